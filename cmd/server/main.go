@@ -82,18 +82,26 @@ func main() {
 		logger.Infof(ctx, "Discord webhook initialized successfully")
 	}
 
-	// 7. Initialize Redis
-	redisClient, err := redis.New(redis.RedisConfig{
-		Host:     cfg.Redis.Host,
-		Port:     cfg.Redis.Port,
-		Password: cfg.Redis.Password,
-		DB:       cfg.Redis.DB,
-	})
-	if err != nil {
-		logger.Error(ctx, "Failed to connect to Redis: ", err)
-		return
+	// 7. Initialize Redis (only when a session/blacklist backend needs it)
+	var redisClient redis.IRedis
+	if cfg.UsesRedis() {
+		redisClient, err = redis.New(redis.RedisConfig{
+			Host:     cfg.Redis.Host,
+			Port:     cfg.Redis.Port,
+			Password: cfg.Redis.Password,
+			DB:       cfg.Redis.DB,
+		})
+		if err != nil {
+			logger.Error(ctx, "Failed to connect to Redis: ", err)
+			return
+		}
+		logger.Infof(ctx, "Redis connected successfully to %s:%d (DB %d)", cfg.Redis.Host, cfg.Redis.Port, cfg.Redis.DB)
+	}
+	if cfg.Blacklist.Enabled {
+		logger.Infof(ctx, "Session backend: %s, blacklist backend: %s", cfg.Session.Backend, cfg.Blacklist.Backend)
+	} else {
+		logger.Warnf(ctx, "Session backend: %s, token blacklist disabled (revocation relies on jwt.ttl)", cfg.Session.Backend)
 	}
-	logger.Infof(ctx, "Redis connected successfully to %s:%d (DB %d)", cfg.Redis.Host, cfg.Redis.Port, cfg.Redis.DB)
 
 	// 9. Initialize JWT Manager
 	jwtManager := auth.NewManager(cfg.JWT.SecretKey)
@@ -101,7 +109,11 @@ func main() {
 
 	// 10. Initialize Redirect Validator
 	// Validates OAuth redirect URLs against whitelist to prevent open redirect attacks
-	redirectValidator := authUsecase.NewRedirectValidator(cfg.AccessControl.AllowedRedirectURLs)
+	redirectValidator, err := authUsecase.NewRedirectValidator(cfg.AccessControl.AllowedRedirectURLs)
+	if err != nil {
+		logger.Error(ctx, "Failed to initialize redirect validator: ", err)
+		return
+	}
 	logger.Infof(ctx, "Redirect validator initialized with %d allowed URLs", len(cfg.AccessControl.AllowedRedirectURLs))
 
 	// ── HTTP Server ─────────────────────────────────────────────────────────
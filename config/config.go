@@ -3,8 +3,12 @@ package config
 import (
 	"encoding/json"
 	"fmt"
+	"net/mail"
 	"os"
+	"regexp"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -36,12 +40,57 @@ type Config struct {
 	// Access Control
 	AccessControl AccessControlConfig
 
+	// Registered Applications (selected with /authentication/login?client_id=)
+	Applications []ApplicationConfig
+
 	// Session Configuration
 	Session SessionConfig
 
 	// Token Blacklist
 	Blacklist BlacklistConfig
 
+	// One-time login codes (redirect ?code=, redeemed at /authentication/exchange)
+	LoginCode LoginCodeConfig
+
+	// Personal Access Tokens
+	AccessToken AccessTokenConfig
+
+	// Service Accounts (OAuth2 client_credentials)
+	ServiceAccount ServiceAccountConfig
+
+	// Admin Impersonation
+	Impersonation ImpersonationConfig
+
+	// Multi-factor Authentication (TOTP)
+	MFA MFAConfig
+
+	// WebAuthn / Passkeys
+	Passkey PasskeyConfig
+
+	// Email Magic-Link Login
+	MagicLink MagicLinkConfig
+
+	// Outgoing Email
+	Mailer MailerConfig
+
+	// User Invitations
+	Invitation InvitationConfig
+
+	// Access Requests (logins refused by the domain allowlist)
+	AccessRequest AccessRequestConfig
+
+	// Domain Events (transactional outbox)
+	Outbox OutboxConfig
+
+	// Outbound Webhooks (fed by the outbox)
+	Webhook WebhookConfig
+
+	// Security Alerts (suspicious authentication activity)
+	SecurityAlert SecurityAlertConfig
+
+	// Rate Limits and Lockouts (login, callback, magic link, token validation)
+	RateLimit RateLimitConfig
+
 	// Monitoring & Notification Configuration
 	Discord DiscordConfig
 }
@@ -77,18 +126,201 @@ type AccessControlConfig struct {
 	DefaultRole         string
 }
 
+// ApplicationConfig is a frontend registered for the login flow. A login that
+// names its client_id uses these settings instead of the global ones.
+type ApplicationConfig struct {
+	ClientID            string   `mapstructure:"client_id" json:"client_id"`
+	Name                string   `mapstructure:"name" json:"name"`
+	AllowedRedirectURLs []string `mapstructure:"allowed_redirect_urls" json:"allowed_redirect_urls"`
+	CookieDomain        string   `mapstructure:"cookie_domain" json:"cookie_domain"`       // empty uses cookie.domain
+	Audience            string   `mapstructure:"audience" json:"audience"`                 // aud claim of its login tokens, empty for none
+	DefaultRedirect     string   `mapstructure:"default_redirect" json:"default_redirect"` // landing page, empty for /dashboard
+	AllowedRoles        []string `mapstructure:"allowed_roles" json:"allowed_roles"`       // empty permits every role
+}
+
 // SessionConfig is the configuration for session management
 type SessionConfig struct {
 	TTL           int // in seconds
 	RememberMeTTL int // in seconds
 	Backend       string
+	KeyPrefix     string // namespace for session keys in Redis
 }
 
+// Storage backends for sessions and the token blacklist
+const (
+	BackendRedis    = "redis"
+	BackendPostgres = "postgres"
+	BackendMemory   = "memory" // single instance only, state is lost on restart
+)
+
 // BlacklistConfig is the configuration for token blacklist
 type BlacklistConfig struct {
-	Enabled   bool
-	Backend   string
-	KeyPrefix string
+	Enabled      bool
+	Backend      string
+	KeyPrefix    string
+	EventChannel string // Redis pub/sub channel for revocation events, empty disables publishing
+}
+
+// LoginCodeConfig is the configuration for the one-time codes that hand a
+// login token to the frontend in place of the token itself
+type LoginCodeConfig struct {
+	Backend   string // redis or memory
+	TTL       int    // in seconds
+	KeyPrefix string // namespace for code keys in Redis
+}
+
+// AccessTokenConfig is the configuration for personal access tokens
+type AccessTokenConfig struct {
+	DefaultTTL int // in seconds, used when the request has no expiry
+	MaxTTL     int // in seconds, upper bound for a requested expiry
+	MaxPerUser int // active tokens per user
+}
+
+// ServiceAccountConfig is the configuration for service accounts and the client_credentials grant
+type ServiceAccountConfig struct {
+	Enabled     bool
+	SigningKey  string // HMAC key for service tokens, must differ from jwt.secret_key
+	Audience    string // audience required on tokens presented to this service's internal routes
+	TokenTTL    int    // in seconds
+	ExchangeTTL int    // in seconds, upper bound for user tokens issued by token exchange
+}
+
+// ImpersonationConfig is the configuration for admin impersonation
+type ImpersonationConfig struct {
+	TTL int // in seconds, lifetime of impersonation tokens
+}
+
+// MFAConfig is the configuration for TOTP multi-factor authentication
+type MFAConfig struct {
+	Enabled         bool
+	RequiredRoles   []string // roles that must pass a TOTP challenge after the OAuth callback
+	Issuer          string   // issuer label shown in authenticator apps
+	ChallengeURL    string   // frontend page that collects the code, receives ?challenge=...
+	ChallengeTTL    int      // in seconds, time to complete the challenge
+	MaxAttempts     int      // failed codes before the factor is locked
+	LockoutDuration int      // in seconds
+}
+
+// PasskeyConfig is the configuration for WebAuthn passkeys
+type PasskeyConfig struct {
+	Enabled       bool
+	RPID          string   // relying party ID, the registrable domain (e.g., "tantai.dev")
+	RPDisplayName string   // name shown by the browser during ceremonies
+	RPOrigins     []string // origins allowed to run ceremonies (e.g., "https://app.tantai.dev")
+	CeremonyTTL   int      // in seconds, time to complete a registration or assertion
+	MaxPerUser    int      // registered passkeys per user
+	Backend       string   // redis or memory, where pending ceremonies are kept
+	KeyPrefix     string   // namespace for pending ceremony keys in Redis
+	// RegistrationMaxAge in seconds: registering a passkey needs a login this
+	// recent, with MFA when the user has a second factor
+	RegistrationMaxAge int
+}
+
+// MagicLinkConfig is the configuration for email magic-link login
+type MagicLinkConfig struct {
+	Enabled        bool
+	URL            string   // frontend page that redeems the link, receives ?token=...
+	TTL            int      // in seconds, lifetime of a link
+	MaxPerHour     int      // links sent to one address per hour
+	AllowedDomains []string // extra domains allowed to log in by magic link only (e.g., contractors)
+}
+
+// MailerConfig is the configuration for outgoing email
+type MailerConfig struct {
+	Driver       string // smtp, file, log
+	From         string // sender, e.g. "SMAP <no-reply@tantai.dev>"
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUsername string
+	SMTPPassword string
+	Dir          string // file driver: directory receiving .eml files
+}
+
+// InvitationConfig is the configuration for user invitations
+type InvitationConfig struct {
+	Enabled  bool
+	TTL      int    // in seconds, time to accept an invitation
+	LoginURL string // page linked from the invitation message
+	Notifier string // log, discord, email
+}
+
+// AccessRequestConfig is the configuration for access requests
+type AccessRequestConfig struct {
+	Enabled bool // file a request when the domain allowlist refuses a login
+}
+
+// OutboxConfig is the configuration for publishing domain events
+type OutboxConfig struct {
+	Enabled      bool
+	Publisher    string // kafka, redis, log
+	PollInterval int    // in milliseconds, how often the relay looks for due events
+	BatchSize    int    // events claimed per relay batch
+	RetryBackoff int    // in seconds, delay before the first retry, doubled after each failure
+	MaxBackoff   int    // in seconds, upper bound for the retry delay
+	Retention    int    // in seconds, how long published events are kept
+	KafkaRESTURL string // Kafka REST Proxy base URL
+	KafkaTopic   string
+	RedisStream  string
+	RedisMaxLen  int64 // approximate stream length cap, 0 keeps everything
+}
+
+// WebhookConfig is the configuration for outbound webhooks
+type WebhookConfig struct {
+	Enabled      bool
+	MaxAttempts  int // attempts before a delivery is dead-lettered
+	RetryBackoff int // in seconds, delay before the first retry, doubled after each failure
+	MaxBackoff   int // in seconds, upper bound for the retry delay
+	Timeout      int // in seconds, per delivery request
+	PollInterval int // in milliseconds, how often the sender looks for due deliveries
+	BatchSize    int // deliveries claimed per batch
+	Retention    int // in seconds, how long delivered and dead deliveries are kept
+	// AllowPrivateTargets lets webhooks reach loopback, private and link-local
+	// addresses, for local testing only
+	AllowPrivateTargets bool
+}
+
+// SecurityAlertConfig is the configuration for security alerts. A zero
+// threshold disables its rule.
+type SecurityAlertConfig struct {
+	Enabled              bool
+	Sink                 string // discord, log
+	Backend              string // redis or memory, where counters and known devices are kept
+	KeyPrefix            string // namespace for counter, hold and known-device keys in Redis
+	DedupWindow          int    // in seconds, an alert with the same subject is sent at most once per window
+	KnownDeviceTTL       int    // in seconds, how long an admin's IP or device stays known after its last login
+	FailedLoginThreshold int    // failed or denied logins of one email that trigger an alert
+	FailedLoginWindow    int    // in seconds
+	RevocationThreshold  int    // revocations by one actor that trigger an alert
+	RevocationWindow     int    // in seconds
+}
+
+// RateLimitRoutes are the routes configurable under rate_limit.routes
+var RateLimitRoutes = []string{"login", "callback", "magic_link", "validate"}
+
+// RateLimitConfig is the configuration for sliding-window rate limits and the
+// lockout of repeated failed OAuth callbacks. A zero limit or threshold
+// disables its rule.
+type RateLimitConfig struct {
+	Enabled          bool
+	Backend          string                          // redis or memory, where hits and lockouts are kept
+	KeyPrefix        string                          // namespace for window and lock keys in Redis
+	Routes           map[string]RateLimitRouteConfig // keyed by RateLimitRoutes
+	LockoutThreshold int                             // failed callbacks of one email or IP that lock it out
+	LockoutWindow    int                             // in seconds, window the failures are counted in
+	LockoutDuration  int                             // in seconds
+}
+
+// RateLimitRouteConfig holds the rules of one route
+type RateLimitRouteConfig struct {
+	PerIP     RateLimitRule
+	PerEmail  RateLimitRule // callback and magic_link only
+	PerClient RateLimitRule // validate only, per internal key or service account
+}
+
+// RateLimitRule allows Limit requests per sliding Window
+type RateLimitRule struct {
+	Limit  int
+	Window int // in seconds
 }
 
 // RedisConfig is the configuration for Redis
@@ -155,7 +387,37 @@ type EncrypterConfig struct {
 
 // InternalConfig is the configuration for internal service authentication
 type InternalConfig struct {
-	InternalKey string
+	InternalKey     string              // legacy single key, accepted under the name "default"
+	Keys            []InternalKeyConfig // named keys with validity windows
+	DatabaseKeys    bool                // also accept keys from the identity.internal_keys table
+	RefreshInterval int                 // in seconds, how often database keys are reloaded
+}
+
+// DefaultInternalKeyName is the name reported for the legacy internal.internal_key
+const DefaultInternalKeyName = "default"
+
+// InternalKeyConfig is a named internal key. Overlapping windows let a
+// consumer move to a new key before the old one stops working.
+type InternalKeyConfig struct {
+	Name      string `mapstructure:"name" json:"name"`
+	Key       string `mapstructure:"key" json:"key"`
+	NotBefore string `mapstructure:"not_before" json:"not_before"` // RFC 3339, empty means no lower bound
+	NotAfter  string `mapstructure:"not_after" json:"not_after"`   // RFC 3339, empty means no upper bound
+}
+
+// Window parses the validity window. Zero times mean the bound is open.
+func (k InternalKeyConfig) Window() (notBefore, notAfter time.Time, err error) {
+	if k.NotBefore != "" {
+		if notBefore, err = time.Parse(time.RFC3339, k.NotBefore); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("not_before: %w", err)
+		}
+	}
+	if k.NotAfter != "" {
+		if notAfter, err = time.Parse(time.RFC3339, k.NotAfter); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("not_after: %w", err)
+		}
+	}
+	return notBefore, notAfter, nil
 }
 
 // Load loads configuration using Viper
@@ -257,21 +519,166 @@ func Load() (*Config, error) {
 		}
 	}
 
+	// Applications
+	if err := viper.UnmarshalKey("applications", &cfg.Applications); err != nil {
+		return nil, fmt.Errorf("error reading applications: %w", err)
+	}
+	if envApps := os.Getenv("APPLICATIONS"); envApps != "" {
+		// JSON array, e.g. [{"client_id":"smap-web","allowed_redirect_urls":["https://smap.tantai.dev"]}]
+		if err := json.Unmarshal([]byte(envApps), &cfg.Applications); err != nil {
+			return nil, fmt.Errorf("error parsing APPLICATIONS: %w", err)
+		}
+	}
+	for i := range cfg.Applications {
+		cfg.Applications[i].AllowedRoles = normalizeRoles(cfg.Applications[i].AllowedRoles)
+	}
+
 	// Session
 	cfg.Session.TTL = viper.GetInt("session.ttl")
 	cfg.Session.RememberMeTTL = viper.GetInt("session.remember_me_ttl")
 	cfg.Session.Backend = viper.GetString("session.backend")
+	cfg.Session.KeyPrefix = viper.GetString("session.key_prefix")
 
 	// Blacklist
 	cfg.Blacklist.Enabled = viper.GetBool("blacklist.enabled")
 	cfg.Blacklist.Backend = viper.GetString("blacklist.backend")
 	cfg.Blacklist.KeyPrefix = viper.GetString("blacklist.key_prefix")
+	cfg.Blacklist.EventChannel = viper.GetString("blacklist.event_channel")
+
+	// Login Codes
+	cfg.LoginCode.Backend = viper.GetString("login_code.backend")
+	cfg.LoginCode.TTL = viper.GetInt("login_code.ttl")
+	cfg.LoginCode.KeyPrefix = viper.GetString("login_code.key_prefix")
+
+	// Personal Access Tokens
+	cfg.AccessToken.DefaultTTL = viper.GetInt("access_token.default_ttl")
+	cfg.AccessToken.MaxTTL = viper.GetInt("access_token.max_ttl")
+	cfg.AccessToken.MaxPerUser = viper.GetInt("access_token.max_per_user")
+
+	// Service Accounts
+	cfg.ServiceAccount.Enabled = viper.GetBool("service_account.enabled")
+	cfg.ServiceAccount.SigningKey = viper.GetString("service_account.signing_key")
+	cfg.ServiceAccount.Audience = viper.GetString("service_account.audience")
+	cfg.ServiceAccount.TokenTTL = viper.GetInt("service_account.token_ttl")
+	cfg.ServiceAccount.ExchangeTTL = viper.GetInt("service_account.exchange_ttl")
 
 	// Encrypter
 	cfg.Encrypter.Key = viper.GetString("encrypter.key")
 
+	// Admin Impersonation
+	cfg.Impersonation.TTL = viper.GetInt("impersonation.ttl")
+
+	// Multi-factor Authentication
+	cfg.MFA.Enabled = viper.GetBool("mfa.enabled")
+	cfg.MFA.RequiredRoles = normalizeRoles(viper.GetStringSlice("mfa.required_roles"))
+	cfg.MFA.Issuer = viper.GetString("mfa.issuer")
+	cfg.MFA.ChallengeURL = viper.GetString("mfa.challenge_url")
+	cfg.MFA.ChallengeTTL = viper.GetInt("mfa.challenge_ttl")
+	cfg.MFA.MaxAttempts = viper.GetInt("mfa.max_attempts")
+	cfg.MFA.LockoutDuration = viper.GetInt("mfa.lockout_duration")
+
+	// WebAuthn / Passkeys
+	cfg.Passkey.Enabled = viper.GetBool("passkey.enabled")
+	cfg.Passkey.RPID = viper.GetString("passkey.rp_id")
+	cfg.Passkey.RPDisplayName = viper.GetString("passkey.rp_display_name")
+	cfg.Passkey.RPOrigins = viper.GetStringSlice("passkey.rp_origins")
+	cfg.Passkey.CeremonyTTL = viper.GetInt("passkey.ceremony_ttl")
+	cfg.Passkey.MaxPerUser = viper.GetInt("passkey.max_per_user")
+	cfg.Passkey.Backend = viper.GetString("passkey.backend")
+	cfg.Passkey.KeyPrefix = viper.GetString("passkey.key_prefix")
+	cfg.Passkey.RegistrationMaxAge = viper.GetInt("passkey.registration_max_age")
+
+	// Email Magic-Link Login
+	cfg.MagicLink.Enabled = viper.GetBool("magic_link.enabled")
+	cfg.MagicLink.URL = viper.GetString("magic_link.url")
+	cfg.MagicLink.TTL = viper.GetInt("magic_link.ttl")
+	cfg.MagicLink.MaxPerHour = viper.GetInt("magic_link.max_per_hour")
+	cfg.MagicLink.AllowedDomains = viper.GetStringSlice("magic_link.allowed_domains")
+
+	// Outgoing Email
+	cfg.Mailer.Driver = viper.GetString("mailer.driver")
+	cfg.Mailer.From = viper.GetString("mailer.from")
+	cfg.Mailer.SMTPHost = viper.GetString("mailer.smtp.host")
+	cfg.Mailer.SMTPPort = viper.GetInt("mailer.smtp.port")
+	cfg.Mailer.SMTPUsername = viper.GetString("mailer.smtp.username")
+	cfg.Mailer.SMTPPassword = viper.GetString("mailer.smtp.password")
+	cfg.Mailer.Dir = viper.GetString("mailer.file.dir")
+
+	// User Invitations
+	cfg.Invitation.Enabled = viper.GetBool("invitation.enabled")
+	cfg.Invitation.TTL = viper.GetInt("invitation.ttl")
+	cfg.Invitation.LoginURL = viper.GetString("invitation.login_url")
+	cfg.Invitation.Notifier = viper.GetString("invitation.notifier")
+
+	// Access Requests
+	cfg.AccessRequest.Enabled = viper.GetBool("access_request.enabled")
+
+	// Domain Events
+	cfg.Outbox.Enabled = viper.GetBool("outbox.enabled")
+	cfg.Outbox.Publisher = viper.GetString("outbox.publisher")
+	cfg.Outbox.PollInterval = viper.GetInt("outbox.poll_interval")
+	cfg.Outbox.BatchSize = viper.GetInt("outbox.batch_size")
+	cfg.Outbox.RetryBackoff = viper.GetInt("outbox.retry_backoff")
+	cfg.Outbox.MaxBackoff = viper.GetInt("outbox.max_backoff")
+	cfg.Outbox.Retention = viper.GetInt("outbox.retention")
+	cfg.Outbox.KafkaRESTURL = viper.GetString("outbox.kafka.rest_url")
+	cfg.Outbox.KafkaTopic = viper.GetString("outbox.kafka.topic")
+	cfg.Outbox.RedisStream = viper.GetString("outbox.redis.stream")
+	cfg.Outbox.RedisMaxLen = viper.GetInt64("outbox.redis.max_len")
+
+	// Outbound Webhooks
+	cfg.Webhook.Enabled = viper.GetBool("webhook.enabled")
+	cfg.Webhook.MaxAttempts = viper.GetInt("webhook.max_attempts")
+	cfg.Webhook.RetryBackoff = viper.GetInt("webhook.retry_backoff")
+	cfg.Webhook.MaxBackoff = viper.GetInt("webhook.max_backoff")
+	cfg.Webhook.Timeout = viper.GetInt("webhook.timeout")
+	cfg.Webhook.PollInterval = viper.GetInt("webhook.poll_interval")
+	cfg.Webhook.BatchSize = viper.GetInt("webhook.batch_size")
+	cfg.Webhook.Retention = viper.GetInt("webhook.retention")
+	cfg.Webhook.AllowPrivateTargets = viper.GetBool("webhook.allow_private_targets")
+
+	// Security Alerts
+	cfg.SecurityAlert.Enabled = viper.GetBool("security_alert.enabled")
+	cfg.SecurityAlert.Sink = viper.GetString("security_alert.sink")
+	cfg.SecurityAlert.Backend = viper.GetString("security_alert.backend")
+	cfg.SecurityAlert.KeyPrefix = viper.GetString("security_alert.key_prefix")
+	cfg.SecurityAlert.DedupWindow = viper.GetInt("security_alert.dedup_window")
+	cfg.SecurityAlert.KnownDeviceTTL = viper.GetInt("security_alert.known_device_ttl")
+	cfg.SecurityAlert.FailedLoginThreshold = viper.GetInt("security_alert.failed_login.threshold")
+	cfg.SecurityAlert.FailedLoginWindow = viper.GetInt("security_alert.failed_login.window")
+	cfg.SecurityAlert.RevocationThreshold = viper.GetInt("security_alert.revocation.threshold")
+	cfg.SecurityAlert.RevocationWindow = viper.GetInt("security_alert.revocation.window")
+
+	// Rate Limits
+	cfg.RateLimit.Enabled = viper.GetBool("rate_limit.enabled")
+	cfg.RateLimit.Backend = viper.GetString("rate_limit.backend")
+	cfg.RateLimit.KeyPrefix = viper.GetString("rate_limit.key_prefix")
+	cfg.RateLimit.Routes = make(map[string]RateLimitRouteConfig, len(RateLimitRoutes))
+	for _, route := range RateLimitRoutes {
+		prefix := "rate_limit.routes." + route
+		cfg.RateLimit.Routes[route] = RateLimitRouteConfig{
+			PerIP:     readRateLimitRule(prefix + ".per_ip"),
+			PerEmail:  readRateLimitRule(prefix + ".per_email"),
+			PerClient: readRateLimitRule(prefix + ".per_client"),
+		}
+	}
+	cfg.RateLimit.LockoutThreshold = viper.GetInt("rate_limit.lockout.threshold")
+	cfg.RateLimit.LockoutWindow = viper.GetInt("rate_limit.lockout.window")
+	cfg.RateLimit.LockoutDuration = viper.GetInt("rate_limit.lockout.duration")
+
 	// Internal Service Key
 	cfg.InternalConfig.InternalKey = viper.GetString("internal.internal_key")
+	if err := viper.UnmarshalKey("internal.keys", &cfg.InternalConfig.Keys); err != nil {
+		return nil, fmt.Errorf("error reading internal.keys: %w", err)
+	}
+	if envKeys := os.Getenv("INTERNAL_KEYS"); envKeys != "" {
+		// JSON array, e.g. [{"name":"project-srv-2026q4","key":"...","not_after":"2027-01-31T00:00:00Z"}]
+		if err := json.Unmarshal([]byte(envKeys), &cfg.InternalConfig.Keys); err != nil {
+			return nil, fmt.Errorf("error parsing INTERNAL_KEYS: %w", err)
+		}
+	}
+	cfg.InternalConfig.DatabaseKeys = viper.GetBool("internal.database_keys")
+	cfg.InternalConfig.RefreshInterval = viper.GetInt("internal.refresh_interval")
 
 	// Discord
 	cfg.Discord.WebhookID = viper.GetString("discord.webhook_id")
@@ -333,12 +740,136 @@ func setDefaults() {
 	// Session
 	viper.SetDefault("session.ttl", 28800)              // 8 hours
 	viper.SetDefault("session.remember_me_ttl", 604800) // 7 days
-	viper.SetDefault("session.backend", "redis")
+	viper.SetDefault("session.backend", BackendRedis)
+	viper.SetDefault("session.key_prefix", "") // e.g. "identity:" when sharing a Redis DB
 
 	// Blacklist
 	viper.SetDefault("blacklist.enabled", true)
-	viper.SetDefault("blacklist.backend", "redis")
+	viper.SetDefault("blacklist.backend", BackendRedis)
 	viper.SetDefault("blacklist.key_prefix", "blacklist:")
+	viper.SetDefault("blacklist.event_channel", "")
+
+	// Login Codes
+	viper.SetDefault("login_code.backend", BackendRedis)
+	viper.SetDefault("login_code.ttl", 60)
+	viper.SetDefault("login_code.key_prefix", "login_code:")
+
+	// Personal Access Tokens
+	viper.SetDefault("access_token.default_ttl", 7776000) // 90 days
+	viper.SetDefault("access_token.max_ttl", 31536000)    // 365 days
+	viper.SetDefault("access_token.max_per_user", 20)
+
+	// Service Accounts
+	viper.SetDefault("service_account.enabled", false)
+	viper.SetDefault("service_account.audience", "identity-srv")
+	viper.SetDefault("service_account.token_ttl", 900)    // 15 minutes
+	viper.SetDefault("service_account.exchange_ttl", 300) // 5 minutes
+
+	// Admin Impersonation
+	viper.SetDefault("impersonation.ttl", 900) // 15 minutes
+
+	// Multi-factor Authentication
+	viper.SetDefault("mfa.enabled", false)
+	viper.SetDefault("mfa.required_roles", []string{"ADMIN"})
+	viper.SetDefault("mfa.issuer", "SMAP")
+	viper.SetDefault("mfa.challenge_ttl", 300) // 5 minutes
+	viper.SetDefault("mfa.max_attempts", 5)
+	viper.SetDefault("mfa.lockout_duration", 900) // 15 minutes
+
+	// WebAuthn / Passkeys
+	viper.SetDefault("passkey.enabled", false)
+	viper.SetDefault("passkey.rp_display_name", "SMAP")
+	viper.SetDefault("passkey.ceremony_ttl", 300) // 5 minutes
+	viper.SetDefault("passkey.max_per_user", 10)
+	viper.SetDefault("passkey.backend", BackendRedis)
+	viper.SetDefault("passkey.key_prefix", "passkey_ceremony:")
+	viper.SetDefault("passkey.registration_max_age", 600) // 10 minutes
+
+	// Email Magic-Link Login
+	viper.SetDefault("magic_link.enabled", false)
+	viper.SetDefault("magic_link.ttl", 900) // 15 minutes
+	viper.SetDefault("magic_link.max_per_hour", 5)
+
+	// Outgoing Email
+	viper.SetDefault("mailer.driver", "log")
+	viper.SetDefault("mailer.from", "SMAP <no-reply@localhost>")
+	viper.SetDefault("mailer.smtp.port", 587)
+	viper.SetDefault("mailer.file.dir", "./tmp/mail")
+
+	// User Invitations
+	viper.SetDefault("invitation.enabled", false)
+	viper.SetDefault("invitation.ttl", 604800) // 7 days
+	viper.SetDefault("invitation.notifier", "log")
+
+	// Access Requests
+	viper.SetDefault("access_request.enabled", false)
+
+	// Domain Events
+	viper.SetDefault("outbox.enabled", false)
+	viper.SetDefault("outbox.publisher", "log")
+	viper.SetDefault("outbox.poll_interval", 1000)
+	viper.SetDefault("outbox.batch_size", 100)
+	viper.SetDefault("outbox.retry_backoff", 1)
+	viper.SetDefault("outbox.max_backoff", 300)  // 5 minutes
+	viper.SetDefault("outbox.retention", 604800) // 7 days
+	viper.SetDefault("outbox.kafka.topic", "identity.events")
+	viper.SetDefault("outbox.redis.stream", "identity:events")
+	viper.SetDefault("outbox.redis.max_len", 100000)
+
+	// Outbound Webhooks
+	viper.SetDefault("webhook.enabled", false)
+	viper.SetDefault("webhook.max_attempts", 8)
+	viper.SetDefault("webhook.retry_backoff", 10)
+	viper.SetDefault("webhook.max_backoff", 3600) // 1 hour
+	viper.SetDefault("webhook.timeout", 10)
+	viper.SetDefault("webhook.poll_interval", 1000)
+	viper.SetDefault("webhook.batch_size", 50)
+	viper.SetDefault("webhook.retention", 2592000) // 30 days
+	viper.SetDefault("webhook.allow_private_targets", false)
+
+	// Security Alerts
+	viper.SetDefault("security_alert.enabled", false)
+	viper.SetDefault("security_alert.sink", "discord")
+	viper.SetDefault("security_alert.backend", BackendRedis)
+	viper.SetDefault("security_alert.key_prefix", "security_alert:")
+	viper.SetDefault("security_alert.dedup_window", 3600)        // 1 hour
+	viper.SetDefault("security_alert.known_device_ttl", 7776000) // 90 days
+	viper.SetDefault("security_alert.failed_login.threshold", 5)
+	viper.SetDefault("security_alert.failed_login.window", 900) // 15 minutes
+	viper.SetDefault("security_alert.revocation.threshold", 10)
+	viper.SetDefault("security_alert.revocation.window", 300) // 5 minutes
+
+	// Rate Limits (windows in seconds)
+	viper.SetDefault("rate_limit.enabled", false)
+	viper.SetDefault("rate_limit.backend", BackendRedis)
+	viper.SetDefault("rate_limit.key_prefix", "rate_limit:")
+	viper.SetDefault("rate_limit.routes.login.per_ip.limit", 30)
+	viper.SetDefault("rate_limit.routes.login.per_ip.window", 60)
+	viper.SetDefault("rate_limit.routes.callback.per_ip.limit", 30)
+	viper.SetDefault("rate_limit.routes.callback.per_ip.window", 60)
+	viper.SetDefault("rate_limit.routes.callback.per_email.limit", 10)
+	viper.SetDefault("rate_limit.routes.callback.per_email.window", 300)
+	viper.SetDefault("rate_limit.routes.magic_link.per_ip.limit", 10)
+	viper.SetDefault("rate_limit.routes.magic_link.per_ip.window", 60)
+	viper.SetDefault("rate_limit.routes.magic_link.per_email.limit", 5)
+	viper.SetDefault("rate_limit.routes.magic_link.per_email.window", 900)
+	viper.SetDefault("rate_limit.routes.validate.per_client.limit", 6000)
+	viper.SetDefault("rate_limit.routes.validate.per_client.window", 60)
+	viper.SetDefault("rate_limit.lockout.threshold", 5)
+	viper.SetDefault("rate_limit.lockout.window", 900)   // 15 minutes
+	viper.SetDefault("rate_limit.lockout.duration", 900) // 15 minutes
+
+	// Internal Service Authentication
+	viper.SetDefault("internal.database_keys", false)
+	viper.SetDefault("internal.refresh_interval", 60)
+}
+
+// readRateLimitRule reads the limit and window under key
+func readRateLimitRule(key string) RateLimitRule {
+	return RateLimitRule{
+		Limit:  viper.GetInt(key + ".limit"),
+		Window: viper.GetInt(key + ".window"),
+	}
 }
 
 func normalizeUserRoles(input map[string]string) map[string]string {
@@ -354,6 +885,17 @@ func normalizeUserRoles(input map[string]string) map[string]string {
 	return roles
 }
 
+// normalizeRoles upper-cases roles and drops empty entries
+func normalizeRoles(input []string) []string {
+	roles := make([]string, 0, len(input))
+	for _, role := range input {
+		if normalized := strings.ToUpper(strings.TrimSpace(role)); normalized != "" {
+			roles = append(roles, normalized)
+		}
+	}
+	return roles
+}
+
 func parseUserRolesEnv(raw string) map[string]string {
 	raw = strings.TrimSpace(raw)
 	if raw == "" {
@@ -446,6 +988,9 @@ func validate(cfg *Config) error {
 			return fmt.Errorf("access_control.user_roles contains invalid role for %s", email)
 		}
 	}
+	if err := validateApplications(cfg.Applications, validRoles); err != nil {
+		return err
+	}
 
 	// Validate Encrypter
 	if cfg.Encrypter.Key == "" {
@@ -470,12 +1015,28 @@ func validate(cfg *Config) error {
 		return fmt.Errorf("postgres.user is required")
 	}
 
-	// Validate Redis Configuration (Task 4.4)
-	if cfg.Redis.Host == "" {
-		return fmt.Errorf("redis.host is required")
+	// Validate storage backends
+	if !isValidBackend(cfg.Session.Backend) {
+		return fmt.Errorf("session.backend must be one of: redis, postgres, memory")
+	}
+	if cfg.Blacklist.Enabled && !isValidBackend(cfg.Blacklist.Backend) {
+		return fmt.Errorf("blacklist.backend must be one of: redis, postgres, memory")
 	}
-	if cfg.Redis.Port == 0 {
-		return fmt.Errorf("redis.port is required")
+	if cfg.LoginCode.Backend != BackendRedis && cfg.LoginCode.Backend != BackendMemory {
+		return fmt.Errorf("login_code.backend must be one of: redis, memory")
+	}
+	if cfg.LoginCode.TTL <= 0 || cfg.LoginCode.TTL > 300 {
+		return fmt.Errorf("login_code.ttl must be between 1 and 300 seconds")
+	}
+
+	// Validate Redis Configuration (only when a backend uses it)
+	if cfg.UsesRedis() {
+		if cfg.Redis.Host == "" {
+			return fmt.Errorf("redis.host is required")
+		}
+		if cfg.Redis.Port == 0 {
+			return fmt.Errorf("redis.port is required")
+		}
 	}
 
 	// Validate Session Configuration (Task 4.4)
@@ -486,13 +1047,406 @@ func validate(cfg *Config) error {
 		return fmt.Errorf("session.remember_me_ttl must be greater than 0")
 	}
 
+	// Validate Personal Access Token Configuration
+	if cfg.AccessToken.DefaultTTL <= 0 || cfg.AccessToken.MaxTTL < cfg.AccessToken.DefaultTTL {
+		return fmt.Errorf("access_token.default_ttl must be greater than 0 and not exceed access_token.max_ttl")
+	}
+
+	// Validate Service Account Configuration
+	if cfg.ServiceAccount.Enabled {
+		if len(cfg.ServiceAccount.SigningKey) < 32 {
+			return fmt.Errorf("service_account.signing_key must be at least 32 characters when service accounts are enabled")
+		}
+		if cfg.ServiceAccount.SigningKey == cfg.JWT.SecretKey {
+			return fmt.Errorf("service_account.signing_key must differ from jwt.secret_key")
+		}
+		if cfg.ServiceAccount.Audience == "" {
+			return fmt.Errorf("service_account.audience is required when service accounts are enabled")
+		}
+		if cfg.ServiceAccount.TokenTTL <= 0 || cfg.ServiceAccount.TokenTTL > 3600 {
+			return fmt.Errorf("service_account.token_ttl must be between 1 and 3600 seconds")
+		}
+		if cfg.ServiceAccount.ExchangeTTL <= 0 || cfg.ServiceAccount.ExchangeTTL > 3600 {
+			return fmt.Errorf("service_account.exchange_ttl must be between 1 and 3600 seconds")
+		}
+	}
+
+	// Validate Impersonation Configuration
+	if cfg.Impersonation.TTL <= 0 || cfg.Impersonation.TTL > cfg.JWT.TTL {
+		return fmt.Errorf("impersonation.ttl must be greater than 0 and not exceed jwt.ttl")
+	}
+
+	// Validate MFA Configuration
+	if cfg.MFA.Enabled {
+		for _, role := range cfg.MFA.RequiredRoles {
+			if !validRoles[role] {
+				return fmt.Errorf("mfa.required_roles contains invalid role %q", role)
+			}
+		}
+		if cfg.MFA.Issuer == "" {
+			return fmt.Errorf("mfa.issuer is required when mfa is enabled")
+		}
+		if cfg.MFA.ChallengeURL == "" {
+			return fmt.Errorf("mfa.challenge_url is required when mfa is enabled")
+		}
+		if cfg.MFA.ChallengeTTL < 60 || cfg.MFA.ChallengeTTL > 1800 {
+			return fmt.Errorf("mfa.challenge_ttl must be between 60 and 1800 seconds")
+		}
+		if cfg.MFA.MaxAttempts <= 0 || cfg.MFA.LockoutDuration <= 0 {
+			return fmt.Errorf("mfa.max_attempts and mfa.lockout_duration must be greater than 0")
+		}
+	}
+
+	// Validate Passkey Configuration
+	if cfg.Passkey.Enabled {
+		if cfg.Passkey.RPID == "" {
+			return fmt.Errorf("passkey.rp_id is required when passkey is enabled")
+		}
+		if len(cfg.Passkey.RPOrigins) == 0 {
+			return fmt.Errorf("passkey.rp_origins is required when passkey is enabled")
+		}
+		for _, origin := range cfg.Passkey.RPOrigins {
+			if !strings.HasPrefix(origin, "https://") && !strings.HasPrefix(origin, "http://localhost") {
+				return fmt.Errorf("passkey.rp_origins entry %q must use https (http is only allowed for localhost)", origin)
+			}
+		}
+		if cfg.Passkey.CeremonyTTL < 30 || cfg.Passkey.CeremonyTTL > 600 {
+			return fmt.Errorf("passkey.ceremony_ttl must be between 30 and 600 seconds")
+		}
+		if cfg.Passkey.MaxPerUser <= 0 {
+			return fmt.Errorf("passkey.max_per_user must be greater than 0")
+		}
+		if cfg.Passkey.Backend != BackendRedis && cfg.Passkey.Backend != BackendMemory {
+			return fmt.Errorf("passkey.backend must be one of: redis, memory")
+		}
+		if cfg.Passkey.RegistrationMaxAge < 60 || cfg.Passkey.RegistrationMaxAge > 86400 {
+			return fmt.Errorf("passkey.registration_max_age must be between 60 and 86400 seconds")
+		}
+	}
+
+	// Validate Magic-Link Configuration
+	if cfg.MagicLink.Enabled {
+		if cfg.MagicLink.URL == "" {
+			return fmt.Errorf("magic_link.url is required when magic links are enabled")
+		}
+		if !strings.HasPrefix(cfg.MagicLink.URL, "https://") && !strings.HasPrefix(cfg.MagicLink.URL, "http://localhost") {
+			return fmt.Errorf("magic_link.url must use https (http is only allowed for localhost)")
+		}
+		if cfg.MagicLink.TTL < 60 || cfg.MagicLink.TTL > 3600 {
+			return fmt.Errorf("magic_link.ttl must be between 60 and 3600 seconds")
+		}
+		if cfg.MagicLink.MaxPerHour <= 0 {
+			return fmt.Errorf("magic_link.max_per_hour must be greater than 0")
+		}
+		if err := validateMailerConfig(cfg.Mailer); err != nil {
+			return err
+		}
+		if cfg.Mailer.Driver == "log" && cfg.Environment.Name == "production" {
+			return fmt.Errorf("mailer.driver log would write login links to the logs; use smtp in production")
+		}
+	}
+
+	// Validate Invitation Configuration
+	if cfg.Invitation.Enabled {
+		if cfg.Invitation.LoginURL == "" {
+			return fmt.Errorf("invitation.login_url is required when invitations are enabled")
+		}
+		if cfg.Invitation.TTL < 3600 || cfg.Invitation.TTL > 30*24*3600 {
+			return fmt.Errorf("invitation.ttl must be between 1 hour and 30 days")
+		}
+		switch cfg.Invitation.Notifier {
+		case "log":
+		case "discord":
+			if cfg.Discord.WebhookID == "" || cfg.Discord.WebhookToken == "" {
+				return fmt.Errorf("discord.webhook_id and discord.webhook_token are required for the discord notifier")
+			}
+		case "email":
+			if err := validateMailerConfig(cfg.Mailer); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("invitation.notifier must be one of log, discord, email")
+		}
+	}
+
+	// Validate Outbox Configuration
+	if cfg.Outbox.Enabled {
+		if err := validateOutboxConfig(cfg.Outbox); err != nil {
+			return err
+		}
+	}
+
+	// Validate Webhook Configuration
+	if cfg.Webhook.Enabled {
+		if !cfg.Outbox.Enabled {
+			return fmt.Errorf("webhook.enabled requires outbox.enabled")
+		}
+		if err := validateWebhookConfig(cfg.Webhook); err != nil {
+			return err
+		}
+	}
+
+	// Validate Security Alert Configuration
+	if cfg.SecurityAlert.Enabled {
+		if err := validateSecurityAlertConfig(cfg.SecurityAlert); err != nil {
+			return err
+		}
+		if cfg.SecurityAlert.Sink == "discord" && (cfg.Discord.WebhookID == "" || cfg.Discord.WebhookToken == "") {
+			return fmt.Errorf("discord.webhook_id and discord.webhook_token are required for the discord alert sink")
+		}
+	}
+
+	// Validate Rate Limit Configuration
+	if cfg.RateLimit.Enabled {
+		if err := validateRateLimitConfig(cfg.RateLimit); err != nil {
+			return err
+		}
+	}
+
 	// Validate Cookie Configuration (Task 4.4)
 	if cfg.Cookie.Name == "" {
 		return fmt.Errorf("cookie.name is required")
 	}
-	if cfg.InternalConfig.InternalKey == "" {
-		return fmt.Errorf("internal.internal_key is required")
+	if err := validateInternalConfig(cfg.InternalConfig); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// applicationClientIDPattern matches the client_id accepted by /authentication/login
+var applicationClientIDPattern = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
+
+func validateApplications(apps []ApplicationConfig, validRoles map[string]bool) error {
+	clientIDs := map[string]bool{}
+	for i, app := range apps {
+		if !applicationClientIDPattern.MatchString(app.ClientID) {
+			return fmt.Errorf("applications[%d].client_id must be 1-64 letters, digits, '.', '_' or '-'", i)
+		}
+		if clientIDs[app.ClientID] {
+			return fmt.Errorf("applications[%d]: duplicate client_id %q", i, app.ClientID)
+		}
+		clientIDs[app.ClientID] = true
+
+		if len(app.AllowedRedirectURLs) == 0 {
+			return fmt.Errorf("applications[%d] (%s): allowed_redirect_urls is required", i, app.ClientID)
+		}
+		for _, role := range app.AllowedRoles {
+			if !validRoles[role] {
+				return fmt.Errorf("applications[%d] (%s): allowed_roles contains invalid role %q", i, app.ClientID, role)
+			}
+		}
+	}
+	return nil
+}
+
+func validateMailerConfig(cfg MailerConfig) error {
+	switch cfg.Driver {
+	case "smtp":
+		if cfg.SMTPHost == "" || cfg.SMTPPort <= 0 {
+			return fmt.Errorf("mailer.smtp.host and mailer.smtp.port are required for the smtp driver")
+		}
+	case "file":
+		if cfg.Dir == "" {
+			return fmt.Errorf("mailer.file.dir is required for the file driver")
+		}
+	case "log":
+	default:
+		return fmt.Errorf("mailer.driver must be one of smtp, file, log")
+	}
+	if _, err := mail.ParseAddress(cfg.From); err != nil {
+		return fmt.Errorf("mailer.from is not a valid address: %w", err)
+	}
+	return nil
+}
+
+func validateOutboxConfig(cfg OutboxConfig) error {
+	switch cfg.Publisher {
+	case "kafka":
+		if cfg.KafkaRESTURL == "" || cfg.KafkaTopic == "" {
+			return fmt.Errorf("outbox.kafka.rest_url and outbox.kafka.topic are required for the kafka publisher")
+		}
+	case "redis":
+		if cfg.RedisStream == "" {
+			return fmt.Errorf("outbox.redis.stream is required for the redis publisher")
+		}
+		if cfg.RedisMaxLen < 0 {
+			return fmt.Errorf("outbox.redis.max_len must not be negative")
+		}
+	case "log":
+	default:
+		return fmt.Errorf("outbox.publisher must be one of kafka, redis, log")
+	}
+	if cfg.PollInterval < 100 {
+		return fmt.Errorf("outbox.poll_interval must be at least 100 milliseconds")
+	}
+	if cfg.BatchSize <= 0 || cfg.BatchSize > 1000 {
+		return fmt.Errorf("outbox.batch_size must be between 1 and 1000")
+	}
+	if cfg.RetryBackoff <= 0 || cfg.MaxBackoff < cfg.RetryBackoff {
+		return fmt.Errorf("outbox.retry_backoff must be greater than 0 and at most outbox.max_backoff")
+	}
+	if cfg.Retention < 3600 {
+		return fmt.Errorf("outbox.retention must be at least 1 hour")
 	}
+	return nil
+}
 
+func validateWebhookConfig(cfg WebhookConfig) error {
+	if cfg.MaxAttempts <= 0 || cfg.MaxAttempts > 50 {
+		return fmt.Errorf("webhook.max_attempts must be between 1 and 50")
+	}
+	if cfg.RetryBackoff <= 0 || cfg.MaxBackoff < cfg.RetryBackoff {
+		return fmt.Errorf("webhook.retry_backoff must be greater than 0 and at most webhook.max_backoff")
+	}
+	// Must stay below the two minute claim lease so a slow receiver is not sent the delivery twice
+	if cfg.Timeout <= 0 || cfg.Timeout > 60 {
+		return fmt.Errorf("webhook.timeout must be between 1 and 60 seconds")
+	}
+	if cfg.PollInterval < 100 {
+		return fmt.Errorf("webhook.poll_interval must be at least 100 milliseconds")
+	}
+	if cfg.BatchSize <= 0 || cfg.BatchSize > 500 {
+		return fmt.Errorf("webhook.batch_size must be between 1 and 500")
+	}
+	if cfg.Retention < 3600 {
+		return fmt.Errorf("webhook.retention must be at least 1 hour")
+	}
+	return nil
+}
+
+func validateSecurityAlertConfig(cfg SecurityAlertConfig) error {
+	switch cfg.Sink {
+	case "discord", "log":
+	default:
+		return fmt.Errorf("security_alert.sink must be one of discord, log")
+	}
+	switch cfg.Backend {
+	case BackendRedis, BackendMemory:
+	default:
+		return fmt.Errorf("security_alert.backend must be one of redis, memory")
+	}
+	if cfg.DedupWindow <= 0 {
+		return fmt.Errorf("security_alert.dedup_window must be greater than 0")
+	}
+	if cfg.KnownDeviceTTL < 86400 {
+		return fmt.Errorf("security_alert.known_device_ttl must be at least 1 day")
+	}
+	if cfg.FailedLoginThreshold < 0 || cfg.RevocationThreshold < 0 {
+		return fmt.Errorf("security_alert thresholds must not be negative")
+	}
+	// The memory backend keeps counters for a day at most
+	if cfg.FailedLoginThreshold > 0 && (cfg.FailedLoginWindow <= 0 || cfg.FailedLoginWindow > 86400) {
+		return fmt.Errorf("security_alert.failed_login.window must be between 1 second and 1 day")
+	}
+	if cfg.RevocationThreshold > 0 && (cfg.RevocationWindow <= 0 || cfg.RevocationWindow > 86400) {
+		return fmt.Errorf("security_alert.revocation.window must be between 1 second and 1 day")
+	}
+	return nil
+}
+
+func validateRateLimitConfig(cfg RateLimitConfig) error {
+	switch cfg.Backend {
+	case BackendRedis, BackendMemory:
+	default:
+		return fmt.Errorf("rate_limit.backend must be one of redis, memory")
+	}
+	for route, rules := range cfg.Routes {
+		if !slices.Contains(RateLimitRoutes, route) {
+			return fmt.Errorf("rate_limit.routes.%s is not a rate-limited route", route)
+		}
+		for dimension, rule := range map[string]RateLimitRule{
+			"per_ip":     rules.PerIP,
+			"per_email":  rules.PerEmail,
+			"per_client": rules.PerClient,
+		} {
+			if err := validateRateLimitRule(fmt.Sprintf("rate_limit.routes.%s.%s", route, dimension), rule); err != nil {
+				return err
+			}
+		}
+	}
+	if cfg.LockoutThreshold < 0 {
+		return fmt.Errorf("rate_limit.lockout.threshold must not be negative")
+	}
+	// The memory backend keeps counters for a day at most
+	if cfg.LockoutThreshold > 0 {
+		if cfg.LockoutWindow <= 0 || cfg.LockoutWindow > 86400 {
+			return fmt.Errorf("rate_limit.lockout.window must be between 1 second and 1 day")
+		}
+		if cfg.LockoutDuration <= 0 || cfg.LockoutDuration > 86400 {
+			return fmt.Errorf("rate_limit.lockout.duration must be between 1 second and 1 day")
+		}
+	}
 	return nil
 }
+
+func validateRateLimitRule(key string, rule RateLimitRule) error {
+	if rule.Limit < 0 {
+		return fmt.Errorf("%s.limit must not be negative", key)
+	}
+	if rule.Limit > 0 && (rule.Window <= 0 || rule.Window > 86400) {
+		return fmt.Errorf("%s.window must be between 1 second and 1 day", key)
+	}
+	return nil
+}
+
+func validateInternalConfig(cfg InternalConfig) error {
+	if cfg.InternalKey == "" && len(cfg.Keys) == 0 && !cfg.DatabaseKeys {
+		return fmt.Errorf("internal.internal_key, internal.keys or internal.database_keys is required")
+	}
+	if cfg.DatabaseKeys && cfg.RefreshInterval <= 0 {
+		return fmt.Errorf("internal.refresh_interval must be greater than 0")
+	}
+
+	names := map[string]bool{}
+	if cfg.InternalKey != "" {
+		names[DefaultInternalKeyName] = true
+	}
+	for i, key := range cfg.Keys {
+		if key.Name == "" || key.Key == "" {
+			return fmt.Errorf("internal.keys[%d] must have a name and a key", i)
+		}
+		if names[key.Name] {
+			return fmt.Errorf("internal.keys[%d]: duplicate key name %q", i, key.Name)
+		}
+		names[key.Name] = true
+
+		notBefore, notAfter, err := key.Window()
+		if err != nil {
+			return fmt.Errorf("internal.keys[%d] (%s): %w", i, key.Name, err)
+		}
+		if !notBefore.IsZero() && !notAfter.IsZero() && !notAfter.After(notBefore) {
+			return fmt.Errorf("internal.keys[%d] (%s): not_after must be after not_before", i, key.Name)
+		}
+	}
+	return nil
+}
+
+// UsesRedis reports whether any configured backend requires a Redis connection.
+func (cfg *Config) UsesRedis() bool {
+	if cfg.Session.Backend == BackendRedis || cfg.LoginCode.Backend == BackendRedis {
+		return true
+	}
+	if cfg.Outbox.Enabled && cfg.Outbox.Publisher == "redis" {
+		return true
+	}
+	if cfg.SecurityAlert.Enabled && cfg.SecurityAlert.Backend == BackendRedis {
+		return true
+	}
+	if cfg.RateLimit.Enabled && cfg.RateLimit.Backend == BackendRedis {
+		return true
+	}
+	if cfg.Passkey.Enabled && cfg.Passkey.Backend == BackendRedis {
+		return true
+	}
+	return cfg.Blacklist.Enabled && (cfg.Blacklist.Backend == BackendRedis || cfg.Blacklist.EventChannel != "")
+}
+
+func isValidBackend(backend string) bool {
+	switch backend {
+	case BackendRedis, BackendPostgres, BackendMemory:
+		return true
+	default:
+		return false
+	}
+}
@@ -20,3 +20,100 @@ func TestParseUserRolesEnvJSON(t *testing.T) {
 		t.Fatalf("json role = %q, want ADMIN", got)
 	}
 }
+
+func TestUsesRedis(t *testing.T) {
+	cfg := &Config{
+		Session:   SessionConfig{Backend: BackendPostgres},
+		Blacklist: BlacklistConfig{Enabled: false, Backend: BackendRedis},
+	}
+	if cfg.UsesRedis() {
+		t.Fatalf("disabled redis blacklist should not require redis")
+	}
+
+	cfg.Blacklist.Enabled = true
+	if !cfg.UsesRedis() {
+		t.Fatalf("enabled redis blacklist should require redis")
+	}
+
+	cfg.Blacklist.Enabled = false
+	cfg.Outbox = OutboxConfig{Enabled: true, Publisher: "redis"}
+	if !cfg.UsesRedis() {
+		t.Fatalf("redis stream publisher should require redis")
+	}
+
+	cfg.Outbox = OutboxConfig{}
+	cfg.SecurityAlert = SecurityAlertConfig{Enabled: true, Backend: BackendRedis}
+	if !cfg.UsesRedis() {
+		t.Fatalf("redis security alert backend should require redis")
+	}
+
+	cfg.SecurityAlert = SecurityAlertConfig{}
+	cfg.LoginCode = LoginCodeConfig{Backend: BackendRedis}
+	if !cfg.UsesRedis() {
+		t.Fatalf("redis login code backend should require redis")
+	}
+
+	cfg.LoginCode = LoginCodeConfig{Backend: BackendMemory}
+	cfg.RateLimit = RateLimitConfig{Enabled: true, Backend: BackendMemory}
+	if cfg.UsesRedis() {
+		t.Fatalf("memory rate limit backend should not require redis")
+	}
+	cfg.RateLimit.Backend = BackendRedis
+	if !cfg.UsesRedis() {
+		t.Fatalf("redis rate limit backend should require redis")
+	}
+
+	cfg.RateLimit = RateLimitConfig{}
+	cfg.Passkey = PasskeyConfig{Enabled: true, Backend: BackendRedis}
+	if !cfg.UsesRedis() {
+		t.Fatalf("redis passkey backend should require redis")
+	}
+}
+
+func TestValidateInternalConfig(t *testing.T) {
+	cfg := InternalConfig{Keys: []InternalKeyConfig{
+		{Name: "old", Key: "k1", NotAfter: "2027-01-31T00:00:00Z"},
+		{Name: "new", Key: "k2", NotBefore: "2027-01-01T00:00:00Z"},
+	}}
+	if err := validateInternalConfig(cfg); err != nil {
+		t.Fatalf("overlapping keys should be valid: %v", err)
+	}
+
+	cfg.Keys = append(cfg.Keys, InternalKeyConfig{Name: "old", Key: "k3"})
+	if err := validateInternalConfig(cfg); err == nil {
+		t.Fatalf("duplicate key names should be rejected")
+	}
+
+	cfg.Keys = []InternalKeyConfig{{Name: "bad", Key: "k", NotBefore: "2027-02-01T00:00:00Z", NotAfter: "2027-01-01T00:00:00Z"}}
+	if err := validateInternalConfig(cfg); err == nil {
+		t.Fatalf("inverted window should be rejected")
+	}
+
+	if err := validateInternalConfig(InternalConfig{}); err == nil {
+		t.Fatalf("no key source should be rejected")
+	}
+}
+
+func TestValidateApplications(t *testing.T) {
+	validRoles := map[string]bool{"ADMIN": true, "ANALYST": true, "VIEWER": true}
+	apps := []ApplicationConfig{
+		{ClientID: "smap-web", AllowedRedirectURLs: []string{"https://smap.tantai.dev"}},
+		{ClientID: "smap-admin", AllowedRedirectURLs: []string{"https://admin.tantai.dev"}, AllowedRoles: []string{"ADMIN"}},
+	}
+	if err := validateApplications(apps, validRoles); err != nil {
+		t.Fatalf("applications should be valid: %v", err)
+	}
+
+	if err := validateApplications(append(apps, apps[0]), validRoles); err == nil {
+		t.Fatalf("duplicate client_id should be rejected")
+	}
+	if err := validateApplications([]ApplicationConfig{{ClientID: "dev tools", AllowedRedirectURLs: []string{"/"}}}, validRoles); err == nil {
+		t.Fatalf("malformed client_id should be rejected")
+	}
+	if err := validateApplications([]ApplicationConfig{{ClientID: "smap-dev"}}, validRoles); err == nil {
+		t.Fatalf("application without redirect URLs should be rejected")
+	}
+	if err := validateApplications([]ApplicationConfig{{ClientID: "smap-dev", AllowedRedirectURLs: []string{"/"}, AllowedRoles: []string{"OWNER"}}}, validRoles); err == nil {
+		t.Fatalf("unknown role should be rejected")
+	}
+}
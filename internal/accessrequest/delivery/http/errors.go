@@ -0,0 +1,40 @@
+package http
+
+import (
+	"errors"
+	"identity-srv/internal/accessrequest"
+
+	pkgErrors "github.com/smap-hcmut/shared-libs/go/errors"
+)
+
+// --- HTTP error constants ---
+
+var (
+	errWrongBody       = pkgErrors.NewHTTPError(26001, "Wrong body")
+	errRequestNotFound = pkgErrors.NewHTTPError(26002, "Access request not found")
+	errInvalidRole     = pkgErrors.NewHTTPError(26003, "Invalid role")
+	errInvalidStatus   = pkgErrors.NewHTTPError(26004, "Invalid access request status")
+	errMissingID       = pkgErrors.NewHTTPError(26005, "Access request ID is required")
+	errScopeNotFound   = pkgErrors.NewHTTPError(26006, "Scope not found")
+	errInternalSystem  = pkgErrors.NewHTTPError(26007, "Internal system error")
+)
+
+// mapError maps UseCase domain errors to HTTP errors
+func (h handler) mapError(err error) error {
+	switch {
+	case errors.Is(err, accessrequest.ErrRequestNotFound):
+		return errRequestNotFound
+	case errors.Is(err, accessrequest.ErrInvalidRole):
+		return errInvalidRole
+	case errors.Is(err, accessrequest.ErrInvalidStatus):
+		return errInvalidStatus
+	case errors.Is(err, accessrequest.ErrInternalSystem):
+		return errInternalSystem
+	default:
+		return err
+	}
+}
+
+var NotFound = []error{
+	errRequestNotFound,
+}
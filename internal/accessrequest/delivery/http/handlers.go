@@ -0,0 +1,113 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/smap-hcmut/shared-libs/go/response"
+)
+
+// List
+// @Summary List Access Requests
+// @Description List the access requests filed by logins refused because of the domain allowlist, oldest first. Requires ADMIN role.
+// @Tags Access Requests
+// @Accept json
+// @Produce json
+// @Param status query string false "pending, approved or denied"
+// @Success 200 {object} response.Resp{data=listResp} "Access requests"
+// @Failure 400 {object} response.Resp "Invalid status"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/access-requests [GET]
+// @Security CookieAuth
+func (h handler) List(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	input := h.processListRequest(c)
+
+	// 2. Call UseCase
+	requests, err := h.uc.List(ctx, input)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.List: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	response.OK(c, h.newListResp(requests))
+}
+
+// Approve
+// @Summary Approve Access Request
+// @Description Let the address log in with the chosen role from its next login, whatever its domain. access_control.user_roles still takes precedence over the role. Requires ADMIN role.
+// @Tags Access Requests
+// @Accept json
+// @Produce json
+// @Param id path string true "Access request ID"
+// @Param body body approveReq true "Role and optional note"
+// @Success 200 {object} response.Resp{data=accessRequestResp} "Approved request"
+// @Failure 400 {object} response.Resp "Invalid role"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 404 {object} response.Resp "Not Found"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/access-requests/{id}/approve [POST]
+// @Security CookieAuth
+func (h handler) Approve(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	input, sc, err := h.processApproveRequest(c)
+	if err != nil {
+		response.Error(c, err, h.discord)
+		return
+	}
+
+	// 2. Call UseCase
+	request, err := h.uc.Approve(ctx, sc, input)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.Approve: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	response.OK(c, h.newAccessRequestResp(request))
+}
+
+// Deny
+// @Summary Deny Access Request
+// @Description Refuse access. Denying an approved request takes access back at the user's next login. Requires ADMIN role.
+// @Tags Access Requests
+// @Accept json
+// @Produce json
+// @Param id path string true "Access request ID"
+// @Param body body denyReq false "Optional note"
+// @Success 200 {object} response.Resp{data=accessRequestResp} "Denied request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 404 {object} response.Resp "Not Found"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/access-requests/{id}/deny [POST]
+// @Security CookieAuth
+func (h handler) Deny(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	input, sc, err := h.processDenyRequest(c)
+	if err != nil {
+		response.Error(c, err, h.discord)
+		return
+	}
+
+	// 2. Call UseCase
+	request, err := h.uc.Deny(ctx, sc, input)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.Deny: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	response.OK(c, h.newAccessRequestResp(request))
+}
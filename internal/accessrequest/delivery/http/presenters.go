@@ -0,0 +1,84 @@
+package http
+
+import (
+	"identity-srv/internal/accessrequest"
+	"identity-srv/internal/model"
+	"strings"
+	"time"
+)
+
+// --- Request DTOs ---
+
+type approveReq struct {
+	Role   string `json:"role" binding:"required"` // ADMIN, ANALYST or VIEWER
+	Reason string `json:"reason"`
+}
+
+func (r approveReq) toInput(id string) accessrequest.ApproveInput {
+	return accessrequest.ApproveInput{
+		ID:     id,
+		Role:   strings.ToUpper(strings.TrimSpace(r.Role)),
+		Reason: strings.TrimSpace(r.Reason),
+	}
+}
+
+type denyReq struct {
+	Reason string `json:"reason"`
+}
+
+func (r denyReq) toInput(id string) accessrequest.DenyInput {
+	return accessrequest.DenyInput{
+		ID:     id,
+		Reason: strings.TrimSpace(r.Reason),
+	}
+}
+
+// --- Response DTOs ---
+
+type accessRequestResp struct {
+	ID            string     `json:"id"`
+	Email         string     `json:"email"`
+	Name          string     `json:"name,omitempty"`
+	Status        string     `json:"status"` // pending, approved, denied
+	Role          *string    `json:"role,omitempty"`
+	Reason        string     `json:"reason,omitempty"`
+	IPAddress     string     `json:"ip_address"`
+	UserAgent     string     `json:"user_agent"`
+	Attempts      int        `json:"attempts"`
+	LastAttemptAt time.Time  `json:"last_attempt_at"`
+	DecidedBy     *string    `json:"decided_by,omitempty"`
+	DecidedAt     *time.Time `json:"decided_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+type listResp struct {
+	AccessRequests []accessRequestResp `json:"access_requests"`
+}
+
+// --- Response Mappers ---
+
+func (h handler) newAccessRequestResp(o model.AccessRequest) accessRequestResp {
+	return accessRequestResp{
+		ID:            o.ID,
+		Email:         o.Email,
+		Name:          o.Name,
+		Status:        o.Status,
+		Role:          o.Role,
+		Reason:        o.Reason,
+		IPAddress:     o.IPAddress,
+		UserAgent:     o.UserAgent,
+		Attempts:      o.Attempts,
+		LastAttemptAt: o.LastAttemptAt,
+		DecidedBy:     o.DecidedBy,
+		DecidedAt:     o.DecidedAt,
+		CreatedAt:     o.CreatedAt,
+	}
+}
+
+func (h handler) newListResp(o []model.AccessRequest) listResp {
+	requests := make([]accessRequestResp, 0, len(o))
+	for _, request := range o {
+		requests = append(requests, h.newAccessRequestResp(request))
+	}
+	return listResp{AccessRequests: requests}
+}
@@ -0,0 +1,75 @@
+package http
+
+import (
+	"errors"
+	"identity-srv/internal/accessrequest"
+	"identity-srv/internal/model"
+	"io"
+
+	"github.com/gin-gonic/gin"
+	"github.com/smap-hcmut/shared-libs/go/auth"
+)
+
+// --- Scope extraction ---
+
+func (h handler) getScope(c *gin.Context) (model.Scope, bool) {
+	payload, ok := auth.GetPayloadFromContext(c.Request.Context())
+	if !ok || payload.UserID == "" {
+		return model.Scope{}, false
+	}
+	return model.Scope{
+		UserID:    payload.UserID,
+		Username:  payload.Username,
+		Role:      payload.Role,
+		JTI:       payload.Id,
+		ExpiresAt: payload.ExpiresAt,
+	}, true
+}
+
+// --- Process request functions ---
+
+func (h handler) processListRequest(c *gin.Context) accessrequest.ListInput {
+	return accessrequest.ListInput{
+		Status: c.Query("status"),
+	}
+}
+
+func (h handler) processApproveRequest(c *gin.Context) (accessrequest.ApproveInput, model.Scope, error) {
+	id, sc, err := h.processIDRequest(c)
+	if err != nil {
+		return accessrequest.ApproveInput{}, model.Scope{}, err
+	}
+
+	var req approveReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		return accessrequest.ApproveInput{}, model.Scope{}, errWrongBody
+	}
+	return req.toInput(id), sc, nil
+}
+
+// processDenyRequest reads the optional note; the body may be empty
+func (h handler) processDenyRequest(c *gin.Context) (accessrequest.DenyInput, model.Scope, error) {
+	id, sc, err := h.processIDRequest(c)
+	if err != nil {
+		return accessrequest.DenyInput{}, model.Scope{}, err
+	}
+
+	var req denyReq
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		return accessrequest.DenyInput{}, model.Scope{}, errWrongBody
+	}
+	return req.toInput(id), sc, nil
+}
+
+func (h handler) processIDRequest(c *gin.Context) (string, model.Scope, error) {
+	sc, ok := h.getScope(c)
+	if !ok {
+		return "", model.Scope{}, errScopeNotFound
+	}
+
+	id := c.Param("id")
+	if id == "" {
+		return "", model.Scope{}, errMissingID
+	}
+	return id, sc, nil
+}
@@ -0,0 +1,10 @@
+package accessrequest
+
+import "errors"
+
+var (
+	ErrRequestNotFound = errors.New("access request not found")
+	ErrInvalidRole     = errors.New("invalid role")
+	ErrInvalidStatus   = errors.New("invalid access request status")
+	ErrInternalSystem  = errors.New("internal system error")
+)
@@ -0,0 +1,19 @@
+package accessrequest
+
+import (
+	"context"
+
+	"identity-srv/internal/model"
+)
+
+//go:generate mockery --name UseCase
+type UseCase interface {
+	// Login (used by the authentication usecase)
+	Submit(ctx context.Context, ip SubmitInput) (model.AccessRequest, error)
+	FindApproved(ctx context.Context, email string) (model.AccessRequest, error)
+
+	// Admin queue
+	List(ctx context.Context, ip ListInput) ([]model.AccessRequest, error)
+	Approve(ctx context.Context, sc model.Scope, ip ApproveInput) (model.AccessRequest, error)
+	Deny(ctx context.Context, sc model.Scope, ip DenyInput) (model.AccessRequest, error)
+}
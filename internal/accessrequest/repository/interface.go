@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"identity-srv/internal/model"
+)
+
+//go:generate mockery --name Repository
+type Repository interface {
+	// Upsert files a pending request for the address, or records another
+	// attempt on its existing request; created tells which
+	Upsert(ctx context.Context, opts UpsertOptions) (request model.AccessRequest, created bool, err error)
+	List(ctx context.Context, opts ListOptions) ([]model.AccessRequest, error)
+	Detail(ctx context.Context, id string) (model.AccessRequest, error)
+	DetailByEmail(ctx context.Context, email string) (model.AccessRequest, error)
+	Decide(ctx context.Context, opts DecideOptions) (model.AccessRequest, error)
+}
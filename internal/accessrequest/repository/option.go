@@ -0,0 +1,21 @@
+package repository
+
+type UpsertOptions struct {
+	Email     string
+	Name      string
+	IPAddress string
+	UserAgent string
+}
+
+type ListOptions struct {
+	Status string // empty for all
+}
+
+// DecideOptions records an admin decision
+type DecideOptions struct {
+	ID        string
+	Status    string // model.AccessRequestStatusApproved or model.AccessRequestStatusDenied
+	Role      string // approval only
+	Reason    string
+	DecidedBy string
+}
@@ -0,0 +1,114 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"identity-srv/internal/accessrequest/repository"
+	"identity-srv/internal/model"
+	"identity-srv/internal/sqlboiler"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// Upsert files a pending request for the address, or records another attempt
+// on its existing request whatever its status
+func (r *implRepository) Upsert(ctx context.Context, opts repository.UpsertOptions) (model.AccessRequest, bool, error) {
+	existing, err := sqlboiler.AccessRequests(
+		sqlboiler.AccessRequestWhere.Email.EQ(opts.Email),
+	).One(ctx, r.db)
+	if err == nil {
+		now := r.clock()
+		existing.Attempts++
+		existing.LastAttemptAt = now
+		existing.IPAddress = opts.IPAddress
+		existing.UserAgent = opts.UserAgent
+		if opts.Name != "" {
+			existing.Name = opts.Name
+		}
+		existing.UpdatedAt = now
+		if _, err := existing.Update(ctx, r.db, boil.Whitelist(
+			sqlboiler.AccessRequestColumns.Attempts,
+			sqlboiler.AccessRequestColumns.LastAttemptAt,
+			sqlboiler.AccessRequestColumns.IPAddress,
+			sqlboiler.AccessRequestColumns.UserAgent,
+			sqlboiler.AccessRequestColumns.Name,
+			sqlboiler.AccessRequestColumns.UpdatedAt,
+		)); err != nil {
+			r.l.Errorf(ctx, "accessrequest.repository.postgres.Upsert.Update: %v", err)
+			return model.AccessRequest{}, false, err
+		}
+		return *model.NewAccessRequestFromDB(existing), false, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		r.l.Errorf(ctx, "accessrequest.repository.postgres.Upsert.One: %v", err)
+		return model.AccessRequest{}, false, err
+	}
+
+	request := r.buildAccessRequest(opts)
+	if err := request.Insert(ctx, r.db, boil.Infer()); err != nil {
+		r.l.Errorf(ctx, "accessrequest.repository.postgres.Upsert.Insert: %v", err)
+		return model.AccessRequest{}, false, err
+	}
+	return *model.NewAccessRequestFromDB(request), true, nil
+}
+
+// List returns the requests with the status, oldest first so the queue is
+// worked in order
+func (r *implRepository) List(ctx context.Context, opts repository.ListOptions) ([]model.AccessRequest, error) {
+	mods := []qm.QueryMod{qm.OrderBy(sqlboiler.AccessRequestColumns.CreatedAt)}
+	if opts.Status != "" {
+		mods = append(mods, sqlboiler.AccessRequestWhere.Status.EQ(opts.Status))
+	}
+
+	requests, err := sqlboiler.AccessRequests(mods...).All(ctx, r.db)
+	if err != nil {
+		r.l.Errorf(ctx, "accessrequest.repository.postgres.List: %v", err)
+		return nil, err
+	}
+
+	result := make([]model.AccessRequest, 0, len(requests))
+	for _, request := range requests {
+		result = append(result, *model.NewAccessRequestFromDB(request))
+	}
+	return result, nil
+}
+
+// Detail finds a request by ID
+func (r *implRepository) Detail(ctx context.Context, id string) (model.AccessRequest, error) {
+	return r.detail(ctx, "Detail", sqlboiler.AccessRequestWhere.ID.EQ(id))
+}
+
+// DetailByEmail finds the request of an address
+func (r *implRepository) DetailByEmail(ctx context.Context, email string) (model.AccessRequest, error) {
+	return r.detail(ctx, "DetailByEmail", sqlboiler.AccessRequestWhere.Email.EQ(email))
+}
+
+func (r *implRepository) detail(ctx context.Context, method string, mods ...qm.QueryMod) (model.AccessRequest, error) {
+	request, err := sqlboiler.AccessRequests(mods...).One(ctx, r.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.AccessRequest{}, repository.ErrNotFound
+		}
+		r.l.Errorf(ctx, "accessrequest.repository.postgres.%s: %v", method, err)
+		return model.AccessRequest{}, err
+	}
+	return *model.NewAccessRequestFromDB(request), nil
+}
+
+// Decide records an approval or a denial
+func (r *implRepository) Decide(ctx context.Context, opts repository.DecideOptions) (model.AccessRequest, error) {
+	rows, err := sqlboiler.AccessRequests(
+		sqlboiler.AccessRequestWhere.ID.EQ(opts.ID),
+	).UpdateAll(ctx, r.db, r.buildDecideColumns(opts))
+	if err != nil {
+		r.l.Errorf(ctx, "accessrequest.repository.postgres.Decide: %v", err)
+		return model.AccessRequest{}, err
+	}
+	if rows == 0 {
+		return model.AccessRequest{}, repository.ErrNotFound
+	}
+	return r.Detail(ctx, opts.ID)
+}
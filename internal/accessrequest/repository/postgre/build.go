@@ -0,0 +1,39 @@
+package postgres
+
+import (
+	"identity-srv/internal/accessrequest/repository"
+	"identity-srv/internal/model"
+	"identity-srv/internal/sqlboiler"
+
+	"github.com/aarondl/null/v8"
+	"github.com/smap-hcmut/shared-libs/go/postgres"
+)
+
+func (r *implRepository) buildAccessRequest(opts repository.UpsertOptions) *sqlboiler.AccessRequest {
+	now := r.clock()
+	return &sqlboiler.AccessRequest{
+		ID:            postgres.NewUUID(),
+		Email:         opts.Email,
+		Name:          opts.Name,
+		Status:        model.AccessRequestStatusPending,
+		IPAddress:     opts.IPAddress,
+		UserAgent:     opts.UserAgent,
+		Attempts:      1,
+		LastAttemptAt: now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+}
+
+func (r *implRepository) buildDecideColumns(opts repository.DecideOptions) sqlboiler.M {
+	now := r.clock()
+	cols := sqlboiler.M{
+		sqlboiler.AccessRequestColumns.Status:    opts.Status,
+		sqlboiler.AccessRequestColumns.Reason:    opts.Reason,
+		sqlboiler.AccessRequestColumns.DecidedAt: null.TimeFrom(now),
+		sqlboiler.AccessRequestColumns.UpdatedAt: now,
+		sqlboiler.AccessRequestColumns.Role:      null.NewString(opts.Role, opts.Role != ""),
+		sqlboiler.AccessRequestColumns.DecidedBy: null.NewString(opts.DecidedBy, opts.DecidedBy != ""),
+	}
+	return cols
+}
@@ -0,0 +1,27 @@
+package accessrequest
+
+// SubmitInput describes a login refused by the domain allowlist
+type SubmitInput struct {
+	Email     string // verified by the OAuth provider
+	Name      string
+	IPAddress string
+	UserAgent string
+}
+
+// ListInput filters the admin queue
+type ListInput struct {
+	Status string // model.AccessRequestStatus*, optional
+}
+
+// ApproveInput grants access with a role
+type ApproveInput struct {
+	ID     string
+	Role   string // ADMIN, ANALYST or VIEWER
+	Reason string // optional note
+}
+
+// DenyInput refuses access
+type DenyInput struct {
+	ID     string
+	Reason string // optional note
+}
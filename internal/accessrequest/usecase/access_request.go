@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"identity-srv/internal/accessrequest"
+	"identity-srv/internal/accessrequest/repository"
+	"identity-srv/internal/model"
+)
+
+// Submit files an access request for a refused login. Admins are notified of
+// new requests only; further attempts are counted on the same request.
+func (u *usecase) Submit(ctx context.Context, ip accessrequest.SubmitInput) (model.AccessRequest, error) {
+	request, created, err := u.repo.Upsert(ctx, repository.UpsertOptions{
+		Email:     normalizeEmail(ip.Email),
+		Name:      ip.Name,
+		IPAddress: ip.IPAddress,
+		UserAgent: ip.UserAgent,
+	})
+	if err != nil {
+		u.l.Errorf(ctx, "accessrequest.usecase.Submit.Upsert: %v", err)
+		return model.AccessRequest{}, fmt.Errorf("%w: %v", accessrequest.ErrInternalSystem, err)
+	}
+
+	if created {
+		u.l.Infof(ctx, "Access request filed: Email=%s ID=%s", request.Email, request.ID)
+		u.notifyAdmins(ctx, request)
+	}
+	return request, nil
+}
+
+// FindApproved returns the approved request of the address
+func (u *usecase) FindApproved(ctx context.Context, email string) (model.AccessRequest, error) {
+	request, err := u.repo.DetailByEmail(ctx, normalizeEmail(email))
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return model.AccessRequest{}, accessrequest.ErrRequestNotFound
+		}
+		u.l.Errorf(ctx, "accessrequest.usecase.FindApproved.DetailByEmail: %v", err)
+		return model.AccessRequest{}, fmt.Errorf("%w: %v", accessrequest.ErrInternalSystem, err)
+	}
+	if request.Status != model.AccessRequestStatusApproved || request.Role == nil {
+		return model.AccessRequest{}, accessrequest.ErrRequestNotFound
+	}
+	return request, nil
+}
+
+// List returns the queue, oldest first
+func (u *usecase) List(ctx context.Context, ip accessrequest.ListInput) ([]model.AccessRequest, error) {
+	if err := validateStatus(ip.Status); err != nil {
+		return nil, err
+	}
+	requests, err := u.repo.List(ctx, repository.ListOptions{Status: ip.Status})
+	if err != nil {
+		u.l.Errorf(ctx, "accessrequest.usecase.List.List: %v", err)
+		return nil, fmt.Errorf("%w: %v", accessrequest.ErrInternalSystem, err)
+	}
+	return requests, nil
+}
+
+// Approve lets the address log in with the role from its next login. A denied
+// request can be approved later, and an approved one denied to take access back.
+func (u *usecase) Approve(ctx context.Context, sc model.Scope, ip accessrequest.ApproveInput) (model.AccessRequest, error) {
+	if err := validateRole(ip.Role); err != nil {
+		return model.AccessRequest{}, err
+	}
+	return u.decide(ctx, "Approve", sc, repository.DecideOptions{
+		ID:     ip.ID,
+		Status: model.AccessRequestStatusApproved,
+		Role:   ip.Role,
+		Reason: ip.Reason,
+	})
+}
+
+// Deny refuses access. Later refused logins are counted on the request but do
+// not notify admins again.
+func (u *usecase) Deny(ctx context.Context, sc model.Scope, ip accessrequest.DenyInput) (model.AccessRequest, error) {
+	return u.decide(ctx, "Deny", sc, repository.DecideOptions{
+		ID:     ip.ID,
+		Status: model.AccessRequestStatusDenied,
+		Reason: ip.Reason,
+	})
+}
+
+func (u *usecase) decide(ctx context.Context, method string, sc model.Scope, opts repository.DecideOptions) (model.AccessRequest, error) {
+	opts.DecidedBy = sc.UserID
+	request, err := u.repo.Decide(ctx, opts)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return model.AccessRequest{}, accessrequest.ErrRequestNotFound
+		}
+		u.l.Errorf(ctx, "accessrequest.usecase.%s.Decide: %v", method, err)
+		return model.AccessRequest{}, fmt.Errorf("%w: %v", accessrequest.ErrInternalSystem, err)
+	}
+
+	u.l.Infof(ctx, "Access request %s: Email=%s Role=%s By=%s", opts.Status, request.Email, opts.Role, sc.UserID)
+	return request, nil
+}
@@ -0,0 +1,145 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"identity-srv/internal/accessrequest"
+	"identity-srv/internal/accessrequest/repository"
+	"identity-srv/internal/model"
+
+	"github.com/smap-hcmut/shared-libs/go/log"
+)
+
+type testLogger struct{ log.Logger }
+
+func (testLogger) Infof(context.Context, string, ...any)  {}
+func (testLogger) Errorf(context.Context, string, ...any) {}
+
+// fakeRepo keeps requests in memory. Decide writes the same columns as the
+// postgres repository: a denial clears the role.
+type fakeRepo struct {
+	repository.Repository
+	requests map[string]*model.AccessRequest
+	decided  int
+}
+
+func (r *fakeRepo) DetailByEmail(_ context.Context, email string) (model.AccessRequest, error) {
+	for _, request := range r.requests {
+		if request.Email == email {
+			return *request, nil
+		}
+	}
+	return model.AccessRequest{}, repository.ErrNotFound
+}
+
+func (r *fakeRepo) Decide(_ context.Context, opts repository.DecideOptions) (model.AccessRequest, error) {
+	r.decided++
+	request, ok := r.requests[opts.ID]
+	if !ok {
+		return model.AccessRequest{}, repository.ErrNotFound
+	}
+	request.Status = opts.Status
+	request.Reason = opts.Reason
+	request.Role = nil
+	if opts.Role != "" {
+		role := opts.Role
+		request.Role = &role
+	}
+	request.DecidedBy = &opts.DecidedBy
+	return *request, nil
+}
+
+func newTestUsecase() (*usecase, *fakeRepo) {
+	repo := &fakeRepo{requests: map[string]*model.AccessRequest{
+		"req": {ID: "req", Email: "guest@example.com", Status: model.AccessRequestStatusPending},
+	}}
+	return &usecase{l: testLogger{}, repo: repo}, repo
+}
+
+func TestDecideTransitions(t *testing.T) {
+	ctx := context.Background()
+	sc := model.Scope{UserID: "admin-1"}
+	uc, _ := newTestUsecase()
+
+	if _, err := uc.FindApproved(ctx, "guest@example.com"); !errors.Is(err, accessrequest.ErrRequestNotFound) {
+		t.Fatalf("FindApproved() on a pending request error = %v, want %v", err, accessrequest.ErrRequestNotFound)
+	}
+
+	steps := []struct {
+		name     string
+		decide   func() (model.AccessRequest, error)
+		wantRole string // empty when FindApproved must refuse
+	}{
+		{
+			name: "approve pending",
+			decide: func() (model.AccessRequest, error) {
+				return uc.Approve(ctx, sc, accessrequest.ApproveInput{ID: "req", Role: model.RoleAnalyst})
+			},
+			wantRole: model.RoleAnalyst,
+		},
+		{
+			name: "deny approved",
+			decide: func() (model.AccessRequest, error) {
+				return uc.Deny(ctx, sc, accessrequest.DenyInput{ID: "req", Reason: "contract ended"})
+			},
+		},
+		{
+			name: "approve denied",
+			decide: func() (model.AccessRequest, error) {
+				return uc.Approve(ctx, sc, accessrequest.ApproveInput{ID: "req", Role: model.RoleViewer})
+			},
+			wantRole: model.RoleViewer,
+		},
+	}
+	for _, step := range steps {
+		t.Run(step.name, func(t *testing.T) {
+			request, err := step.decide()
+			if err != nil {
+				t.Fatalf("decide error = %v", err)
+			}
+			if request.DecidedBy == nil || *request.DecidedBy != sc.UserID {
+				t.Errorf("DecidedBy = %v, want %q", request.DecidedBy, sc.UserID)
+			}
+
+			approved, err := uc.FindApproved(ctx, " Guest@Example.com ")
+			if step.wantRole == "" {
+				if !errors.Is(err, accessrequest.ErrRequestNotFound) {
+					t.Errorf("FindApproved() error = %v, want %v", err, accessrequest.ErrRequestNotFound)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FindApproved() error = %v", err)
+			}
+			if approved.Role == nil || *approved.Role != step.wantRole {
+				t.Errorf("FindApproved() role = %v, want %q", approved.Role, step.wantRole)
+			}
+		})
+	}
+}
+
+func TestDecideRefused(t *testing.T) {
+	ctx := context.Background()
+	sc := model.Scope{UserID: "admin-1"}
+
+	t.Run("invalid role", func(t *testing.T) {
+		uc, repo := newTestUsecase()
+		_, err := uc.Approve(ctx, sc, accessrequest.ApproveInput{ID: "req", Role: "OWNER"})
+		if !errors.Is(err, accessrequest.ErrInvalidRole) {
+			t.Errorf("Approve() error = %v, want %v", err, accessrequest.ErrInvalidRole)
+		}
+		if repo.decided != 0 {
+			t.Errorf("Decide called %d times, want 0", repo.decided)
+		}
+	})
+
+	t.Run("unknown request", func(t *testing.T) {
+		uc, _ := newTestUsecase()
+		_, err := uc.Deny(ctx, sc, accessrequest.DenyInput{ID: "missing"})
+		if !errors.Is(err, accessrequest.ErrRequestNotFound) {
+			t.Errorf("Deny() error = %v, want %v", err, accessrequest.ErrRequestNotFound)
+		}
+	})
+}
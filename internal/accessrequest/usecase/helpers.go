@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"identity-srv/internal/accessrequest"
+	"identity-srv/internal/model"
+)
+
+func validateRole(role string) error {
+	switch role {
+	case model.RoleAdmin, model.RoleAnalyst, model.RoleViewer:
+		return nil
+	default:
+		return accessrequest.ErrInvalidRole
+	}
+}
+
+func validateStatus(status string) error {
+	switch status {
+	case "", model.AccessRequestStatusPending, model.AccessRequestStatusApproved, model.AccessRequestStatusDenied:
+		return nil
+	default:
+		return accessrequest.ErrInvalidStatus
+	}
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// notifyAdmins posts a new request to Discord. A failure is only logged: the
+// request stays in the queue.
+func (u *usecase) notifyAdmins(ctx context.Context, request model.AccessRequest) {
+	if u.discord == nil {
+		return
+	}
+	name := request.Name
+	if name == "" {
+		name = "-"
+	}
+	message := fmt.Sprintf("**Access request**\nEmail: %s\nName: %s\nIP: %s\nReview it with GET /authentication/access-requests?status=pending (ID %s)",
+		request.Email, name, request.IPAddress, request.ID)
+	if err := u.discord.ReportBug(ctx, message); err != nil {
+		u.l.Errorf(ctx, "accessrequest.usecase.notifyAdmins: %v", err)
+	}
+}
@@ -0,0 +1,25 @@
+package usecase
+
+import (
+	"identity-srv/internal/accessrequest"
+	"identity-srv/internal/accessrequest/repository"
+
+	"github.com/smap-hcmut/shared-libs/go/discord"
+	"github.com/smap-hcmut/shared-libs/go/log"
+)
+
+type usecase struct {
+	l       log.Logger
+	repo    repository.Repository
+	discord discord.IDiscord
+}
+
+// New creates the access request usecase. d tells admins about new requests;
+// nil leaves them to the queue API.
+func New(l log.Logger, repo repository.Repository, d discord.IDiscord) accessrequest.UseCase {
+	return &usecase{
+		l:       l,
+		repo:    repo,
+		discord: d,
+	}
+}
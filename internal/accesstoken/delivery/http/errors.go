@@ -0,0 +1,46 @@
+package http
+
+import (
+	"errors"
+	"identity-srv/internal/accesstoken"
+
+	pkgErrors "github.com/smap-hcmut/shared-libs/go/errors"
+)
+
+// --- HTTP error constants ---
+
+var (
+	errWrongBody      = pkgErrors.NewHTTPError(21001, "Wrong body")
+	errTokenNotFound  = pkgErrors.NewHTTPError(21002, "Access token not found")
+	errInvalidName    = pkgErrors.NewHTTPError(21003, "Invalid token name")
+	errInvalidScope   = pkgErrors.NewHTTPError(21004, "Invalid scope")
+	errInvalidExpiry  = pkgErrors.NewHTTPError(21005, "Invalid token expiry")
+	errTooManyTokens  = pkgErrors.NewHTTPError(21006, "Too many access tokens")
+	errMissingID      = pkgErrors.NewHTTPError(21007, "Token ID is required")
+	errInternalSystem = pkgErrors.NewHTTPError(21008, "Internal system error")
+	errScopeNotFound  = pkgErrors.NewHTTPError(21009, "Scope not found")
+)
+
+// mapError maps UseCase domain errors to HTTP errors
+func (h handler) mapError(err error) error {
+	switch {
+	case errors.Is(err, accesstoken.ErrTokenNotFound):
+		return errTokenNotFound
+	case errors.Is(err, accesstoken.ErrInvalidName):
+		return errInvalidName
+	case errors.Is(err, accesstoken.ErrInvalidScope):
+		return errInvalidScope
+	case errors.Is(err, accesstoken.ErrInvalidExpiry):
+		return errInvalidExpiry
+	case errors.Is(err, accesstoken.ErrTooManyTokens):
+		return errTooManyTokens
+	case errors.Is(err, accesstoken.ErrInternalSystem):
+		return errInternalSystem
+	default:
+		return err
+	}
+}
+
+var NotFound = []error{
+	errTokenNotFound,
+}
@@ -0,0 +1,109 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/smap-hcmut/shared-libs/go/response"
+)
+
+// Create
+// @Summary Create Personal Access Token
+// @Description Create a personal access token for CLI/script use. The plaintext token is returned only once.
+// @Tags Access Tokens
+// @Accept json
+// @Produce json
+// @Param body body createReq true "Token name, scopes and expiry"
+// @Success 200 {object} response.Resp{data=createResp} "Created token (plaintext shown once)"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/tokens [POST]
+// @Security CookieAuth
+func (h handler) Create(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	input, sc, err := h.processCreateRequest(c)
+	if err != nil {
+		response.Error(c, err, h.discord)
+		return
+	}
+
+	// 2. Call UseCase
+	output, err := h.uc.Create(ctx, sc, input)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.Create: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	response.OK(c, h.newCreateResp(output))
+}
+
+// List
+// @Summary List Personal Access Tokens
+// @Description List the current user's personal access tokens (without secrets).
+// @Tags Access Tokens
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.Resp{data=listResp} "Tokens"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/tokens [GET]
+// @Security CookieAuth
+func (h handler) List(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	sc, err := h.processListRequest(c)
+	if err != nil {
+		response.Error(c, err, h.discord)
+		return
+	}
+
+	// 2. Call UseCase
+	tokens, err := h.uc.List(ctx, sc)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.List: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	response.OK(c, h.newListResp(tokens))
+}
+
+// Revoke
+// @Summary Revoke Personal Access Token
+// @Description Revoke one of the current user's personal access tokens.
+// @Tags Access Tokens
+// @Accept json
+// @Produce json
+// @Param id path string true "Token ID"
+// @Success 200 {object} response.Resp "Token revoked"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 404 {object} response.Resp "Not Found"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/tokens/{id} [DELETE]
+// @Security CookieAuth
+func (h handler) Revoke(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	id, sc, err := h.processRevokeRequest(c)
+	if err != nil {
+		response.Error(c, err, h.discord)
+		return
+	}
+
+	// 2. Call UseCase
+	if err := h.uc.Revoke(ctx, sc, id); err != nil {
+		h.l.Errorf(ctx, "uc.Revoke: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	response.OK(c, nil)
+}
@@ -0,0 +1,82 @@
+package http
+
+import (
+	"identity-srv/internal/accesstoken"
+	"identity-srv/internal/model"
+	"time"
+)
+
+// --- Request DTOs ---
+
+type createReq struct {
+	Name          string   `json:"name" binding:"required"`
+	Scopes        []string `json:"scopes"`
+	ExpiresInDays int      `json:"expires_in_days"` // 0 uses the server default
+}
+
+func (r createReq) validate() error {
+	if r.ExpiresInDays < 0 {
+		return errInvalidExpiry
+	}
+	return nil
+}
+
+func (r createReq) toInput() accesstoken.CreateInput {
+	return accesstoken.CreateInput{
+		Name:      r.Name,
+		Scopes:    r.Scopes,
+		ExpiresIn: time.Duration(r.ExpiresInDays) * 24 * time.Hour,
+	}
+}
+
+// --- Response DTOs ---
+
+type accessTokenResp struct {
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	TokenPrefix string     `json:"token_prefix"`
+	Scopes      []string   `json:"scopes"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt  *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt   *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+type createResp struct {
+	Token string `json:"token"` // plaintext, shown once
+	accessTokenResp
+}
+
+type listResp struct {
+	Tokens []accessTokenResp `json:"tokens"`
+}
+
+// --- Response Mappers ---
+
+func (h handler) newAccessTokenResp(o model.AccessToken) accessTokenResp {
+	return accessTokenResp{
+		ID:          o.ID,
+		Name:        o.Name,
+		TokenPrefix: o.TokenPrefix,
+		Scopes:      o.Scopes,
+		ExpiresAt:   o.ExpiresAt,
+		LastUsedAt:  o.LastUsedAt,
+		RevokedAt:   o.RevokedAt,
+		CreatedAt:   o.CreatedAt,
+	}
+}
+
+func (h handler) newCreateResp(o accesstoken.CreateOutput) createResp {
+	return createResp{
+		Token:           o.Token,
+		accessTokenResp: h.newAccessTokenResp(o.AccessToken),
+	}
+}
+
+func (h handler) newListResp(o []model.AccessToken) listResp {
+	tokens := make([]accessTokenResp, 0, len(o))
+	for _, token := range o {
+		tokens = append(tokens, h.newAccessTokenResp(token))
+	}
+	return listResp{Tokens: tokens}
+}
@@ -0,0 +1,65 @@
+package http
+
+import (
+	"identity-srv/internal/accesstoken"
+	"identity-srv/internal/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/smap-hcmut/shared-libs/go/auth"
+)
+
+// --- Scope extraction ---
+
+func (h handler) getScope(c *gin.Context) (model.Scope, bool) {
+	payload, ok := auth.GetPayloadFromContext(c.Request.Context())
+	if !ok || payload.UserID == "" {
+		return model.Scope{}, false
+	}
+	return model.Scope{
+		UserID:    payload.UserID,
+		Username:  payload.Username,
+		Role:      payload.Role,
+		JTI:       payload.Id,
+		ExpiresAt: payload.ExpiresAt,
+	}, true
+}
+
+// --- Process request functions ---
+
+func (h handler) processCreateRequest(c *gin.Context) (accesstoken.CreateInput, model.Scope, error) {
+	sc, ok := h.getScope(c)
+	if !ok {
+		return accesstoken.CreateInput{}, model.Scope{}, errScopeNotFound
+	}
+
+	var req createReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		return accesstoken.CreateInput{}, model.Scope{}, errWrongBody
+	}
+	if err := req.validate(); err != nil {
+		return accesstoken.CreateInput{}, model.Scope{}, err
+	}
+
+	return req.toInput(), sc, nil
+}
+
+func (h handler) processListRequest(c *gin.Context) (model.Scope, error) {
+	sc, ok := h.getScope(c)
+	if !ok {
+		return model.Scope{}, errScopeNotFound
+	}
+	return sc, nil
+}
+
+func (h handler) processRevokeRequest(c *gin.Context) (string, model.Scope, error) {
+	sc, ok := h.getScope(c)
+	if !ok {
+		return "", model.Scope{}, errScopeNotFound
+	}
+
+	id := c.Param("id")
+	if id == "" {
+		return "", model.Scope{}, errMissingID
+	}
+	return id, sc, nil
+}
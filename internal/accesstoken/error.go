@@ -0,0 +1,13 @@
+package accesstoken
+
+import "errors"
+
+var (
+	ErrTokenNotFound  = errors.New("access token not found")
+	ErrInvalidToken   = errors.New("invalid access token")
+	ErrInvalidName    = errors.New("invalid token name")
+	ErrInvalidScope   = errors.New("invalid scope")
+	ErrInvalidExpiry  = errors.New("invalid token expiry")
+	ErrTooManyTokens  = errors.New("too many access tokens")
+	ErrInternalSystem = errors.New("internal system error")
+)
@@ -0,0 +1,18 @@
+package accesstoken
+
+import (
+	"context"
+
+	"identity-srv/internal/model"
+)
+
+//go:generate mockery --name UseCase
+type UseCase interface {
+	// Self-service management (scoped to the caller)
+	Create(ctx context.Context, sc model.Scope, ip CreateInput) (CreateOutput, error)
+	List(ctx context.Context, sc model.Scope) ([]model.AccessToken, error)
+	Revoke(ctx context.Context, sc model.Scope, id string) error
+
+	// Validation (used by authentication.ValidateToken)
+	Validate(ctx context.Context, token string) (model.AccessToken, error)
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"identity-srv/internal/model"
+)
+
+//go:generate mockery --name Repository
+type Repository interface {
+	Create(ctx context.Context, opts CreateOptions) (model.AccessToken, error)
+	List(ctx context.Context, opts ListOptions) ([]model.AccessToken, error)
+	Count(ctx context.Context, opts ListOptions) (int64, error)
+	DetailByHash(ctx context.Context, secretHash string) (model.AccessToken, error)
+	Revoke(ctx context.Context, opts RevokeOptions) error
+	TouchLastUsed(ctx context.Context, opts TouchLastUsedOptions) error
+}
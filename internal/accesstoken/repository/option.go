@@ -0,0 +1,29 @@
+package repository
+
+import "time"
+
+type CreateOptions struct {
+	UserID      string
+	Name        string
+	TokenPrefix string
+	SecretHash  string
+	Scopes      []string
+	ExpiresAt   *time.Time
+}
+
+type ListOptions struct {
+	UserID     string
+	ActiveOnly bool // exclude revoked and expired tokens
+}
+
+type RevokeOptions struct {
+	ID     string
+	UserID string
+}
+
+type TouchLastUsedOptions struct {
+	ID string
+	// MinInterval skips the write when last_used_at is more recent, so
+	// validation does not cost one UPDATE per request
+	MinInterval time.Duration
+}
@@ -0,0 +1,105 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"identity-srv/internal/accesstoken/repository"
+	"identity-srv/internal/model"
+	"identity-srv/internal/sqlboiler"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// Create inserts a new personal access token
+func (r *implRepository) Create(ctx context.Context, opts repository.CreateOptions) (model.AccessToken, error) {
+	token := r.buildAccessToken(opts)
+	if err := token.Insert(ctx, r.db, boil.Infer()); err != nil {
+		r.l.Errorf(ctx, "accesstoken.repository.postgres.Create: %v", err)
+		return model.AccessToken{}, err
+	}
+	return *model.NewAccessTokenFromDB(token), nil
+}
+
+// List returns a user's tokens, newest first
+func (r *implRepository) List(ctx context.Context, opts repository.ListOptions) ([]model.AccessToken, error) {
+	mods := append(r.buildListQuery(opts), qm.OrderBy(sqlboiler.PersonalAccessTokenColumns.CreatedAt+" DESC"))
+
+	tokens, err := sqlboiler.PersonalAccessTokens(mods...).All(ctx, r.db)
+	if err != nil {
+		r.l.Errorf(ctx, "accesstoken.repository.postgres.List: %v", err)
+		return nil, err
+	}
+
+	result := make([]model.AccessToken, 0, len(tokens))
+	for _, token := range tokens {
+		result = append(result, *model.NewAccessTokenFromDB(token))
+	}
+	return result, nil
+}
+
+// Count returns the number of a user's tokens
+func (r *implRepository) Count(ctx context.Context, opts repository.ListOptions) (int64, error) {
+	count, err := sqlboiler.PersonalAccessTokens(r.buildListQuery(opts)...).Count(ctx, r.db)
+	if err != nil {
+		r.l.Errorf(ctx, "accesstoken.repository.postgres.Count: %v", err)
+		return 0, err
+	}
+	return count, nil
+}
+
+// DetailByHash finds a token by the hash of its secret
+func (r *implRepository) DetailByHash(ctx context.Context, secretHash string) (model.AccessToken, error) {
+	token, err := sqlboiler.PersonalAccessTokens(
+		sqlboiler.PersonalAccessTokenWhere.SecretHash.EQ(secretHash),
+	).One(ctx, r.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.AccessToken{}, repository.ErrNotFound
+		}
+		r.l.Errorf(ctx, "accesstoken.repository.postgres.DetailByHash: %v", err)
+		return model.AccessToken{}, err
+	}
+	return *model.NewAccessTokenFromDB(token), nil
+}
+
+// Revoke marks a user's token as revoked
+func (r *implRepository) Revoke(ctx context.Context, opts repository.RevokeOptions) error {
+	rows, err := sqlboiler.PersonalAccessTokens(
+		sqlboiler.PersonalAccessTokenWhere.ID.EQ(opts.ID),
+		sqlboiler.PersonalAccessTokenWhere.UserID.EQ(opts.UserID),
+		sqlboiler.PersonalAccessTokenWhere.RevokedAt.IsNull(),
+	).UpdateAll(ctx, r.db, sqlboiler.M{
+		sqlboiler.PersonalAccessTokenColumns.RevokedAt: null.TimeFrom(r.clock()),
+	})
+	if err != nil {
+		r.l.Errorf(ctx, "accesstoken.repository.postgres.Revoke: %v", err)
+		return err
+	}
+	if rows == 0 {
+		return repository.ErrNotFound
+	}
+	return nil
+}
+
+// TouchLastUsed records a use of the token, at most once per MinInterval
+func (r *implRepository) TouchLastUsed(ctx context.Context, opts repository.TouchLastUsedOptions) error {
+	now := r.clock()
+	_, err := sqlboiler.PersonalAccessTokens(
+		sqlboiler.PersonalAccessTokenWhere.ID.EQ(opts.ID),
+		qm.Expr(
+			sqlboiler.PersonalAccessTokenWhere.LastUsedAt.IsNull(),
+			qm.Or2(sqlboiler.PersonalAccessTokenWhere.LastUsedAt.LT(null.TimeFrom(now.Add(-opts.MinInterval)))),
+		),
+	).UpdateAll(ctx, r.db, sqlboiler.M{
+		sqlboiler.PersonalAccessTokenColumns.LastUsedAt: null.TimeFrom(now),
+	})
+	if err != nil {
+		r.l.Errorf(ctx, "accesstoken.repository.postgres.TouchLastUsed: %v", err)
+		return err
+	}
+	return nil
+}
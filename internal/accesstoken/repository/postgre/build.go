@@ -0,0 +1,46 @@
+package postgres
+
+import (
+	"identity-srv/internal/accesstoken/repository"
+	"identity-srv/internal/sqlboiler"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/types"
+	"github.com/smap-hcmut/shared-libs/go/postgres"
+)
+
+func (r *implRepository) buildAccessToken(opts repository.CreateOptions) *sqlboiler.PersonalAccessToken {
+	token := &sqlboiler.PersonalAccessToken{
+		ID:          postgres.NewUUID(),
+		UserID:      opts.UserID,
+		Name:        opts.Name,
+		TokenPrefix: opts.TokenPrefix,
+		SecretHash:  opts.SecretHash,
+		Scopes:      types.StringArray(opts.Scopes),
+		CreatedAt:   r.clock(),
+	}
+	if token.Scopes == nil {
+		token.Scopes = types.StringArray{}
+	}
+	if opts.ExpiresAt != nil {
+		token.ExpiresAt = null.TimeFrom(*opts.ExpiresAt)
+	}
+	return token
+}
+
+func (r *implRepository) buildListQuery(opts repository.ListOptions) []qm.QueryMod {
+	mods := []qm.QueryMod{
+		sqlboiler.PersonalAccessTokenWhere.UserID.EQ(opts.UserID),
+	}
+	if opts.ActiveOnly {
+		mods = append(mods,
+			sqlboiler.PersonalAccessTokenWhere.RevokedAt.IsNull(),
+			qm.Expr(
+				sqlboiler.PersonalAccessTokenWhere.ExpiresAt.IsNull(),
+				qm.Or2(sqlboiler.PersonalAccessTokenWhere.ExpiresAt.GT(null.TimeFrom(r.clock()))),
+			),
+		)
+	}
+	return mods
+}
@@ -0,0 +1,18 @@
+package accesstoken
+
+import (
+	"time"
+
+	"identity-srv/internal/model"
+)
+
+type CreateInput struct {
+	Name      string
+	Scopes    []string
+	ExpiresIn time.Duration // 0 uses the configured default
+}
+
+type CreateOutput struct {
+	Token       string // plaintext, shown once
+	AccessToken model.AccessToken
+}
@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"identity-srv/internal/accesstoken"
+	"identity-srv/internal/accesstoken/repository"
+	"identity-srv/internal/model"
+)
+
+// Create issues a new personal access token for the caller.
+// The plaintext token is only returned here; Postgres keeps its hash.
+func (u *usecase) Create(ctx context.Context, sc model.Scope, ip accesstoken.CreateInput) (accesstoken.CreateOutput, error) {
+	name := strings.TrimSpace(ip.Name)
+	if name == "" || len(name) > maxNameLength {
+		return accesstoken.CreateOutput{}, accesstoken.ErrInvalidName
+	}
+
+	scopes, err := normalizeScopes(ip.Scopes)
+	if err != nil {
+		return accesstoken.CreateOutput{}, err
+	}
+
+	ttl := ip.ExpiresIn
+	if ttl == 0 {
+		ttl = u.defaultTTL
+	}
+	if ttl < 0 || ttl > u.maxTTL {
+		return accesstoken.CreateOutput{}, accesstoken.ErrInvalidExpiry
+	}
+
+	count, err := u.repo.Count(ctx, repository.ListOptions{UserID: sc.UserID, ActiveOnly: true})
+	if err != nil {
+		u.l.Errorf(ctx, "accesstoken.usecase.Create.Count: %v", err)
+		return accesstoken.CreateOutput{}, fmt.Errorf("%w: %v", accesstoken.ErrInternalSystem, err)
+	}
+	if u.maxPerUser > 0 && count >= int64(u.maxPerUser) {
+		return accesstoken.CreateOutput{}, accesstoken.ErrTooManyTokens
+	}
+
+	token, err := generateToken()
+	if err != nil {
+		u.l.Errorf(ctx, "accesstoken.usecase.Create.generateToken: %v", err)
+		return accesstoken.CreateOutput{}, fmt.Errorf("%w: %v", accesstoken.ErrInternalSystem, err)
+	}
+
+	expiresAt := u.clock().Add(ttl)
+	created, err := u.repo.Create(ctx, repository.CreateOptions{
+		UserID:      sc.UserID,
+		Name:        name,
+		TokenPrefix: token[:len(model.AccessTokenPrefix)+displayPrefixLength],
+		SecretHash:  hashToken(token),
+		Scopes:      scopes,
+		ExpiresAt:   &expiresAt,
+	})
+	if err != nil {
+		u.l.Errorf(ctx, "accesstoken.usecase.Create.Create: %v", err)
+		return accesstoken.CreateOutput{}, fmt.Errorf("%w: %v", accesstoken.ErrInternalSystem, err)
+	}
+
+	u.l.Infof(ctx, "Personal access token created: ID=%s UserID=%s", created.ID, sc.UserID)
+	return accesstoken.CreateOutput{
+		Token:       token,
+		AccessToken: created,
+	}, nil
+}
+
+// List returns all of the caller's tokens, including revoked and expired ones
+func (u *usecase) List(ctx context.Context, sc model.Scope) ([]model.AccessToken, error) {
+	tokens, err := u.repo.List(ctx, repository.ListOptions{UserID: sc.UserID})
+	if err != nil {
+		u.l.Errorf(ctx, "accesstoken.usecase.List.List: %v", err)
+		return nil, fmt.Errorf("%w: %v", accesstoken.ErrInternalSystem, err)
+	}
+	return tokens, nil
+}
+
+// Revoke revokes one of the caller's tokens
+func (u *usecase) Revoke(ctx context.Context, sc model.Scope, id string) error {
+	if err := u.repo.Revoke(ctx, repository.RevokeOptions{ID: id, UserID: sc.UserID}); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return accesstoken.ErrTokenNotFound
+		}
+		u.l.Errorf(ctx, "accesstoken.usecase.Revoke.Revoke: %v", err)
+		return fmt.Errorf("%w: %v", accesstoken.ErrInternalSystem, err)
+	}
+
+	u.l.Infof(ctx, "Personal access token revoked: ID=%s UserID=%s", id, sc.UserID)
+	return nil
+}
+
+// Validate resolves a plaintext token to an active access token and records its use
+func (u *usecase) Validate(ctx context.Context, token string) (model.AccessToken, error) {
+	if !strings.HasPrefix(token, model.AccessTokenPrefix) {
+		return model.AccessToken{}, accesstoken.ErrInvalidToken
+	}
+
+	found, err := u.repo.DetailByHash(ctx, hashToken(token))
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return model.AccessToken{}, accesstoken.ErrInvalidToken
+		}
+		u.l.Errorf(ctx, "accesstoken.usecase.Validate.DetailByHash: %v", err)
+		return model.AccessToken{}, fmt.Errorf("%w: %v", accesstoken.ErrInternalSystem, err)
+	}
+
+	if !found.IsActive(u.clock()) {
+		return model.AccessToken{}, accesstoken.ErrInvalidToken
+	}
+
+	// Usage tracking must not fail the request
+	if err := u.repo.TouchLastUsed(ctx, repository.TouchLastUsedOptions{
+		ID:          found.ID,
+		MinInterval: lastUsedInterval,
+	}); err != nil {
+		u.l.Warnf(ctx, "accesstoken.usecase.Validate.TouchLastUsed: %v", err)
+	}
+
+	return found, nil
+}
@@ -0,0 +1,216 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"identity-srv/internal/accesstoken"
+	"identity-srv/internal/accesstoken/repository"
+	"identity-srv/internal/model"
+
+	"github.com/smap-hcmut/shared-libs/go/log"
+)
+
+type testLogger struct{ log.Logger }
+
+func (testLogger) Infof(context.Context, string, ...any)  {}
+func (testLogger) Warnf(context.Context, string, ...any)  {}
+func (testLogger) Errorf(context.Context, string, ...any) {}
+
+// fakeRepo keeps tokens in memory, keyed by hash like the postgres table
+type fakeRepo struct {
+	repository.Repository
+
+	clock   func() time.Time
+	created []repository.CreateOptions
+	tokens  map[string]*model.AccessToken // secret hash -> token
+}
+
+func (r *fakeRepo) Create(ctx context.Context, opts repository.CreateOptions) (model.AccessToken, error) {
+	r.created = append(r.created, opts)
+	t := &model.AccessToken{
+		ID:          opts.TokenPrefix,
+		UserID:      opts.UserID,
+		Name:        opts.Name,
+		TokenPrefix: opts.TokenPrefix,
+		Scopes:      opts.Scopes,
+		ExpiresAt:   opts.ExpiresAt,
+		CreatedAt:   r.clock(),
+	}
+	r.tokens[opts.SecretHash] = t
+	return *t, nil
+}
+
+func (r *fakeRepo) Count(ctx context.Context, opts repository.ListOptions) (int64, error) {
+	var count int64
+	for _, t := range r.tokens {
+		if t.UserID == opts.UserID && (!opts.ActiveOnly || t.IsActive(r.clock())) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *fakeRepo) DetailByHash(ctx context.Context, secretHash string) (model.AccessToken, error) {
+	t, ok := r.tokens[secretHash]
+	if !ok {
+		return model.AccessToken{}, repository.ErrNotFound
+	}
+	return *t, nil
+}
+
+func (r *fakeRepo) Revoke(ctx context.Context, opts repository.RevokeOptions) error {
+	for _, t := range r.tokens {
+		if t.ID == opts.ID && t.UserID == opts.UserID && t.RevokedAt == nil {
+			now := r.clock()
+			t.RevokedAt = &now
+			return nil
+		}
+	}
+	return repository.ErrNotFound
+}
+
+func (r *fakeRepo) TouchLastUsed(ctx context.Context, opts repository.TouchLastUsedOptions) error {
+	return nil
+}
+
+// newTestUsecase returns a usecase whose clock is moved by advance
+func newTestUsecase() (*usecase, *fakeRepo, func(time.Duration)) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+	repo := &fakeRepo{clock: clock, tokens: make(map[string]*model.AccessToken)}
+	uc := &usecase{
+		l:          testLogger{},
+		repo:       repo,
+		clock:      clock,
+		defaultTTL: 30 * 24 * time.Hour,
+		maxTTL:     365 * 24 * time.Hour,
+		maxPerUser: 5,
+	}
+	return uc, repo, func(d time.Duration) { now = now.Add(d) }
+}
+
+func TestCreateStoresOnlyHash(t *testing.T) {
+	ctx := context.Background()
+	uc, repo, _ := newTestUsecase()
+	sc := model.Scope{UserID: "u1"}
+
+	out, err := uc.Create(ctx, sc, accesstoken.CreateInput{Name: "ci", Scopes: []string{"projects:read"}})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if !strings.HasPrefix(out.Token, model.AccessTokenPrefix) {
+		t.Fatalf("Create() token = %q, want prefix %q", out.Token, model.AccessTokenPrefix)
+	}
+
+	stored := repo.created[0]
+	secret := strings.TrimPrefix(out.Token, model.AccessTokenPrefix)
+	if stored.SecretHash != hashToken(out.Token) || strings.Contains(stored.SecretHash, secret) {
+		t.Fatalf("stored hash = %q, want the SHA-256 of the token", stored.SecretHash)
+	}
+	if !strings.HasPrefix(out.Token, stored.TokenPrefix) || len(stored.TokenPrefix) != len(model.AccessTokenPrefix)+displayPrefixLength {
+		t.Fatalf("stored prefix = %q, want the first %d characters of the secret", stored.TokenPrefix, displayPrefixLength)
+	}
+
+	if _, err := uc.Validate(ctx, out.Token); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	if _, err := uc.Validate(ctx, stored.TokenPrefix); !errors.Is(err, accesstoken.ErrInvalidToken) {
+		t.Fatalf("Validate(prefix) error = %v, want %v", err, accesstoken.ErrInvalidToken)
+	}
+}
+
+func TestCreateExpiry(t *testing.T) {
+	tests := []struct {
+		name      string
+		expiresIn time.Duration
+		want      time.Duration
+		wantErr   error
+	}{
+		{name: "default", want: 30 * 24 * time.Hour},
+		{name: "requested", expiresIn: time.Hour, want: time.Hour},
+		{name: "longest allowed", expiresIn: 365 * 24 * time.Hour, want: 365 * 24 * time.Hour},
+		{name: "longer than allowed", expiresIn: 366 * 24 * time.Hour, wantErr: accesstoken.ErrInvalidExpiry},
+		{name: "negative", expiresIn: -time.Hour, wantErr: accesstoken.ErrInvalidExpiry},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, _, _ := newTestUsecase()
+			out, err := uc.Create(context.Background(), model.Scope{UserID: "u1"}, accesstoken.CreateInput{Name: "ci", ExpiresIn: tt.expiresIn})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Create() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if got := out.AccessToken.ExpiresAt.Sub(uc.clock()); got != tt.want {
+				t.Fatalf("Create() expires in %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		// prepare acts on a freshly created token and returns the token to validate
+		prepare func(uc *usecase, advance func(time.Duration), out accesstoken.CreateOutput) string
+		want    error
+	}{
+		{
+			name:    "active",
+			prepare: func(uc *usecase, advance func(time.Duration), out accesstoken.CreateOutput) string { return out.Token },
+		},
+		{
+			name: "expired",
+			prepare: func(uc *usecase, advance func(time.Duration), out accesstoken.CreateOutput) string {
+				advance(time.Hour)
+				return out.Token
+			},
+			want: accesstoken.ErrInvalidToken,
+		},
+		{
+			name: "revoked",
+			prepare: func(uc *usecase, advance func(time.Duration), out accesstoken.CreateOutput) string {
+				if err := uc.Revoke(context.Background(), model.Scope{UserID: "u1"}, out.AccessToken.ID); err != nil {
+					t.Fatalf("Revoke() error = %v", err)
+				}
+				return out.Token
+			},
+			want: accesstoken.ErrInvalidToken,
+		},
+		{
+			name: "unknown",
+			prepare: func(uc *usecase, advance func(time.Duration), out accesstoken.CreateOutput) string {
+				return out.Token + "x"
+			},
+			want: accesstoken.ErrInvalidToken,
+		},
+		{
+			name: "not an access token",
+			prepare: func(uc *usecase, advance func(time.Duration), out accesstoken.CreateOutput) string {
+				return strings.TrimPrefix(out.Token, model.AccessTokenPrefix)
+			},
+			want: accesstoken.ErrInvalidToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, _, advance := newTestUsecase()
+			out, err := uc.Create(context.Background(), model.Scope{UserID: "u1"}, accesstoken.CreateInput{Name: "ci", ExpiresIn: time.Hour})
+			if err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+
+			_, err = uc.Validate(context.Background(), tt.prepare(uc, advance, out))
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("Validate() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"time"
+
+	"identity-srv/internal/accesstoken"
+	"identity-srv/internal/model"
+)
+
+const (
+	maxNameLength       = 100
+	maxScopes           = 20
+	displayPrefixLength = 6 // characters of the secret kept in token_prefix
+	secretBytes         = 32
+	lastUsedInterval    = time.Minute
+)
+
+// scopePattern accepts scopes like "projects:read" or "ingest.write"
+var scopePattern = regexp.MustCompile(`^[a-z][a-z0-9_.:-]{0,63}$`)
+
+// generateToken returns "smap_pat_" followed by 256 bits of base64url randomness
+func generateToken() (string, error) {
+	secret := make([]byte, secretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("rand.Read: %w", err)
+	}
+	return model.AccessTokenPrefix + base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+// hashToken returns the hex SHA-256 of the token. The secret is high-entropy,
+// so a fast hash is enough and allows an indexed lookup.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// normalizeScopes validates and de-duplicates scopes, preserving order
+func normalizeScopes(scopes []string) ([]string, error) {
+	if len(scopes) > maxScopes {
+		return nil, accesstoken.ErrInvalidScope
+	}
+
+	normalized := make([]string, 0, len(scopes))
+	seen := make(map[string]struct{}, len(scopes))
+	for _, scope := range scopes {
+		if !scopePattern.MatchString(scope) {
+			return nil, fmt.Errorf("%w: %q", accesstoken.ErrInvalidScope, scope)
+		}
+		if _, ok := seen[scope]; ok {
+			continue
+		}
+		seen[scope] = struct{}{}
+		normalized = append(normalized, scope)
+	}
+	return normalized, nil
+}
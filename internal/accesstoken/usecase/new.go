@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"time"
+
+	"identity-srv/config"
+	"identity-srv/internal/accesstoken"
+	"identity-srv/internal/accesstoken/repository"
+
+	"github.com/smap-hcmut/shared-libs/go/log"
+)
+
+type usecase struct {
+	l          log.Logger
+	repo       repository.Repository
+	clock      func() time.Time
+	defaultTTL time.Duration
+	maxTTL     time.Duration
+	maxPerUser int
+}
+
+func New(l log.Logger, repo repository.Repository, cfg config.AccessTokenConfig) accesstoken.UseCase {
+	return &usecase{
+		l:          l,
+		repo:       repo,
+		clock:      time.Now,
+		defaultTTL: time.Duration(cfg.DefaultTTL) * time.Second,
+		maxTTL:     time.Duration(cfg.MaxTTL) * time.Second,
+		maxPerUser: cfg.MaxPerUser,
+	}
+}
@@ -0,0 +1,16 @@
+package audit
+
+import "context"
+
+type requestMetaCtxKey struct{}
+
+// SetRequestMetaToContext attaches the request metadata recorded with events
+func SetRequestMetaToContext(ctx context.Context, meta RequestMeta) context.Context {
+	return context.WithValue(ctx, requestMetaCtxKey{}, meta)
+}
+
+// GetRequestMetaFromContext returns the request metadata, empty outside HTTP requests
+func GetRequestMetaFromContext(ctx context.Context) RequestMeta {
+	meta, _ := ctx.Value(requestMetaCtxKey{}).(RequestMeta)
+	return meta
+}
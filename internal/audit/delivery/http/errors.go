@@ -0,0 +1,36 @@
+package http
+
+import (
+	"errors"
+	"identity-srv/internal/audit"
+
+	pkgErrors "github.com/smap-hcmut/shared-libs/go/errors"
+)
+
+// --- HTTP error constants ---
+
+var (
+	errInvalidUserID    = pkgErrors.NewHTTPError(27001, "Invalid user ID")
+	errInvalidEventType = pkgErrors.NewHTTPError(27002, "Invalid event type")
+	errInvalidTimeRange = pkgErrors.NewHTTPError(27003, "Invalid time range")
+	errInvalidPage      = pkgErrors.NewHTTPError(27004, "Invalid page or limit")
+	errInternalSystem   = pkgErrors.NewHTTPError(27005, "Internal system error")
+)
+
+// mapError maps UseCase domain errors to HTTP errors
+func (h handler) mapError(err error) error {
+	switch {
+	case errors.Is(err, audit.ErrInvalidUserID):
+		return errInvalidUserID
+	case errors.Is(err, audit.ErrInvalidEventType):
+		return errInvalidEventType
+	case errors.Is(err, audit.ErrInvalidTimeRange):
+		return errInvalidTimeRange
+	case errors.Is(err, audit.ErrInvalidPage):
+		return errInvalidPage
+	case errors.Is(err, audit.ErrInternalSystem):
+		return errInternalSystem
+	default:
+		return err
+	}
+}
@@ -0,0 +1,47 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/smap-hcmut/shared-libs/go/response"
+)
+
+// List
+// @Summary List Audit Logs
+// @Description List audit log entries, newest first: logins (success and failure with reason), logouts, token revocations, role changes, impersonation and access denied by the domain allowlist or the blocklist. Requires ADMIN role.
+// @Tags Audit
+// @Accept json
+// @Produce json
+// @Param user_id query string false "Entries where the user is the actor or the target"
+// @Param event_type query string false "login, logout, logout_all, token_revoke, role_change, impersonation or access_denied"
+// @Param from query string false "Start of the time range, RFC 3339, inclusive"
+// @Param to query string false "End of the time range, RFC 3339, exclusive"
+// @Param page query int false "Page number, from 1" default(1)
+// @Param limit query int false "Entries per page, at most 200" default(50)
+// @Success 200 {object} response.Resp{data=listResp} "Audit logs"
+// @Failure 400 {object} response.Resp "Invalid filter"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /audit-logs [GET]
+// @Security CookieAuth
+func (h handler) List(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	input, err := h.processListRequest(c)
+	if err != nil {
+		response.Error(c, err, h.discord)
+		return
+	}
+
+	// 2. Call UseCase
+	output, err := h.uc.List(ctx, input)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.List: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	response.OK(c, h.newListResp(output))
+}
@@ -0,0 +1,29 @@
+package http
+
+import (
+	"identity-srv/internal/audit"
+	internalmw "identity-srv/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/smap-hcmut/shared-libs/go/discord"
+	"github.com/smap-hcmut/shared-libs/go/log"
+	"github.com/smap-hcmut/shared-libs/go/middleware"
+)
+
+type Handler interface {
+	RegisterRoutes(r *gin.RouterGroup, mw *middleware.Middleware, imw *internalmw.Middleware)
+}
+
+type handler struct {
+	l       log.Logger
+	uc      audit.UseCase
+	discord discord.IDiscord
+}
+
+func New(l log.Logger, uc audit.UseCase, discord discord.IDiscord) Handler {
+	return handler{
+		l:       l,
+		uc:      uc,
+		discord: discord,
+	}
+}
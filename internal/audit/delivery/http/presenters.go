@@ -0,0 +1,65 @@
+package http
+
+import (
+	"identity-srv/internal/audit"
+	"identity-srv/internal/model"
+	"time"
+)
+
+// --- Response DTOs ---
+
+type auditLogResp struct {
+	ID          string            `json:"id"`
+	EventType   string            `json:"event_type"`
+	Outcome     string            `json:"outcome"` // success, failure
+	Reason      string            `json:"reason,omitempty"`
+	ActorID     *string           `json:"actor_id,omitempty"`
+	ActorEmail  string            `json:"actor_email,omitempty"`
+	TargetID    *string           `json:"target_id,omitempty"`
+	TargetEmail string            `json:"target_email,omitempty"`
+	IPAddress   string            `json:"ip_address"`
+	UserAgent   string            `json:"user_agent"`
+	TraceID     string            `json:"trace_id,omitempty"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+	CreatedAt   time.Time         `json:"created_at"`
+}
+
+type listResp struct {
+	AuditLogs []auditLogResp `json:"audit_logs"`
+	Total     int64          `json:"total"`
+	Page      int            `json:"page"`
+	Limit     int            `json:"limit"`
+}
+
+// --- Response Mappers ---
+
+func (h handler) newAuditLogResp(o model.AuditLog) auditLogResp {
+	return auditLogResp{
+		ID:          o.ID,
+		EventType:   o.EventType,
+		Outcome:     o.Outcome,
+		Reason:      o.Reason,
+		ActorID:     o.ActorID,
+		ActorEmail:  o.ActorEmail,
+		TargetID:    o.TargetID,
+		TargetEmail: o.TargetEmail,
+		IPAddress:   o.IPAddress,
+		UserAgent:   o.UserAgent,
+		TraceID:     o.TraceID,
+		Metadata:    o.Metadata,
+		CreatedAt:   o.CreatedAt,
+	}
+}
+
+func (h handler) newListResp(o audit.ListOutput) listResp {
+	logs := make([]auditLogResp, 0, len(o.Logs))
+	for _, entry := range o.Logs {
+		logs = append(logs, h.newAuditLogResp(entry))
+	}
+	return listResp{
+		AuditLogs: logs,
+		Total:     o.Total,
+		Page:      o.Page,
+		Limit:     o.Limit,
+	}
+}
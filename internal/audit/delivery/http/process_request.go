@@ -0,0 +1,56 @@
+package http
+
+import (
+	"identity-srv/internal/audit"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// --- Process request functions ---
+
+func (h handler) processListRequest(c *gin.Context) (audit.ListInput, error) {
+	input := audit.ListInput{
+		UserID:    strings.TrimSpace(c.Query("user_id")),
+		EventType: strings.TrimSpace(c.Query("event_type")),
+	}
+
+	var err error
+	if input.From, err = parseTimeQuery(c, "from"); err != nil {
+		return audit.ListInput{}, errInvalidTimeRange
+	}
+	if input.To, err = parseTimeQuery(c, "to"); err != nil {
+		return audit.ListInput{}, errInvalidTimeRange
+	}
+	if input.Page, err = parseIntQuery(c, "page"); err != nil {
+		return audit.ListInput{}, errInvalidPage
+	}
+	if input.Limit, err = parseIntQuery(c, "limit"); err != nil {
+		return audit.ListInput{}, errInvalidPage
+	}
+	return input, nil
+}
+
+// parseTimeQuery reads an optional RFC 3339 time
+func parseTimeQuery(c *gin.Context, key string) (*time.Time, error) {
+	value := c.Query(key)
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// parseIntQuery reads an optional integer, zero when absent
+func parseIntQuery(c *gin.Context, key string) (int, error) {
+	value := c.Query(key)
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(value)
+}
@@ -0,0 +1,14 @@
+package http
+
+import (
+	internalmw "identity-srv/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/smap-hcmut/shared-libs/go/middleware"
+)
+
+func (h handler) RegisterRoutes(r *gin.RouterGroup, mw *middleware.Middleware, imw *internalmw.Middleware) {
+	// Admin query (require ADMIN role)
+	r.Use(mw.Auth(), mw.AdminOnly(), imw.Admin())
+	r.GET("", h.List)
+}
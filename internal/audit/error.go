@@ -0,0 +1,11 @@
+package audit
+
+import "errors"
+
+var (
+	ErrInvalidUserID    = errors.New("invalid user id")
+	ErrInvalidEventType = errors.New("invalid audit event type")
+	ErrInvalidTimeRange = errors.New("invalid time range")
+	ErrInvalidPage      = errors.New("invalid page")
+	ErrInternalSystem   = errors.New("internal system error")
+)
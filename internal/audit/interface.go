@@ -0,0 +1,15 @@
+package audit
+
+import (
+	"context"
+)
+
+//go:generate mockery --name UseCase
+type UseCase interface {
+	// Record appends an entry. Auditing never fails the audited action: errors
+	// are logged, not returned.
+	Record(ctx context.Context, ip RecordInput)
+
+	// Admin query
+	List(ctx context.Context, ip ListInput) (ListOutput, error)
+}
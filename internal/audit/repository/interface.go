@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"identity-srv/internal/model"
+)
+
+//go:generate mockery --name Repository
+type Repository interface {
+	// Create appends an entry; entries are never updated
+	Create(ctx context.Context, opts CreateOptions) (model.AuditLog, error)
+	// List returns a page of matching entries, newest first, and the number of matches
+	List(ctx context.Context, opts ListOptions) ([]model.AuditLog, int64, error)
+}
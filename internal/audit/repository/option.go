@@ -0,0 +1,26 @@
+package repository
+
+import "time"
+
+type CreateOptions struct {
+	EventType   string
+	Outcome     string
+	Reason      string
+	ActorID     string // empty for anonymous events
+	ActorEmail  string
+	TargetID    string
+	TargetEmail string
+	IPAddress   string
+	UserAgent   string
+	TraceID     string
+	Metadata    map[string]string
+}
+
+type ListOptions struct {
+	UserID    string // actor or target, empty for all
+	EventType string // empty for all
+	From      *time.Time
+	To        *time.Time
+	Offset    int
+	Limit     int
+}
@@ -0,0 +1,54 @@
+package postgres
+
+import (
+	"context"
+
+	"identity-srv/internal/audit/repository"
+	"identity-srv/internal/model"
+	"identity-srv/internal/sqlboiler"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// Create appends an entry
+func (r *implRepository) Create(ctx context.Context, opts repository.CreateOptions) (model.AuditLog, error) {
+	entry, err := r.buildAuditLog(opts)
+	if err != nil {
+		r.l.Errorf(ctx, "audit.repository.postgres.Create.buildAuditLog: %v", err)
+		return model.AuditLog{}, err
+	}
+	if err := entry.Insert(ctx, r.db, boil.Infer()); err != nil {
+		r.l.Errorf(ctx, "audit.repository.postgres.Create: %v", err)
+		return model.AuditLog{}, err
+	}
+	return *model.NewAuditLogFromDB(entry), nil
+}
+
+// List returns a page of matching entries, newest first
+func (r *implRepository) List(ctx context.Context, opts repository.ListOptions) ([]model.AuditLog, int64, error) {
+	mods := r.buildListQuery(opts)
+
+	total, err := sqlboiler.AuditLogs(mods...).Count(ctx, r.db)
+	if err != nil {
+		r.l.Errorf(ctx, "audit.repository.postgres.List.Count: %v", err)
+		return nil, 0, err
+	}
+
+	mods = append(mods,
+		qm.OrderBy(sqlboiler.AuditLogColumns.CreatedAt+" DESC"),
+		qm.Offset(opts.Offset),
+		qm.Limit(opts.Limit),
+	)
+	entries, err := sqlboiler.AuditLogs(mods...).All(ctx, r.db)
+	if err != nil {
+		r.l.Errorf(ctx, "audit.repository.postgres.List.All: %v", err)
+		return nil, 0, err
+	}
+
+	result := make([]model.AuditLog, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, *model.NewAuditLogFromDB(entry))
+	}
+	return result, total, nil
+}
@@ -0,0 +1,58 @@
+package postgres
+
+import (
+	"encoding/json"
+
+	"identity-srv/internal/audit/repository"
+	"identity-srv/internal/sqlboiler"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/smap-hcmut/shared-libs/go/postgres"
+)
+
+func (r *implRepository) buildAuditLog(opts repository.CreateOptions) (*sqlboiler.AuditLog, error) {
+	entry := &sqlboiler.AuditLog{
+		ID:          postgres.NewUUID(),
+		EventType:   opts.EventType,
+		Outcome:     opts.Outcome,
+		Reason:      opts.Reason,
+		ActorID:     null.NewString(opts.ActorID, opts.ActorID != ""),
+		ActorEmail:  opts.ActorEmail,
+		TargetID:    null.NewString(opts.TargetID, opts.TargetID != ""),
+		TargetEmail: opts.TargetEmail,
+		IPAddress:   opts.IPAddress,
+		UserAgent:   opts.UserAgent,
+		TraceID:     opts.TraceID,
+		CreatedAt:   r.clock(),
+	}
+	if len(opts.Metadata) > 0 {
+		metadata, err := json.Marshal(opts.Metadata)
+		if err != nil {
+			return nil, err
+		}
+		entry.Metadata = null.JSONFrom(metadata)
+	}
+	return entry, nil
+}
+
+// buildListQuery returns the filters shared by the page and the count
+func (r *implRepository) buildListQuery(opts repository.ListOptions) []qm.QueryMod {
+	var mods []qm.QueryMod
+	if opts.UserID != "" {
+		mods = append(mods, qm.Expr(
+			sqlboiler.AuditLogWhere.ActorID.EQ(null.StringFrom(opts.UserID)),
+			qm.Or2(sqlboiler.AuditLogWhere.TargetID.EQ(null.StringFrom(opts.UserID))),
+		))
+	}
+	if opts.EventType != "" {
+		mods = append(mods, sqlboiler.AuditLogWhere.EventType.EQ(opts.EventType))
+	}
+	if opts.From != nil {
+		mods = append(mods, sqlboiler.AuditLogWhere.CreatedAt.GTE(*opts.From))
+	}
+	if opts.To != nil {
+		mods = append(mods, sqlboiler.AuditLogWhere.CreatedAt.LT(*opts.To))
+	}
+	return mods
+}
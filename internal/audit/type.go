@@ -0,0 +1,50 @@
+package audit
+
+import (
+	"time"
+
+	"identity-srv/internal/model"
+)
+
+// RequestMeta describes the HTTP request behind an event
+type RequestMeta struct {
+	IPAddress string
+	UserAgent string
+	TraceID   string
+}
+
+// RecordInput describes an event. The IP, user agent and trace ID are taken
+// from the request metadata in the context.
+type RecordInput struct {
+	EventType   string // model.AuditEvent*
+	Outcome     string // model.AuditOutcome*, success when empty
+	Reason      string // cause of a failure or denial
+	ActorID     string
+	ActorEmail  string
+	TargetID    string // optional, when the action applies to another user
+	TargetEmail string
+	Metadata    map[string]string
+}
+
+// ListInput filters the log. All filters are optional.
+type ListInput struct {
+	UserID    string // matches the actor or the target
+	EventType string
+	From      *time.Time
+	To        *time.Time
+	Page      int // 1-based, 1 when zero
+	Limit     int // DefaultLimit when zero, at most MaxLimit
+}
+
+// ListOutput is a page of entries, newest first
+type ListOutput struct {
+	Logs  []model.AuditLog
+	Total int64
+	Page  int
+	Limit int
+}
+
+const (
+	DefaultLimit = 50
+	MaxLimit     = 200
+)
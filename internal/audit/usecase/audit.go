@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"identity-srv/internal/audit"
+	"identity-srv/internal/audit/repository"
+	"identity-srv/internal/model"
+)
+
+// Record appends an entry, with the IP, user agent and trace ID of the request
+// in ctx. The entry is written synchronously so it is not lost on a crash; a
+// failure is logged with the event so it can still be traced in the service logs.
+func (u *usecase) Record(ctx context.Context, ip audit.RecordInput) {
+	outcome := ip.Outcome
+	if outcome == "" {
+		outcome = model.AuditOutcomeSuccess
+	}
+	meta := audit.GetRequestMetaFromContext(ctx)
+
+	if _, err := u.repo.Create(ctx, repository.CreateOptions{
+		EventType:   ip.EventType,
+		Outcome:     outcome,
+		Reason:      ip.Reason,
+		ActorID:     ip.ActorID,
+		ActorEmail:  ip.ActorEmail,
+		TargetID:    ip.TargetID,
+		TargetEmail: ip.TargetEmail,
+		IPAddress:   meta.IPAddress,
+		UserAgent:   meta.UserAgent,
+		TraceID:     meta.TraceID,
+		Metadata:    ip.Metadata,
+	}); err != nil {
+		u.l.Errorf(ctx, "audit.usecase.Record.Create: event=%s outcome=%s actor=%s target=%s: %v",
+			ip.EventType, outcome, ip.ActorEmail, ip.TargetEmail, err)
+	}
+}
+
+// List returns a page of entries, newest first
+func (u *usecase) List(ctx context.Context, ip audit.ListInput) (audit.ListOutput, error) {
+	if err := validateListInput(ip); err != nil {
+		return audit.ListOutput{}, err
+	}
+	page, limit := pagination(ip)
+
+	logs, total, err := u.repo.List(ctx, repository.ListOptions{
+		UserID:    ip.UserID,
+		EventType: ip.EventType,
+		From:      ip.From,
+		To:        ip.To,
+		Offset:    (page - 1) * limit,
+		Limit:     limit,
+	})
+	if err != nil {
+		u.l.Errorf(ctx, "audit.usecase.List.List: %v", err)
+		return audit.ListOutput{}, fmt.Errorf("%w: %v", audit.ErrInternalSystem, err)
+	}
+
+	return audit.ListOutput{
+		Logs:  logs,
+		Total: total,
+		Page:  page,
+		Limit: limit,
+	}, nil
+}
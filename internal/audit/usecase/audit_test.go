@@ -0,0 +1,221 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"identity-srv/internal/audit"
+	"identity-srv/internal/audit/repository"
+	"identity-srv/internal/model"
+
+	"github.com/smap-hcmut/shared-libs/go/log"
+)
+
+type testLogger struct{ log.Logger }
+
+func (testLogger) Errorf(context.Context, string, ...any) {}
+
+// fakeRepo filters entries in memory like the postgres query: the user matches
+// the actor or the target, From is inclusive and To exclusive.
+type fakeRepo struct {
+	repository.Repository
+	logs  []model.AuditLog
+	calls int
+}
+
+func (r *fakeRepo) List(_ context.Context, opts repository.ListOptions) ([]model.AuditLog, int64, error) {
+	r.calls++
+	var matches []model.AuditLog
+	for _, entry := range r.logs {
+		if opts.UserID != "" && !isUser(entry.ActorID, opts.UserID) && !isUser(entry.TargetID, opts.UserID) {
+			continue
+		}
+		if opts.EventType != "" && entry.EventType != opts.EventType {
+			continue
+		}
+		if opts.From != nil && entry.CreatedAt.Before(*opts.From) {
+			continue
+		}
+		if opts.To != nil && !entry.CreatedAt.Before(*opts.To) {
+			continue
+		}
+		matches = append(matches, entry)
+	}
+	sort.Slice(matches, func(i, j int) bool { return matches[i].CreatedAt.After(matches[j].CreatedAt) })
+
+	total := int64(len(matches))
+	if opts.Offset >= len(matches) {
+		return nil, total, nil
+	}
+	matches = matches[opts.Offset:]
+	if len(matches) > opts.Limit {
+		matches = matches[:opts.Limit]
+	}
+	return matches, total, nil
+}
+
+func isUser(id *string, userID string) bool {
+	return id != nil && *id == userID
+}
+
+const (
+	alice = "00000000-0000-0000-0000-00000000000a"
+	bob   = "00000000-0000-0000-0000-00000000000b"
+)
+
+var testStart = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// testLogs returns one entry per hour, oldest first
+func testLogs() []model.AuditLog {
+	a, b := alice, bob
+	entries := []struct {
+		id        string
+		eventType string
+		actor     *string
+		target    *string
+	}{
+		{"1", model.AuditEventLogin, &a, nil},
+		{"2", model.AuditEventLogin, &b, nil},
+		{"3", model.AuditEventRoleChange, &a, &b},
+		{"4", model.AuditEventAccessDenied, nil, nil},
+		{"5", model.AuditEventLogout, &b, nil},
+		{"6", model.AuditEventLogin, &a, nil},
+	}
+	logs := make([]model.AuditLog, 0, len(entries))
+	for i, e := range entries {
+		logs = append(logs, model.AuditLog{
+			ID:        e.id,
+			EventType: e.eventType,
+			Outcome:   model.AuditOutcomeSuccess,
+			ActorID:   e.actor,
+			TargetID:  e.target,
+			CreatedAt: testStart.Add(time.Duration(i) * time.Hour),
+		})
+	}
+	return logs
+}
+
+func ids(logs []model.AuditLog) []string {
+	result := make([]string, 0, len(logs))
+	for _, entry := range logs {
+		result = append(result, entry.ID)
+	}
+	return result
+}
+
+func TestList(t *testing.T) {
+	hour := func(n int) *time.Time {
+		at := testStart.Add(time.Duration(n) * time.Hour)
+		return &at
+	}
+
+	tests := []struct {
+		name      string
+		input     audit.ListInput
+		wantIDs   []string
+		wantTotal int64
+		wantPage  int
+		wantLimit int
+	}{
+		{
+			name:      "no filter, newest first",
+			wantIDs:   []string{"6", "5", "4", "3", "2", "1"},
+			wantTotal: 6, wantPage: 1, wantLimit: audit.DefaultLimit,
+		},
+		{
+			name:      "event type",
+			input:     audit.ListInput{EventType: model.AuditEventLogin},
+			wantIDs:   []string{"6", "2", "1"},
+			wantTotal: 3, wantPage: 1, wantLimit: audit.DefaultLimit,
+		},
+		{
+			name:      "user as actor or target",
+			input:     audit.ListInput{UserID: bob},
+			wantIDs:   []string{"5", "3", "2"},
+			wantTotal: 3, wantPage: 1, wantLimit: audit.DefaultLimit,
+		},
+		{
+			name:      "user and event type",
+			input:     audit.ListInput{UserID: alice, EventType: model.AuditEventLogin},
+			wantIDs:   []string{"6", "1"},
+			wantTotal: 2, wantPage: 1, wantLimit: audit.DefaultLimit,
+		},
+		{
+			name:      "time range includes from and excludes to",
+			input:     audit.ListInput{From: hour(1), To: hour(4)},
+			wantIDs:   []string{"4", "3", "2"},
+			wantTotal: 3, wantPage: 1, wantLimit: audit.DefaultLimit,
+		},
+		{
+			name:      "open-ended range",
+			input:     audit.ListInput{From: hour(4)},
+			wantIDs:   []string{"6", "5"},
+			wantTotal: 2, wantPage: 1, wantLimit: audit.DefaultLimit,
+		},
+		{
+			name:      "second page",
+			input:     audit.ListInput{Page: 2, Limit: 4},
+			wantIDs:   []string{"2", "1"},
+			wantTotal: 6, wantPage: 2, wantLimit: 4,
+		},
+		{
+			name:      "page past the end",
+			input:     audit.ListInput{Page: 3, Limit: 4},
+			wantIDs:   []string{},
+			wantTotal: 6, wantPage: 3, wantLimit: 4,
+		},
+		{
+			name:      "filtered page",
+			input:     audit.ListInput{EventType: model.AuditEventLogin, Page: 2, Limit: 2},
+			wantIDs:   []string{"1"},
+			wantTotal: 3, wantPage: 2, wantLimit: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := &usecase{l: testLogger{}, repo: &fakeRepo{logs: testLogs()}}
+			output, err := uc.List(context.Background(), tt.input)
+			if err != nil {
+				t.Fatalf("List() error = %v", err)
+			}
+			if got := ids(output.Logs); !reflect.DeepEqual(got, tt.wantIDs) {
+				t.Errorf("List() = %v, want %v", got, tt.wantIDs)
+			}
+			if output.Total != tt.wantTotal || output.Page != tt.wantPage || output.Limit != tt.wantLimit {
+				t.Errorf("List() total=%d page=%d limit=%d, want %d %d %d",
+					output.Total, output.Page, output.Limit, tt.wantTotal, tt.wantPage, tt.wantLimit)
+			}
+		})
+	}
+}
+
+func TestListRefused(t *testing.T) {
+	from := testStart
+	tests := []struct {
+		name  string
+		input audit.ListInput
+		want  error
+	}{
+		{"user id not a uuid", audit.ListInput{UserID: "alice"}, audit.ErrInvalidUserID},
+		{"unknown event type", audit.ListInput{EventType: "password_reset"}, audit.ErrInvalidEventType},
+		{"empty range", audit.ListInput{From: &from, To: &from}, audit.ErrInvalidTimeRange},
+		{"negative page", audit.ListInput{Page: -1}, audit.ErrInvalidPage},
+		{"limit over max", audit.ListInput{Limit: audit.MaxLimit + 1}, audit.ErrInvalidPage},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeRepo{logs: testLogs()}
+			uc := &usecase{l: testLogger{}, repo: repo}
+			if _, err := uc.List(context.Background(), tt.input); !errors.Is(err, tt.want) {
+				t.Errorf("List() error = %v, want %v", err, tt.want)
+			}
+			if repo.calls != 0 {
+				t.Errorf("repository queried %d times, want 0", repo.calls)
+			}
+		})
+	}
+}
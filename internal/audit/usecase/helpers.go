@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"identity-srv/internal/audit"
+	"identity-srv/internal/model"
+
+	"github.com/google/uuid"
+)
+
+func validateListInput(ip audit.ListInput) error {
+	if ip.UserID != "" {
+		if _, err := uuid.Parse(ip.UserID); err != nil {
+			return audit.ErrInvalidUserID
+		}
+	}
+	if err := validateEventType(ip.EventType); err != nil {
+		return err
+	}
+	if ip.From != nil && ip.To != nil && !ip.From.Before(*ip.To) {
+		return audit.ErrInvalidTimeRange
+	}
+	if ip.Page < 0 || ip.Limit < 0 || ip.Limit > audit.MaxLimit {
+		return audit.ErrInvalidPage
+	}
+	return nil
+}
+
+func validateEventType(eventType string) error {
+	switch eventType {
+	case "",
+		model.AuditEventLogin,
+		model.AuditEventLogout,
+		model.AuditEventLogoutAll,
+		model.AuditEventTokenRevoke,
+		model.AuditEventRoleChange,
+		model.AuditEventImpersonation,
+		model.AuditEventDeactivation,
+		model.AuditEventAccessDenied:
+		return nil
+	default:
+		return audit.ErrInvalidEventType
+	}
+}
+
+// pagination applies the defaults to a validated input
+func pagination(ip audit.ListInput) (page, limit int) {
+	page, limit = ip.Page, ip.Limit
+	if page == 0 {
+		page = 1
+	}
+	if limit == 0 {
+		limit = audit.DefaultLimit
+	}
+	return page, limit
+}
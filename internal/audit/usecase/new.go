@@ -0,0 +1,20 @@
+package usecase
+
+import (
+	"identity-srv/internal/audit"
+	"identity-srv/internal/audit/repository"
+
+	"github.com/smap-hcmut/shared-libs/go/log"
+)
+
+type usecase struct {
+	l    log.Logger
+	repo repository.Repository
+}
+
+func New(l log.Logger, repo repository.Repository) audit.UseCase {
+	return &usecase{
+		l:    l,
+		repo: repo,
+	}
+}
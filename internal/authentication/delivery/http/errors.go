@@ -33,6 +33,21 @@ var (
 	errInvalidRedirectURL   = pkgErrors.NewHTTPError(20021, "Invalid redirect URL")
 	errInternalSystem       = pkgErrors.NewHTTPError(20022, "Internal system error")
 	errUserCreation         = pkgErrors.NewHTTPError(20023, "Failed to create or update user")
+	errBlacklistDisabled    = pkgErrors.NewHTTPError(20024, "Token revocation is disabled")
+	errCannotImpersonate    = pkgErrors.NewHTTPError(20025, "User cannot be impersonated")
+	errInvalidMFAChallenge  = pkgErrors.NewHTTPError(20026, "Invalid MFA challenge")
+	errMFANotEnrolled       = pkgErrors.NewHTTPError(20027, "MFA not enrolled")
+	errMFAAlreadyEnrolled   = pkgErrors.NewHTTPError(20028, "MFA already enrolled")
+	errInvalidPasskey       = pkgErrors.NewHTTPError(20029, "Invalid passkey ceremony")
+	errPasskeyNotVerified   = pkgErrors.NewHTTPError(20030, "Passkey verification failed")
+	errInvalidLoginParams   = pkgErrors.NewHTTPError(20031, "Invalid login parameters")
+	errReauthRequired       = pkgErrors.NewHTTPError(20032, "Re-authentication required")
+	errInvalidMagicLink     = pkgErrors.NewHTTPError(20033, "Invalid or already used magic link")
+	errAccessPending        = pkgErrors.NewHTTPError(20034, "Domain not allowed; access request pending approval")
+	errCannotDeactivate     = pkgErrors.NewHTTPError(20035, "User cannot be deactivated")
+	errInvalidLoginCode     = pkgErrors.NewHTTPError(20036, "Invalid, used or expired login code")
+	errUnknownClient        = pkgErrors.NewHTTPError(20037, "Unknown client_id")
+	errRoleNotAllowed       = pkgErrors.NewHTTPError(20038, "Role not allowed for this application")
 )
 
 // mapError maps UseCase domain errors to HTTP errors
@@ -74,6 +89,34 @@ func (h handler) mapError(err error) error {
 		return errInternalSystem
 	case errors.Is(err, authentication.ErrUserCreation):
 		return errUserCreation
+	case errors.Is(err, authentication.ErrBlacklistDisabled):
+		return errBlacklistDisabled
+	case errors.Is(err, authentication.ErrCannotImpersonate):
+		return errCannotImpersonate
+	case errors.Is(err, authentication.ErrCannotDeactivate):
+		return errCannotDeactivate
+	case errors.Is(err, authentication.ErrInvalidMFAChallenge):
+		return errInvalidMFAChallenge
+	case errors.Is(err, authentication.ErrMFANotEnrolled):
+		return errMFANotEnrolled
+	case errors.Is(err, authentication.ErrMFAAlreadyEnrolled):
+		return errMFAAlreadyEnrolled
+	case errors.Is(err, authentication.ErrInvalidPasskey):
+		return errInvalidPasskey
+	case errors.Is(err, authentication.ErrPasskeyNotVerified):
+		return errPasskeyNotVerified
+	case errors.Is(err, authentication.ErrReauthRequired):
+		return errReauthRequired
+	case errors.Is(err, authentication.ErrInvalidMagicLink):
+		return errInvalidMagicLink
+	case errors.Is(err, authentication.ErrAccessPending):
+		return errAccessPending
+	case errors.Is(err, authentication.ErrInvalidLoginCode):
+		return errInvalidLoginCode
+	case errors.Is(err, authentication.ErrUnknownClient):
+		return errUnknownClient
+	case errors.Is(err, authentication.ErrRoleNotAllowed):
+		return errRoleNotAllowed
 	default:
 		return err
 	}
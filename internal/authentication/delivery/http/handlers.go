@@ -1,13 +1,15 @@
 package http
 
 import (
+	"net/http"
+
 	"github.com/gin-gonic/gin"
 	"github.com/smap-hcmut/shared-libs/go/response"
 )
 
 // Logout
 // @Summary Logout
-// @Description Logout by expiring the authentication cookie. Requires authentication via cookie.
+// @Description Logout by blacklisting the current token, deleting its session and expiring the authentication cookie. Requires authentication via cookie.
 // @Tags Authentication
 // @Accept json
 // @Produce json
@@ -38,6 +40,74 @@ func (h handler) Logout(c *gin.Context) {
 	response.OK(c, nil)
 }
 
+// LogoutAll
+// @Summary Logout Everywhere
+// @Description Revoke every token issued to the current user (all devices) and expire the authentication cookie.
+// @Tags Authentication
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.Resp "Success - All tokens revoked"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/logout-all [POST]
+// @Security CookieAuth
+func (h handler) LogoutAll(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	sc, err := h.getScope(c)
+	if err != nil {
+		response.Unauthorized(c)
+		return
+	}
+
+	// 2. Call UseCase
+	if err := h.uc.RevokeAllUserTokens(ctx, sc.UserID); err != nil {
+		h.l.Errorf(ctx, "uc.RevokeAllUserTokens: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	h.expireAuthCookie(c)
+	response.OK(c, nil)
+}
+
+// EndSession
+// @Summary RP-Initiated Logout
+// @Description OIDC-style logout for browsers. A GET revokes only the token passed as id_token_hint, since any page can trigger it; a POST also accepts the cookie or Authorization token. The cookie is expired when it belongs to the revoked token's user. Optionally ends the identity provider session, then redirects to a validated URL.
+// @Tags Authentication
+// @Produce json
+// @Param id_token_hint query string false "Token to revoke; required for a GET to log out"
+// @Param post_logout_redirect_uri query string false "URL to redirect to after logout (must be allowed)"
+// @Param state query string false "Opaque value appended to the final redirect"
+// @Param idp_logout query bool false "Also log out of the identity provider"
+// @Success 302 {string} string "Redirect to IdP logout or post-logout URL"
+// @Failure 400 {object} response.Resp "Invalid redirect URL"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/end-session [GET]
+// @Router /authentication/end-session [POST]
+func (h handler) EndSession(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	input := h.processEndSessionRequest(c)
+
+	// 2. Call UseCase
+	output, err := h.uc.EndSession(ctx, input)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.EndSession: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	if output.LoggedOut {
+		h.expireAuthCookie(c)
+	}
+	c.Redirect(http.StatusFound, output.RedirectURL)
+}
+
 // GetMe
 // @Summary Get Current User
 // @Description Get current authenticated user information.
@@ -9,11 +9,12 @@ import (
 
 // ValidateToken validates a JWT token (internal service endpoint)
 // @Summary Validate Token (Internal)
-// @Description Fallback token validation endpoint for services. Requires X-Internal-Key header.
+// @Description Fallback token validation endpoint for services. Accepts login JWTs and personal access tokens (smap_pat_*). Pass "audience" to reject tokens exchanged for another service, and "max_age" before a sensitive action to learn whether the login is recent enough (reauth_required). Requires X-Internal-Key header or a service token with scope tokens:validate.
 // @Tags Internal
 // @Accept json
 // @Produce json
-// @Param X-Internal-Key header string true "Internal authentication key"
+// @Param X-Internal-Key header string false "Internal authentication key"
+// @Param X-Service-Token header string false "Service token (or Authorization: Bearer <service token> on routes without an admin)"
 // @Param body body validateTokenReq true "Token to validate"
 // @Success 200 {object} response.Resp{data=validateTokenResp} "Token validation result"
 // @Failure 400 {object} response.Resp "Bad Request"
@@ -25,14 +26,14 @@ func (h handler) ValidateToken(c *gin.Context) {
 
 	// 1. Process Request
 	fmt.Println("DEBUG: ValidateToken Handler Reached")
-	token, err := h.processValidateTokenRequest(c)
+	input, err := h.processValidateTokenRequest(c)
 	if err != nil {
 		response.Error(c, err, h.discord)
 		return
 	}
 
 	// 2. Call UseCase
-	result, err := h.uc.ValidateToken(ctx, token)
+	result, err := h.uc.ValidateToken(ctx, input)
 	if err != nil {
 		h.l.Errorf(ctx, "uc.ValidateToken: %v", err)
 		response.Error(c, h.mapError(err), h.discord)
@@ -45,11 +46,12 @@ func (h handler) ValidateToken(c *gin.Context) {
 
 // RevokeToken revokes a specific token or all user tokens (internal service endpoint)
 // @Summary Revoke Token (Internal)
-// @Description Revoke specific token or all user tokens. Requires X-Internal-Key + ADMIN role.
+// @Description Revoke specific token or all user tokens. Requires X-Internal-Key (or a service token with scope tokens:revoke in X-Service-Token) + the acting ADMIN's JWT in the Authorization header or cookie.
 // @Tags Internal
 // @Accept json
 // @Produce json
-// @Param X-Internal-Key header string true "Internal authentication key"
+// @Param X-Internal-Key header string false "Internal authentication key"
+// @Param X-Service-Token header string false "Service token (or Authorization: Bearer <service token> on routes without an admin)"
 // @Param body body revokeTokenReq true "Token revocation request"
 // @Success 200 {object} response.Resp "Token(s) revoked"
 // @Failure 400 {object} response.Resp "Bad Request"
@@ -85,11 +87,12 @@ func (h handler) RevokeToken(c *gin.Context) {
 
 // GetUserByID gets user information by ID (internal service endpoint)
 // @Summary Get User by ID (Internal)
-// @Description Get user information by ID. Requires X-Internal-Key header.
+// @Description Get user information by ID. Requires X-Internal-Key header or a service token with scope users:read.
 // @Tags Internal
 // @Accept json
 // @Produce json
-// @Param X-Internal-Key header string true "Internal authentication key"
+// @Param X-Internal-Key header string false "Internal authentication key"
+// @Param X-Service-Token header string false "Service token (or Authorization: Bearer <service token> on routes without an admin)"
 // @Param id path string true "User ID"
 // @Success 200 {object} response.Resp{data=getUserResp} "User information"
 // @Failure 400 {object} response.Resp "Bad Request"
@@ -118,3 +121,80 @@ func (h handler) GetUserByID(c *gin.Context) {
 	// 3. Response
 	response.OK(c, h.newGetUserResp(user))
 }
+
+// Impersonate issues a short-lived token for a user on behalf of an admin (internal service endpoint)
+// @Summary Impersonate User (Internal)
+// @Description Issue a short-lived token for the target user carrying an "act" claim with the admin. Admins cannot be impersonated. The action is audited. Requires X-Internal-Key (or a service token with scope users:impersonate in X-Service-Token) + the acting ADMIN's JWT in the Authorization header or cookie.
+// @Tags Internal
+// @Accept json
+// @Produce json
+// @Param X-Internal-Key header string false "Internal authentication key"
+// @Param X-Service-Token header string false "Service token (or Authorization: Bearer <service token> on routes without an admin)"
+// @Param userID path string true "Target user ID"
+// @Param body body impersonateReq false "Optional reason"
+// @Success 200 {object} response.Resp{data=impersonateResp} "Impersonation token"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 404 {object} response.Resp "Not Found"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /internal/impersonate/{userID} [POST]
+func (h handler) Impersonate(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	input, sc, err := h.processImpersonateRequest(c)
+	if err != nil {
+		response.Error(c, err, h.discord)
+		return
+	}
+
+	// 2. Call UseCase
+	output, err := h.uc.Impersonate(ctx, sc, input)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.Impersonate: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	response.OK(c, h.newImpersonateResp(output))
+}
+
+// DeactivateUser deactivates a user and revokes their tokens (internal service endpoint)
+// @Summary Deactivate User (Internal)
+// @Description Mark a user inactive so they can no longer log in, and revoke all of their tokens. Admins cannot deactivate themselves. Publishes user.deactivated when the outbox is enabled. Requires X-Internal-Key (or a service token with scope users:deactivate in X-Service-Token) + the acting ADMIN's JWT in the Authorization header or cookie.
+// @Tags Internal
+// @Accept json
+// @Produce json
+// @Param X-Internal-Key header string false "Internal authentication key"
+// @Param X-Service-Token header string false "Service token (or Authorization: Bearer <service token> on routes without an admin)"
+// @Param id path string true "User ID"
+// @Success 200 {object} response.Resp{data=getUserResp} "Deactivated user"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 404 {object} response.Resp "Not Found"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /internal/users/{id}/deactivate [POST]
+func (h handler) DeactivateUser(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	userID, sc, err := h.processDeactivateUserRequest(c)
+	if err != nil {
+		response.Error(c, err, h.discord)
+		return
+	}
+
+	// 2. Call UseCase
+	user, err := h.uc.DeactivateUser(ctx, sc, userID)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.DeactivateUser: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	response.OK(c, h.newGetUserResp(user))
+}
@@ -0,0 +1,98 @@
+package http
+
+import (
+	internalmw "identity-srv/internal/middleware"
+	"identity-srv/internal/ratelimit"
+
+	"github.com/gin-gonic/gin"
+	"github.com/smap-hcmut/shared-libs/go/response"
+)
+
+// magicLinkSentMessage is returned whether or not an email was sent, so the
+// endpoint does not reveal which addresses may log in
+const magicLinkSentMessage = "If this address can log in, a login link has been sent to it"
+
+// RequestMagicLink emails a single-use login link
+// @Summary Request Magic Link
+// @Description Email a single-use login link to the address. The answer is the same whether or not the address may log in. The link opens magic_link.url, which completes the login with /authentication/magic-link/login. A registered client_id is sealed in the link; the login then applies the application's redirect allowlist, cookie domain, token audience, landing page and roles.
+// @Tags Authentication
+// @Accept json
+// @Produce json
+// @Param body body magicLinkReq true "Address, remember_me and post-login redirect"
+// @Success 200 {object} response.Resp{data=magicLinkResp} "Request accepted"
+// @Failure 400 {object} response.Resp "Invalid email, unknown client_id, invalid redirect or too many links requested"
+// @Failure 429 {object} response.Resp "Rate limited, see Retry-After"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/magic-link [POST]
+func (h handler) RequestMagicLink(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	input, err := h.processMagicLinkRequest(c)
+	if err != nil {
+		response.Error(c, err, h.discord)
+		return
+	}
+
+	// 2. Call UseCase
+	if err := h.uc.RequestMagicLink(ctx, input); err != nil {
+		h.l.Errorf(ctx, "uc.RequestMagicLink: %v", err)
+		if _, ok := ratelimit.RetryAfter(err); ok {
+			internalmw.AbortTooManyRequests(c, err)
+			return
+		}
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	response.OK(c, magicLinkResp{Message: magicLinkSentMessage})
+}
+
+// MagicLinkLogin completes a login with the token of an emailed link
+// @Summary Login With a Magic Link
+// @Description Redeem the token from a magic link. Each link works once. When the user's role or factors require MFA, redirect_url points to the MFA challenge page instead of the post-login redirect, as after the OAuth callback.
+// @Tags Authentication
+// @Accept json
+// @Produce json
+// @Param body body magicLinkLoginReq true "Token from the link"
+// @Success 200 {object} response.Resp{data=magicLinkLoginResp} "Login completed or MFA challenge issued"
+// @Failure 400 {object} response.Resp "Invalid, used or expired link"
+// @Failure 403 {object} response.Resp "Domain not allowed or account blocked"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/magic-link/login [POST]
+func (h handler) MagicLinkLogin(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	input, err := h.processMagicLinkLoginRequest(c)
+	if err != nil {
+		response.Error(c, err, h.discord)
+		return
+	}
+
+	// 2. Call UseCase
+	output, err := h.uc.MagicLinkLogin(ctx, input)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.MagicLinkLogin: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	if h.isDevelopmentMode() {
+		response.OK(c, h.newMagicLinkLoginResp(output, output.RedirectURL, output.Token, output.MFAChallenge))
+		return
+	}
+	if output.MFAChallenge != "" {
+		response.OK(c, h.newMagicLinkLoginResp(output, h.mfaChallengeURL(output, ""), "", ""))
+		return
+	}
+	redirectURL, err := h.loginRedirect(c, output.Token, output.RedirectURL, output.CookieDomain)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.CreateLoginCode: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+	response.OK(c, h.newMagicLinkLoginResp(output, redirectURL, "", ""))
+}
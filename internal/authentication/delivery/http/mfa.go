@@ -0,0 +1,85 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/smap-hcmut/shared-libs/go/response"
+)
+
+// MFAChallengeEnroll starts TOTP enrolment during login
+// @Summary Enrol TOTP During Login
+// @Description For a challenge with enroll=true (role requires MFA, no factor yet): returns a TOTP secret and otpauth URI. Complete the login with /authentication/mfa/challenge.
+// @Tags Authentication
+// @Accept json
+// @Produce json
+// @Param body body mfaChallengeEnrollReq true "Challenge from the OAuth callback"
+// @Success 200 {object} response.Resp{data=mfaChallengeEnrollResp} "Secret and otpauth URI (shown once)"
+// @Failure 400 {object} response.Resp "Invalid or expired challenge, or already enrolled"
+// @Failure 403 {object} response.Resp "Account blocked"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/mfa/challenge/enroll [POST]
+func (h handler) MFAChallengeEnroll(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	challenge, err := h.processMFAChallengeEnrollRequest(c)
+	if err != nil {
+		response.Error(c, err, h.discord)
+		return
+	}
+
+	// 2. Call UseCase
+	output, err := h.uc.EnrollMFAChallenge(ctx, challenge)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.EnrollMFAChallenge: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	response.OK(c, h.newMFAChallengeEnrollResp(output))
+}
+
+// MFAChallenge completes a login with a second factor
+// @Summary Complete MFA Challenge
+// @Description Verify a TOTP or recovery code for the challenge issued by the OAuth callback. On success the auth cookie is set and the response carries the original redirect URL. For an enrolment challenge the code confirms the new factor and recovery codes are returned once.
+// @Tags Authentication
+// @Accept json
+// @Produce json
+// @Param body body mfaChallengeReq true "Challenge and code"
+// @Success 200 {object} response.Resp{data=mfaChallengeResp} "Login completed"
+// @Failure 400 {object} response.Resp "Wrong OTP, expired challenge or too many attempts"
+// @Failure 403 {object} response.Resp "Account blocked"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/mfa/challenge [POST]
+func (h handler) MFAChallenge(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	input, err := h.processMFAChallengeRequest(c)
+	if err != nil {
+		response.Error(c, err, h.discord)
+		return
+	}
+
+	// 2. Call UseCase
+	output, err := h.uc.VerifyMFAChallenge(ctx, input)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.VerifyMFAChallenge: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	if h.isDevelopmentMode() {
+		response.OK(c, h.newMFAChallengeResp(output, output.RedirectURL, output.Token))
+		return
+	}
+
+	redirectURL, err := h.loginRedirect(c, output.Token, output.RedirectURL, output.CookieDomain)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.CreateLoginCode: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+	response.OK(c, h.newMFAChallengeResp(output, redirectURL, ""))
+}
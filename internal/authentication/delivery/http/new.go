@@ -3,6 +3,7 @@ package http
 import (
 	"identity-srv/config"
 	"identity-srv/internal/authentication"
+	internalmw "identity-srv/internal/middleware"
 	"identity-srv/internal/model"
 
 	"github.com/gin-gonic/gin"
@@ -12,7 +13,7 @@ import (
 )
 
 type Handler interface {
-	RegisterRoutes(r *gin.RouterGroup, mw *middleware.Middleware)
+	RegisterRoutes(r *gin.RouterGroup, mw *middleware.Middleware, imw *internalmw.Middleware)
 }
 
 type handler struct {
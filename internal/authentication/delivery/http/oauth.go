@@ -2,7 +2,9 @@ package http
 
 import (
 	"net/http"
-	"net/url"
+
+	internalmw "identity-srv/internal/middleware"
+	"identity-srv/internal/ratelimit"
 
 	"github.com/gin-gonic/gin"
 	"github.com/smap-hcmut/shared-libs/go/response"
@@ -14,16 +16,24 @@ import (
 // @Tags Authentication
 // @Produce json
 // @Param redirect query string false "URL to redirect to after login"
+// @Param max_age query int false "Re-authenticate at the provider if the last authentication is older (seconds)"
+// @Param prompt query string false "login: always re-authenticate at the provider" Enums(login)
+// @Param remember_me query bool false "Create a long-lived session after the callback"
+// @Param provider query string false "Expected provider; refused when it is not the configured one" Enums(google, azure, okta)
+// @Param client_id query string false "Registered application starting the login; selects its redirect allowlist, cookie domain, token audience, landing page and roles"
+// @Param login_hint query string false "Account to pre-select at the provider"
+// @Param locale query string false "UI locale of the provider's login screen, defaults to the first Accept-Language tag"
 // @Success 302 {string} string "Redirect to OAuth provider"
+// @Failure 400 {object} response.Resp "Invalid login parameters, provider, client_id or redirect"
 // @Router /authentication/login [get]
 func (h handler) OAuthLogin(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	// 1. Process Request — generates HMAC-signed state embedding the redirect URL
+	// 1. Process Request — generates HMAC-signed state embedding the redirect URL and max_age
 	input, err := h.processLoginRequest(c)
 	if err != nil {
 		h.l.Errorf(ctx, "processLoginRequest: %v", err)
-		response.Error(c, errInternalSystem, h.discord)
+		response.Error(c, err, h.discord)
 		return
 	}
 
@@ -46,17 +56,18 @@ func (h handler) OAuthLogin(c *gin.Context) {
 // @Produce json
 // @Param code query string true "Authorization code from provider"
 // @Param state query string true "State parameter for CSRF protection"
-// @Success 302 {string} string "Redirect to dashboard (production mode)"
+// @Success 302 {string} string "Redirect to dashboard, or to mfa.challenge_url when a second factor is required (production mode)"
 // @Success 200 {object} response.Resp{data=oauthCallbackResp} "Token response (development mode)"
-// @Failure 400 {object} response.Resp "Invalid request"
-// @Failure 403 {object} response.Resp "Domain not allowed or account blocked"
+// @Failure 400 {object} response.Resp "Invalid request, or the provider did not re-authenticate the user as max_age/prompt=login required"
+// @Failure 403 {object} response.Resp "Domain not allowed (with access_request.enabled, 20034 while the filed request is pending), role not allowed for the application, or account blocked"
+// @Failure 429 {object} response.Resp "Rate limited or locked out after repeated failures, see Retry-After"
 // @Failure 500 {object} response.Resp "Internal server error"
 // @Router /authentication/callback [get]
 func (h handler) OAuthCallback(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	// 1. Process Request — validates HMAC state, extracts redirect URL (no cookies)
-	input, redirectURL, err := h.processCallbackRequest(c)
+	// 1. Process Request — validates HMAC state, restores the login context (no cookies)
+	input, err := h.processCallbackRequest(c)
 	if err != nil {
 		h.l.Errorf(ctx, "processCallbackRequest: %v", err)
 		response.Error(c, err, h.discord)
@@ -67,6 +78,10 @@ func (h handler) OAuthCallback(c *gin.Context) {
 	output, err := h.uc.ProcessOAuthCallback(ctx, input)
 	if err != nil {
 		h.l.Errorf(ctx, "uc.ProcessOAuthCallback: %v", err)
+		if _, ok := ratelimit.RetryAfter(err); ok {
+			internalmw.AbortTooManyRequests(c, err)
+			return
+		}
 		response.Error(c, h.mapError(err), h.discord)
 		return
 	}
@@ -75,31 +90,60 @@ func (h handler) OAuthCallback(c *gin.Context) {
 	// Development mode: Return token in JSON response for easier testing
 	if h.isDevelopmentMode() {
 		h.l.Infof(ctx, "Development mode: returning token in response body")
-		response.OK(c, h.newOAuthCallbackResp(output.Token))
+		response.OK(c, h.newOAuthCallbackResp(output))
+		return
+	}
+
+	// A second factor is required: send the user to the page that collects the code
+	if output.MFAChallenge != "" {
+		c.Redirect(http.StatusTemporaryRedirect, h.mfaChallengeURL(output, input.Locale))
 		return
 	}
 
-	// Production mode: Set HttpOnly cookie and redirect.
+	// Production mode: Set HttpOnly cookie and redirect with a one-time code.
 	// The redirect URL was embedded in the HMAC-signed state at login time,
 	// so SameSite is set correctly based on the original request origin:
 	// - localhost origin → SameSite=None (cross-site fetch from local dev)
 	// - production origin → SameSite=Lax
-	if redirectURL == "" {
-		redirectURL = "/dashboard"
+	// A registered application supplies its landing page and cookie domain.
+	location, err := h.loginRedirect(c, output.Token, output.RedirectURL, output.CookieDomain)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.CreateLoginCode: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+	c.Redirect(http.StatusTemporaryRedirect, location)
+}
+
+// ExchangeLoginCode redeems the one-time code of a post-login redirect
+// @Summary Exchange Login Code
+// @Description Redeem the ?code= appended to the post-login redirect for the login token. Call it server-side from the frontend's callback page, then set the token in an HttpOnly cookie on the frontend's own domain. Each code works once, within login_code.ttl (60 seconds by default).
+// @Tags Authentication
+// @Accept json
+// @Produce json
+// @Param body body exchangeLoginCodeReq true "Code from the redirect"
+// @Success 200 {object} response.Resp{data=exchangeLoginCodeResp} "Login token"
+// @Failure 400 {object} response.Resp "Invalid, used or expired code"
+// @Failure 500 {object} response.Resp "Internal server error"
+// @Router /authentication/exchange [post]
+func (h handler) ExchangeLoginCode(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	code, err := h.processExchangeLoginCodeRequest(c)
+	if err != nil {
+		response.Error(c, err, h.discord)
+		return
 	}
 
-	h.setAuthCookieForRedirect(c, output.Token, redirectURL)
-
-	// Also pass the token in the redirect URL so the frontend can set its
-	// own cookie when it runs on a different domain (e.g., localhost dev).
-	// The frontend callback page reads ?token=..., validates it server-side,
-	// and sets an HttpOnly cookie on its own domain.
-	if parsed, err := url.Parse(redirectURL); err == nil {
-		q := parsed.Query()
-		q.Set("token", output.Token)
-		parsed.RawQuery = q.Encode()
-		redirectURL = parsed.String()
+	// 2. Call UseCase
+	output, err := h.uc.RedeemLoginCode(ctx, code)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.RedeemLoginCode: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
 	}
 
-	c.Redirect(http.StatusTemporaryRedirect, redirectURL)
+	// 3. Response
+	response.OK(c, exchangeLoginCodeResp{Token: output.Token, ExpiresAt: output.ExpiresAt})
 }
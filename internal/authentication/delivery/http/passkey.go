@@ -0,0 +1,154 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/smap-hcmut/shared-libs/go/response"
+)
+
+// MFAPasskeyChallengeBegin starts a passkey assertion for an MFA challenge
+// @Summary Start Passkey MFA Challenge
+// @Description For a challenge whose methods include "passkey": returns options for navigator.credentials.get() limited to the user's passkeys. Complete it with /authentication/mfa/challenge/passkey.
+// @Tags Authentication
+// @Accept json
+// @Produce json
+// @Param body body mfaPasskeyBeginReq true "Challenge from the OAuth callback"
+// @Success 200 {object} response.Resp{data=passkeyCeremonyResp} "Assertion options"
+// @Failure 400 {object} response.Resp "Invalid or expired challenge, or no passkey registered"
+// @Failure 403 {object} response.Resp "Account blocked"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/mfa/challenge/passkey/begin [POST]
+func (h handler) MFAPasskeyChallengeBegin(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	challenge, err := h.processMFAPasskeyBeginRequest(c)
+	if err != nil {
+		response.Error(c, err, h.discord)
+		return
+	}
+
+	// 2. Call UseCase
+	output, err := h.uc.BeginMFAPasskeyChallenge(ctx, challenge)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.BeginMFAPasskeyChallenge: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	response.OK(c, h.newPasskeyCeremonyResp(output))
+}
+
+// MFAPasskeyChallenge completes a login with a passkey as the second factor
+// @Summary Complete MFA Challenge With a Passkey
+// @Description Verify the passkey assertion for the challenge issued by the OAuth callback. On success the auth cookie is set and the response carries the original redirect URL.
+// @Tags Authentication
+// @Accept json
+// @Produce json
+// @Param body body mfaPasskeyReq true "Challenge, ceremony and assertion"
+// @Success 200 {object} response.Resp{data=mfaChallengeResp} "Login completed"
+// @Failure 400 {object} response.Resp "Verification failed or expired challenge"
+// @Failure 403 {object} response.Resp "Account blocked"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/mfa/challenge/passkey [POST]
+func (h handler) MFAPasskeyChallenge(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	input, err := h.processMFAPasskeyRequest(c)
+	if err != nil {
+		response.Error(c, err, h.discord)
+		return
+	}
+
+	// 2. Call UseCase
+	output, err := h.uc.VerifyMFAPasskeyChallenge(ctx, input)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.VerifyMFAPasskeyChallenge: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	if h.isDevelopmentMode() {
+		response.OK(c, h.newMFAChallengeResp(output, output.RedirectURL, output.Token))
+		return
+	}
+	redirectURL, err := h.loginRedirect(c, output.Token, output.RedirectURL, output.CookieDomain)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.CreateLoginCode: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+	response.OK(c, h.newMFAChallengeResp(output, redirectURL, ""))
+}
+
+// PasskeyLoginBegin starts a passwordless login
+// @Summary Start Passkey Login
+// @Description Returns options for navigator.credentials.get() accepting any discoverable passkey registered with this service. User verification is required.
+// @Tags Authentication
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.Resp{data=passkeyCeremonyResp} "Assertion options"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/passkey/login/begin [POST]
+func (h handler) PasskeyLoginBegin(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request (nothing to read, the passkey identifies the user)
+
+	// 2. Call UseCase
+	output, err := h.uc.BeginPasskeyLogin(ctx)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.BeginPasskeyLogin: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	response.OK(c, h.newPasskeyCeremonyResp(output))
+}
+
+// PasskeyLogin logs in with a passkey instead of the OAuth provider
+// @Summary Passwordless Login With a Passkey
+// @Description Verify a passkey assertion and log its owner in. Only users who already have an account and a registered passkey can log in this way; the domain allowlist and blocklist still apply. A registered client_id applies the application's redirect allowlist, cookie domain, token audience, landing page and roles.
+// @Tags Authentication
+// @Accept json
+// @Produce json
+// @Param body body passkeyLoginReq true "Ceremony and assertion"
+// @Success 200 {object} response.Resp{data=passkeyLoginResp} "Login completed"
+// @Failure 400 {object} response.Resp "Verification failed, expired ceremony, unknown client_id or invalid redirect"
+// @Failure 403 {object} response.Resp "Domain not allowed, account blocked or role not allowed for the application"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/passkey/login [POST]
+func (h handler) PasskeyLogin(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	input, err := h.processPasskeyLoginRequest(c)
+	if err != nil {
+		response.Error(c, err, h.discord)
+		return
+	}
+
+	// 2. Call UseCase
+	output, err := h.uc.FinishPasskeyLogin(ctx, input)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.FinishPasskeyLogin: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	if h.isDevelopmentMode() {
+		response.OK(c, passkeyLoginResp{RedirectURL: output.RedirectURL, Token: output.Token})
+		return
+	}
+	redirectURL, err := h.loginRedirect(c, output.Token, output.RedirectURL, output.CookieDomain)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.CreateLoginCode: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+	response.OK(c, passkeyLoginResp{RedirectURL: redirectURL})
+}
@@ -1,16 +1,74 @@
 package http
 
 import (
+	"encoding/json"
 	"fmt"
 	"identity-srv/internal/authentication"
 	"identity-srv/internal/model"
+	"strings"
 	"time"
 )
 
 // --- Request DTOs ---
 
 type validateTokenReq struct {
-	Token string `json:"token" binding:"required"`
+	Token    string `json:"token" binding:"required"`
+	Audience string `json:"audience,omitempty"`                          // the validating service; rejects tokens issued to another audience
+	MaxAge   *int64 `json:"max_age,omitempty" binding:"omitempty,min=0"` // seconds; sets reauth_required when the login is older
+}
+
+func (r validateTokenReq) toInput() authentication.ValidateTokenInput {
+	input := authentication.ValidateTokenInput{
+		Token:    strings.TrimSpace(r.Token),
+		Audience: strings.TrimSpace(r.Audience),
+	}
+	if r.MaxAge != nil {
+		maxAge := time.Duration(*r.MaxAge) * time.Second
+		input.MaxAge = &maxAge
+	}
+	return input
+}
+
+type mfaChallengeEnrollReq struct {
+	Challenge string `json:"challenge" binding:"required"`
+}
+
+type mfaChallengeReq struct {
+	Challenge string `json:"challenge" binding:"required"`
+	Code      string `json:"code" binding:"required"` // TOTP code, or a recovery code once enrolled
+}
+
+type mfaPasskeyBeginReq struct {
+	Challenge string `json:"challenge" binding:"required"`
+}
+
+type mfaPasskeyReq struct {
+	Challenge  string          `json:"challenge" binding:"required"`
+	Ceremony   string          `json:"ceremony" binding:"required"`
+	Credential json.RawMessage `json:"credential" binding:"required" swaggertype:"object"` // PublicKeyCredential from navigator.credentials.get()
+}
+
+type passkeyLoginReq struct {
+	Ceremony   string          `json:"ceremony" binding:"required"`
+	Credential json.RawMessage `json:"credential" binding:"required" swaggertype:"object"` // PublicKeyCredential from navigator.credentials.get()
+	RememberMe bool            `json:"remember_me"`
+	Redirect   string          `json:"redirect"`  // must be relative or in the redirect allowlist
+	ClientID   string          `json:"client_id"` // registered application; selects its allowlist, cookie domain, audience, landing page and roles
+}
+
+type magicLinkReq struct {
+	Email      string `json:"email" binding:"required"`
+	RememberMe bool   `json:"remember_me"`
+	Redirect   string `json:"redirect"`  // must be relative or in the redirect allowlist
+	ClientID   string `json:"client_id"` // registered application; selects its allowlist, cookie domain, audience, landing page and roles
+}
+
+type magicLinkLoginReq struct {
+	Token string `json:"token" binding:"required"` // from the link's ?token=
+}
+
+type exchangeLoginCodeReq struct {
+	Code string `json:"code" binding:"required"` // from the post-login redirect's ?code=
 }
 
 type revokeTokenReq struct {
@@ -18,10 +76,57 @@ type revokeTokenReq struct {
 	UserID string `json:"user_id,omitempty"`
 }
 
+type impersonateReq struct {
+	Reason string `json:"reason"` // optional, kept in the audit record
+}
+
 // --- Response DTOs ---
 
 type oauthCallbackResp struct {
-	Token string `json:"token"`
+	Token                 string   `json:"token,omitempty"`
+	MFAChallenge          string   `json:"mfa_challenge,omitempty"` // complete at /authentication/mfa/challenge
+	MFAMethods            []string `json:"mfa_methods,omitempty"`   // "totp", "passkey"
+	MFAEnrollmentRequired bool     `json:"mfa_enrollment_required,omitempty"`
+}
+
+type exchangeLoginCodeResp struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type mfaChallengeEnrollResp struct {
+	Secret     string `json:"secret"`      // base32, for manual entry
+	OTPAuthURI string `json:"otpauth_uri"` // render as a QR code
+}
+
+type mfaChallengeResp struct {
+	RedirectURL   string   `json:"redirect_url"`
+	Token         string   `json:"token,omitempty"`          // development mode only
+	RecoveryCodes []string `json:"recovery_codes,omitempty"` // set after enrolment, shown once
+}
+
+type passkeyCeremonyResp struct {
+	Ceremony string          `json:"ceremony"`                     // send back with the assertion
+	Options  json.RawMessage `json:"options" swaggertype:"object"` // pass to navigator.credentials.get()
+}
+
+type passkeyLoginResp struct {
+	RedirectURL string `json:"redirect_url"`
+	Token       string `json:"token,omitempty"` // development mode only
+}
+
+type magicLinkResp struct {
+	Message string `json:"message"`
+}
+
+// magicLinkLoginResp tells the frontend where to go next: the MFA challenge
+// page when a second factor is required, else the post-login redirect
+type magicLinkLoginResp struct {
+	RedirectURL           string   `json:"redirect_url"`
+	Token                 string   `json:"token,omitempty"`         // development mode only
+	MFAChallenge          string   `json:"mfa_challenge,omitempty"` // development mode only
+	MFAMethods            []string `json:"mfa_methods,omitempty"`
+	MFAEnrollmentRequired bool     `json:"mfa_enrollment_required,omitempty"`
 }
 
 type getMeResp struct {
@@ -31,13 +136,35 @@ type getMeResp struct {
 	Role     string  `json:"role"`
 }
 
+type actorResp struct {
+	UserID   string     `json:"user_id"`
+	Email    string     `json:"email,omitempty"`
+	ClientID string     `json:"client_id,omitempty"`
+	Actor    *actorResp `json:"actor,omitempty"` // previous actor in an exchange chain
+}
+
 type validateTokenResp struct {
-	Valid     bool      `json:"valid"`
-	UserID    string    `json:"user_id,omitempty"`
-	Email     string    `json:"email,omitempty"`
-	Role      string    `json:"role,omitempty"`
-	Groups    []string  `json:"groups,omitempty"`
-	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	Valid     bool       `json:"valid"`
+	TokenType string     `json:"token_type,omitempty"`
+	UserID    string     `json:"user_id,omitempty"`
+	Email     string     `json:"email,omitempty"`
+	Role      string     `json:"role,omitempty"`
+	Groups    []string   `json:"groups,omitempty"`
+	Scopes    []string   `json:"scopes,omitempty"`
+	Actor     *actorResp `json:"actor,omitempty"` // admin impersonating the user or service that exchanged the token
+	Audience  string     `json:"audience,omitempty"`
+	ExpiresAt time.Time  `json:"expires_at,omitempty"`
+	AuthTime  *time.Time `json:"auth_time,omitempty"` // last active login, absent for impersonation and personal access tokens
+	AMR       []string   `json:"amr,omitempty"`       // "oauth", "totp", "webauthn"
+	ACR       string     `json:"acr,omitempty"`       // "aal1" or "aal2"
+	// Set when max_age was given and the login is older: send the user to /authentication/login?max_age=...
+	ReauthRequired bool `json:"reauth_required,omitempty"`
+}
+
+type impersonateResp struct {
+	Token     string      `json:"token"`
+	ExpiresAt time.Time   `json:"expires_at"`
+	User      getUserResp `json:"user"`
 }
 
 type getUserResp struct {
@@ -53,9 +180,44 @@ type getUserResp struct {
 
 // --- Response Mappers ---
 
-func (h handler) newOAuthCallbackResp(token string) oauthCallbackResp {
+func (h handler) newOAuthCallbackResp(o *authentication.OAuthCallbackOutput) oauthCallbackResp {
 	return oauthCallbackResp{
-		Token: token,
+		Token:                 o.Token,
+		MFAChallenge:          o.MFAChallenge,
+		MFAMethods:            o.MFAMethods,
+		MFAEnrollmentRequired: o.MFAEnrollmentRequired,
+	}
+}
+
+func (h handler) newMagicLinkLoginResp(o *authentication.OAuthCallbackOutput, redirectURL, token, challenge string) magicLinkLoginResp {
+	return magicLinkLoginResp{
+		RedirectURL:           redirectURL,
+		Token:                 token,
+		MFAChallenge:          challenge,
+		MFAMethods:            o.MFAMethods,
+		MFAEnrollmentRequired: o.MFAEnrollmentRequired,
+	}
+}
+
+func (h handler) newMFAChallengeEnrollResp(o *authentication.MFAChallengeEnrollOutput) mfaChallengeEnrollResp {
+	return mfaChallengeEnrollResp{
+		Secret:     o.Secret,
+		OTPAuthURI: o.OTPAuthURI,
+	}
+}
+
+func (h handler) newMFAChallengeResp(o *authentication.VerifyMFAChallengeOutput, redirectURL string, token string) mfaChallengeResp {
+	return mfaChallengeResp{
+		RedirectURL:   redirectURL,
+		Token:         token,
+		RecoveryCodes: o.RecoveryCodes,
+	}
+}
+
+func (h handler) newPasskeyCeremonyResp(o *authentication.PasskeyCeremonyOutput) passkeyCeremonyResp {
+	return passkeyCeremonyResp{
+		Ceremony: o.Ceremony,
+		Options:  o.Options,
 	}
 }
 
@@ -72,13 +234,44 @@ func (h handler) newValidateTokenResp(o *authentication.TokenValidationResult) v
 	if !o.Valid {
 		return validateTokenResp{Valid: false}
 	}
-	return validateTokenResp{
-		Valid:     true,
-		UserID:    o.UserID,
-		Email:     o.Email,
-		Role:      o.Role,
-		Groups:    o.Groups,
+	resp := validateTokenResp{
+		Valid:          true,
+		TokenType:      o.TokenType,
+		UserID:         o.UserID,
+		Email:          o.Email,
+		Role:           o.Role,
+		Groups:         o.Groups,
+		Scopes:         o.Scopes,
+		Audience:       o.Audience,
+		ExpiresAt:      o.ExpiresAt,
+		Actor:          newActorResp(o.Actor),
+		AMR:            o.AMR,
+		ACR:            o.ACR,
+		ReauthRequired: o.ReauthRequired,
+	}
+	if !o.AuthTime.IsZero() {
+		resp.AuthTime = &o.AuthTime
+	}
+	return resp
+}
+
+func newActorResp(a *authentication.Actor) *actorResp {
+	if a == nil {
+		return nil
+	}
+	return &actorResp{
+		UserID:   a.UserID,
+		Email:    a.Email,
+		ClientID: a.ClientID,
+		Actor:    newActorResp(a.Act),
+	}
+}
+
+func (h handler) newImpersonateResp(o *authentication.ImpersonateOutput) impersonateResp {
+	return impersonateResp{
+		Token:     o.Token,
 		ExpiresAt: o.ExpiresAt,
+		User:      h.newGetUserResp(&o.User),
 	}
 }
 
@@ -10,8 +10,12 @@ import (
 	"identity-srv/internal/authentication"
 	"identity-srv/internal/model"
 	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
+	"unicode"
 
 	"github.com/gin-gonic/gin"
 	"github.com/smap-hcmut/shared-libs/go/auth"
@@ -36,10 +40,11 @@ func (h handler) getScope(c *gin.Context) (model.Scope, error) {
 	}
 
 	return model.Scope{
-		UserID:   userID,
-		Username: payload.Username,
-		Role:     payload.Role,
-		JTI:      payload.Id,
+		UserID:    userID,
+		Username:  payload.Username,
+		Role:      payload.Role,
+		JTI:       payload.Id,
+		ExpiresAt: payload.ExpiresAt,
 	}, nil
 }
 
@@ -55,24 +60,31 @@ func (h handler) getScope(c *gin.Context) (model.Scope, error) {
 // safe, unambiguous separator.
 
 type statePayload struct {
-	Nonce    string `json:"n"`
-	Redirect string `json:"r,omitempty"`
-	Exp      int64  `json:"e"` // Unix timestamp (5-minute window)
+	Nonce      string `json:"n"`
+	Redirect   string `json:"r,omitempty"`
+	MaxAge     *int64 `json:"m,omitempty"` // seconds, from max_age or prompt=login (0)
+	RememberMe bool   `json:"rm,omitempty"`
+	Provider   string `json:"p,omitempty"`
+	ClientID   string `json:"c,omitempty"`
+	Locale     string `json:"l,omitempty"`
+	LoginHint  string `json:"h,omitempty"`
+	Iat        int64  `json:"i,omitempty"` // Unix timestamp of the login request
+	Exp        int64  `json:"e"`           // Unix timestamp (5-minute window)
 }
 
 // generateSignedState creates a tamper-proof state token that embeds the
-// post-login redirect URL. No cookie is needed.
-func (h handler) generateSignedState(redirectURL string) (string, error) {
+// login context: redirect URL, max_age, remember_me, provider, client, locale
+// and login hint. No cookie is needed.
+func (h handler) generateSignedState(payload statePayload) (string, error) {
 	nonceBytes := make([]byte, 16)
 	if _, err := rand.Read(nonceBytes); err != nil {
 		return "", fmt.Errorf("generateSignedState: rand.Read: %w", err)
 	}
 
-	payload := statePayload{
-		Nonce:    base64.RawURLEncoding.EncodeToString(nonceBytes),
-		Redirect: redirectURL,
-		Exp:      time.Now().Add(5 * time.Minute).Unix(),
-	}
+	now := time.Now()
+	payload.Nonce = base64.RawURLEncoding.EncodeToString(nonceBytes)
+	payload.Iat = now.Unix()
+	payload.Exp = now.Add(5 * time.Minute).Unix()
 
 	payloadJSON, err := json.Marshal(payload)
 	if err != nil {
@@ -126,49 +138,293 @@ func (h handler) computeStateHMAC(data string) string {
 
 // --- Process request functions ---
 
-// processLoginRequest generates a signed state (embedding the redirect URL) and
-// returns the login input for the use case.
+// processLoginRequest reads the forced re-authentication options and the
+// login context, seals them in a signed state and returns the login input for
+// the use case.
 func (h handler) processLoginRequest(c *gin.Context) (authentication.OAuthLoginInput, error) {
-	redirectURL := c.Query("redirect")
-	signedState, err := h.generateSignedState(redirectURL)
+	maxAge, err := parseMaxAge(c.Query("max_age"), c.Query("prompt"))
+	if err != nil {
+		return authentication.OAuthLoginInput{}, err
+	}
+	payload, err := parseLoginContext(c)
 	if err != nil {
 		return authentication.OAuthLoginInput{}, err
 	}
-	return authentication.OAuthLoginInput{
-		RedirectURL: redirectURL,
+	payload.Redirect = c.Query("redirect")
+	payload.MaxAge = maxAge
+
+	signedState, err := h.generateSignedState(payload)
+	if err != nil {
+		h.l.Errorf(c.Request.Context(), "generateSignedState: %v", err)
+		return authentication.OAuthLoginInput{}, errInternalSystem
+	}
+
+	input := authentication.OAuthLoginInput{
+		RedirectURL: payload.Redirect,
 		State:       signedState,
-	}, nil
+		Provider:    payload.Provider,
+		ClientID:    payload.ClientID,
+		LoginHint:   payload.LoginHint,
+		Locale:      payload.Locale,
+	}
+	if maxAge != nil {
+		d := time.Duration(*maxAge) * time.Second
+		input.MaxAge = &d
+	}
+	return input, nil
+}
+
+// parseMaxAge reads the OIDC max_age and prompt parameters. prompt=login is
+// max_age=0; the stricter of the two wins.
+func parseMaxAge(maxAgeParam, prompt string) (*int64, error) {
+	var maxAge *int64
+	if maxAgeParam != "" {
+		v, err := strconv.ParseInt(maxAgeParam, 10, 64)
+		if err != nil || v < 0 {
+			return nil, errInvalidLoginParams
+		}
+		maxAge = &v
+	}
+
+	switch prompt {
+	case "":
+	case "login":
+		zero := int64(0)
+		maxAge = &zero
+	default:
+		return nil, errInvalidLoginParams
+	}
+	return maxAge, nil
+}
+
+// maxLoginHintLength is the longest email address (RFC 5321)
+const maxLoginHintLength = 254
+
+var (
+	clientIDPattern = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
+	localePattern   = regexp.MustCompile(`^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$`)
+)
+
+// parseLoginContext reads remember_me, provider, client_id, login_hint and the
+// UI locale of a login. The locale is the locale parameter or else the first
+// Accept-Language tag, the header middleware.Locale reads; a malformed header
+// is ignored rather than failing the login.
+func parseLoginContext(c *gin.Context) (statePayload, error) {
+	var p statePayload
+	if v := c.Query("remember_me"); v != "" {
+		rememberMe, err := strconv.ParseBool(v)
+		if err != nil {
+			return statePayload{}, errInvalidLoginParams
+		}
+		p.RememberMe = rememberMe
+	}
+
+	p.Provider = strings.ToLower(strings.TrimSpace(c.Query("provider")))
+	p.ClientID = strings.TrimSpace(c.Query("client_id"))
+	if p.ClientID != "" && !clientIDPattern.MatchString(p.ClientID) {
+		return statePayload{}, errInvalidLoginParams
+	}
+
+	p.LoginHint = strings.TrimSpace(c.Query("login_hint"))
+	if len(p.LoginHint) > maxLoginHintLength || strings.IndexFunc(p.LoginHint, unicode.IsControl) >= 0 {
+		return statePayload{}, errInvalidLoginParams
+	}
+
+	if locale := c.Query("locale"); locale != "" {
+		if !localePattern.MatchString(locale) {
+			return statePayload{}, errInvalidLoginParams
+		}
+		p.Locale = locale
+	} else if tag := acceptLanguageTag(c.GetHeader("Accept-Language")); localePattern.MatchString(tag) {
+		p.Locale = tag
+	}
+	return p, nil
+}
+
+// acceptLanguageTag returns the first language tag of an Accept-Language header
+func acceptLanguageTag(header string) string {
+	tag, _, _ := strings.Cut(header, ",")
+	tag, _, _ = strings.Cut(tag, ";")
+	return strings.TrimSpace(tag)
 }
 
 // processCallbackRequest validates the HMAC-signed state from the query param and
-// returns the callback input together with the redirect URL embedded in the state.
-// No cookies are read — this works regardless of which origin the callback arrives from.
-func (h handler) processCallbackRequest(c *gin.Context) (authentication.OAuthCallbackInput, string, error) {
+// returns the callback input, with the login context restored from the state.
+// No cookies are read — this works regardless of which origin the callback
+// arrives from.
+func (h handler) processCallbackRequest(c *gin.Context) (authentication.OAuthCallbackInput, error) {
 	state := c.Query("state")
 	payload, err := h.verifySignedState(state)
 	if err != nil {
-		return authentication.OAuthCallbackInput{}, "", errInvalidState
+		return authentication.OAuthCallbackInput{}, errInvalidState
 	}
 
 	code := c.Query("code")
 	if code == "" {
-		return authentication.OAuthCallbackInput{}, "", errMissingCode
+		return authentication.OAuthCallbackInput{}, errMissingCode
+	}
+
+	input := authentication.OAuthCallbackInput{
+		Code:        code,
+		RememberMe:  payload.RememberMe,
+		RedirectURL: payload.Redirect,
+		Provider:    payload.Provider,
+		ClientID:    payload.ClientID,
+		Locale:      payload.Locale,
+		LoginHint:   payload.LoginHint,
+		IPAddress:   c.ClientIP(),
+		UserAgent:   c.Request.UserAgent(),
+	}
+	if payload.MaxAge != nil {
+		input.AuthNotBefore = time.Unix(payload.Iat-*payload.MaxAge, 0)
+	}
+	return input, nil
+}
+
+func (h handler) processMFAChallengeEnrollRequest(c *gin.Context) (string, error) {
+	var req mfaChallengeEnrollReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		return "", errWrongBody
+	}
+	return req.Challenge, nil
+}
+
+func (h handler) processMFAChallengeRequest(c *gin.Context) (authentication.VerifyMFAChallengeInput, error) {
+	var req mfaChallengeReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		return authentication.VerifyMFAChallengeInput{}, errWrongBody
+	}
+	return authentication.VerifyMFAChallengeInput{
+		Challenge: req.Challenge,
+		Code:      req.Code,
+		IPAddress: c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+	}, nil
+}
+
+func (h handler) processMFAPasskeyBeginRequest(c *gin.Context) (string, error) {
+	var req mfaPasskeyBeginReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		return "", errWrongBody
 	}
+	return req.Challenge, nil
+}
 
-	return authentication.OAuthCallbackInput{
-		Code:       code,
-		RememberMe: c.Query("remember_me") == "true",
+func (h handler) processMFAPasskeyRequest(c *gin.Context) (authentication.VerifyMFAPasskeyInput, error) {
+	var req mfaPasskeyReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		return authentication.VerifyMFAPasskeyInput{}, errWrongBody
+	}
+	return authentication.VerifyMFAPasskeyInput{
+		Challenge:  req.Challenge,
+		Ceremony:   req.Ceremony,
+		Credential: req.Credential,
 		IPAddress:  c.ClientIP(),
 		UserAgent:  c.Request.UserAgent(),
-	}, payload.Redirect, nil
+	}, nil
 }
 
-func (h handler) processValidateTokenRequest(c *gin.Context) (string, error) {
-	var req validateTokenReq
+func (h handler) processPasskeyLoginRequest(c *gin.Context) (authentication.PasskeyLoginInput, error) {
+	var req passkeyLoginReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		return authentication.PasskeyLoginInput{}, errWrongBody
+	}
+	clientID := strings.TrimSpace(req.ClientID)
+	if clientID != "" && !clientIDPattern.MatchString(clientID) {
+		return authentication.PasskeyLoginInput{}, errInvalidLoginParams
+	}
+	return authentication.PasskeyLoginInput{
+		Ceremony:    req.Ceremony,
+		Credential:  req.Credential,
+		RememberMe:  req.RememberMe,
+		RedirectURL: req.Redirect,
+		ClientID:    clientID,
+		IPAddress:   c.ClientIP(),
+		UserAgent:   c.Request.UserAgent(),
+	}, nil
+}
+
+func (h handler) processMagicLinkRequest(c *gin.Context) (authentication.MagicLinkRequestInput, error) {
+	var req magicLinkReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		return authentication.MagicLinkRequestInput{}, errWrongBody
+	}
+	clientID := strings.TrimSpace(req.ClientID)
+	if clientID != "" && !clientIDPattern.MatchString(clientID) {
+		return authentication.MagicLinkRequestInput{}, errInvalidLoginParams
+	}
+	return authentication.MagicLinkRequestInput{
+		Email:       strings.TrimSpace(req.Email),
+		RedirectURL: req.Redirect,
+		RememberMe:  req.RememberMe,
+		ClientID:    clientID,
+		IPAddress:   c.ClientIP(),
+		UserAgent:   c.Request.UserAgent(),
+	}, nil
+}
+
+func (h handler) processMagicLinkLoginRequest(c *gin.Context) (authentication.MagicLinkLoginInput, error) {
+	var req magicLinkLoginReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		return authentication.MagicLinkLoginInput{}, errWrongBody
+	}
+	return authentication.MagicLinkLoginInput{
+		Token:     strings.TrimSpace(req.Token),
+		IPAddress: c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+	}, nil
+}
+
+func (h handler) processExchangeLoginCodeRequest(c *gin.Context) (string, error) {
+	var req exchangeLoginCodeReq
 	if err := c.ShouldBindJSON(&req); err != nil {
 		return "", errWrongBody
 	}
-	return req.Token, nil
+	return strings.TrimSpace(req.Code), nil
+}
+
+// processEndSessionRequest reads the logout parameters and the token to revoke.
+// Any page can trigger a GET (an <img> tag), so a GET only revokes the token
+// named by id_token_hint; a POST may revoke the cookie or header token. The
+// token is optional: an RP-initiated logout must still redirect when it is missing.
+func (h handler) processEndSessionRequest(c *gin.Context) authentication.EndSessionInput {
+	param := func(key string) string {
+		if v := c.PostForm(key); v != "" {
+			return v
+		}
+		return c.Query(key)
+	}
+
+	input := authentication.EndSessionInput{
+		Token:                 param("id_token_hint"),
+		SessionToken:          h.extractToken(c),
+		PostLogoutRedirectURI: param("post_logout_redirect_uri"),
+		State:                 param("state"),
+		IdPLogout:             param("idp_logout") == "true",
+	}
+	if input.Token == "" && c.Request.Method == http.MethodPost {
+		input.Token = input.SessionToken
+	}
+	return input
+}
+
+// extractToken reads the token from the auth cookie, falling back to the Bearer header
+func (h handler) extractToken(c *gin.Context) string {
+	if token, err := c.Cookie(h.cookieConfig.Name); err == nil && token != "" {
+		return token
+	}
+	if header := c.GetHeader("Authorization"); strings.HasPrefix(header, "Bearer ") {
+		return strings.TrimPrefix(header, "Bearer ")
+	}
+	return ""
+}
+
+func (h handler) processValidateTokenRequest(c *gin.Context) (authentication.ValidateTokenInput, error) {
+	var req validateTokenReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		return authentication.ValidateTokenInput{}, errWrongBody
+	}
+	return req.toInput(), nil
 }
 
 func (h handler) processRevokeTokenRequest(c *gin.Context) (revokeTokenReq, error) {
@@ -195,9 +451,50 @@ func (h handler) processGetUserRequest(c *gin.Context) (string, error) {
 	return userID, nil
 }
 
+func (h handler) processDeactivateUserRequest(c *gin.Context) (string, model.Scope, error) {
+	sc, err := h.getScope(c)
+	if err != nil {
+		return "", model.Scope{}, errScopeNotFound
+	}
+
+	userID := c.Param("id")
+	if userID == "" {
+		return "", model.Scope{}, errMissingUserID
+	}
+	return userID, sc, nil
+}
+
+func (h handler) processImpersonateRequest(c *gin.Context) (authentication.ImpersonateInput, model.Scope, error) {
+	sc, err := h.getScope(c)
+	if err != nil {
+		return authentication.ImpersonateInput{}, model.Scope{}, errScopeNotFound
+	}
+
+	userID := c.Param("userID")
+	if userID == "" {
+		return authentication.ImpersonateInput{}, model.Scope{}, errMissingUserID
+	}
+
+	// The body is optional
+	var req impersonateReq
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			return authentication.ImpersonateInput{}, model.Scope{}, errWrongBody
+		}
+	}
+
+	return authentication.ImpersonateInput{
+		TargetUserID: userID,
+		Reason:       req.Reason,
+		IPAddress:    c.ClientIP(),
+		UserAgent:    c.Request.UserAgent(),
+	}, sc, nil
+}
+
 // setAuthCookieForRedirect sets the auth cookie with SameSite determined by the
 // redirect destination rather than the Origin header (which is absent in OAuth redirects).
-func (h handler) setAuthCookieForRedirect(c *gin.Context, token string, redirectURL string) {
+// cookieDomain overrides cookie.domain for a registered application.
+func (h handler) setAuthCookieForRedirect(c *gin.Context, token, redirectURL, cookieDomain string) {
 	isLocalhost := strings.HasPrefix(redirectURL, "http://localhost") ||
 		strings.HasPrefix(redirectURL, "https://localhost")
 
@@ -214,8 +511,61 @@ func (h handler) setAuthCookieForRedirect(c *gin.Context, token string, redirect
 			SameSite: http.SameSiteNoneMode,
 		})
 	} else {
-		auth.GinSetAuthCookie(c, token, h.cookieConfig.Domain)
+		if cookieDomain == "" {
+			cookieDomain = h.cookieConfig.Domain
+		}
+		auth.GinSetAuthCookie(c, token, cookieDomain)
+	}
+}
+
+// setQueryParam sets a query parameter on a URL, leaving unparsable URLs unchanged
+func setQueryParam(rawURL, key, value string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	q := parsed.Query()
+	q.Set(key, value)
+	parsed.RawQuery = q.Encode()
+	return parsed.String()
+}
+
+// withCodeParam passes a one-time code in the redirect URL so the frontend can
+// set its own cookie when it runs on a different domain (e.g., localhost dev).
+// The frontend callback page redeems ?code=... server-side with
+// POST /authentication/exchange and sets an HttpOnly cookie on its own domain.
+// The token itself never appears in browser history, proxy logs or Referer.
+func withCodeParam(redirectURL, code string) string {
+	return setQueryParam(redirectURL, "code", code)
+}
+
+// mfaChallengeURL points the browser at the frontend page that completes an MFA
+// challenge, in the locale the login started with
+func (h handler) mfaChallengeURL(output *authentication.OAuthCallbackOutput, locale string) string {
+	challengeURL := setQueryParam(h.config.MFA.ChallengeURL, "challenge", output.MFAChallenge)
+	if output.MFAEnrollmentRequired {
+		challengeURL = setQueryParam(challengeURL, "enroll", "true")
+	} else {
+		challengeURL = setQueryParam(challengeURL, "methods", strings.Join(output.MFAMethods, ","))
+	}
+	if locale != "" {
+		challengeURL = setQueryParam(challengeURL, "locale", locale)
+	}
+	return challengeURL
+}
+
+// loginRedirect sets the auth cookie for a completed login and returns where
+// the frontend should go next, with a one-time code for the token
+func (h handler) loginRedirect(c *gin.Context, token, redirectURL, cookieDomain string) (string, error) {
+	if redirectURL == "" {
+		redirectURL = "/dashboard"
+	}
+	code, err := h.uc.CreateLoginCode(c.Request.Context(), token)
+	if err != nil {
+		return "", err
 	}
+	h.setAuthCookieForRedirect(c, token, redirectURL, cookieDomain)
+	return withCodeParam(redirectURL, code), nil
 }
 
 func (h handler) expireAuthCookie(c *gin.Context) {
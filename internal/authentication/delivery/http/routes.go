@@ -1,25 +1,56 @@
 package http
 
 import (
+	internalmw "identity-srv/internal/middleware"
+	"identity-srv/internal/ratelimit"
+
 	"github.com/gin-gonic/gin"
 	"github.com/smap-hcmut/shared-libs/go/middleware"
 )
 
-func (h handler) RegisterRoutes(r *gin.RouterGroup, mw *middleware.Middleware) {
+// Scopes a service token needs for each internal route
+const (
+	scopeTokensValidate   = "tokens:validate"
+	scopeTokensRevoke     = "tokens:revoke"
+	scopeUsersRead        = "users:read"
+	scopeUsersImpersonate = "users:impersonate"
+	scopeUsersDeactivate  = "users:deactivate"
+)
+
+func (h handler) RegisterRoutes(r *gin.RouterGroup, mw *middleware.Middleware, imw *internalmw.Middleware) {
 	// Public routes
-	r.GET("/login", h.OAuthLogin)
-	r.GET("/callback", h.OAuthCallback)
+	r.GET("/login", imw.RateLimit(ratelimit.RouteLogin), h.OAuthLogin)
+	r.GET("/callback", imw.RateLimit(ratelimit.RouteCallback), h.OAuthCallback)
+	r.GET("/end-session", h.EndSession)  // revokes only the id_token_hint token
+	r.POST("/end-session", h.EndSession) // also revokes the cookie/header token
+	r.POST("/exchange", h.ExchangeLoginCode)
+
+	// MFA step-up challenge (the signed challenge from the callback stands in for a session)
+	r.POST("/mfa/challenge", h.MFAChallenge)
+	r.POST("/mfa/challenge/enroll", h.MFAChallengeEnroll)
+	r.POST("/mfa/challenge/passkey/begin", h.MFAPasskeyChallengeBegin)
+	r.POST("/mfa/challenge/passkey", h.MFAPasskeyChallenge)
+
+	// Passwordless login with a passkey (when passkey.enabled)
+	r.POST("/passkey/login/begin", h.PasskeyLoginBegin)
+	r.POST("/passkey/login", h.PasskeyLogin)
+
+	// Passwordless login with an emailed link (when magic_link.enabled)
+	r.POST("/magic-link", imw.RateLimit(ratelimit.RouteMagicLink), h.RequestMagicLink)
+	r.POST("/magic-link/login", h.MagicLinkLogin)
 
 	// Protected routes (require authentication)
 	r.POST("/logout", mw.Auth(), h.Logout)
+	r.POST("/logout-all", mw.Auth(), imw.SelfService(), h.LogoutAll)
 	r.GET("/me", mw.Auth(), h.GetMe)
 
-	// Internal routes (require X-Internal-Key header)
+	// Internal routes (require X-Internal-Key header or a service token with the route's scope)
 	internal := r.Group("/internal")
-	internal.Use(mw.InternalAuth())
 	{
-		internal.POST("/validate", h.ValidateToken)
-		internal.POST("/revoke-token", mw.Auth(), mw.AdminOnly(), h.RevokeToken)
-		internal.GET("/users/:id", h.GetUserByID)
+		internal.POST("/validate", imw.InternalAuth(scopeTokensValidate), imw.RateLimit(ratelimit.RouteValidate), h.ValidateToken)
+		internal.POST("/revoke-token", imw.InternalAuth(scopeTokensRevoke), mw.Auth(), mw.AdminOnly(), imw.Admin(), h.RevokeToken)
+		internal.GET("/users/:id", imw.InternalAuth(scopeUsersRead), h.GetUserByID)
+		internal.POST("/impersonate/:userID", imw.InternalAuth(scopeUsersImpersonate), mw.Auth(), mw.AdminOnly(), imw.Admin(), h.Impersonate)
+		internal.POST("/users/:id/deactivate", imw.InternalAuth(scopeUsersDeactivate), mw.Auth(), mw.AdminOnly(), imw.Admin(), h.DeactivateUser)
 	}
 }
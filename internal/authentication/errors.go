@@ -21,4 +21,23 @@ var (
 	ErrRedirectURLNotAllowed = errors.New("redirect url not allowed")
 	ErrInternalSystem        = errors.New("internal system error")
 	ErrUserCreation          = errors.New("failed to create or update user")
+	ErrBlacklistDisabled     = errors.New("token blacklist disabled")
+	ErrCannotImpersonate     = errors.New("user cannot be impersonated")
+	ErrCannotDeactivate      = errors.New("user cannot be deactivated")
+	ErrInvalidSubjectToken   = errors.New("invalid subject token")
+	ErrInvalidMFAChallenge   = errors.New("invalid mfa challenge")
+	ErrMFANotEnrolled        = errors.New("mfa not enrolled")
+	ErrMFAAlreadyEnrolled    = errors.New("mfa already enrolled")
+	ErrInvalidPasskey        = errors.New("invalid passkey ceremony")
+	ErrPasskeyNotVerified    = errors.New("passkey verification failed")
+	ErrReauthRequired        = errors.New("re-authentication required")
+	ErrInvalidMagicLink      = errors.New("invalid magic link")
+	ErrAccessPending         = errors.New("access request pending approval")
+	ErrInvalidLoginCode      = errors.New("invalid login code")
+	ErrUnknownClient         = errors.New("unknown client")
+	ErrRoleNotAllowed        = errors.New("role not allowed for application")
+	ErrInvalidToken          = errors.New("invalid or revoked token")
+	ErrImpersonatedToken     = errors.New("not allowed with an impersonation token")
+	ErrScopedToken           = errors.New("not allowed with a token scoped to a service")
+	ErrAdminRequired         = errors.New("admin role required")
 )
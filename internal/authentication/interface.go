@@ -1,23 +1,47 @@
-package authentication
-
-import (
-	"context"
-	"identity-srv/internal/model"
-)
-
-// UseCase interface for authentication module
-type UseCase interface {
-	// User operations
-	GetCurrentUser(ctx context.Context, sc model.Scope) (*model.User, error)
-	GetUserByID(ctx context.Context, userID string) (*model.User, error)
-
-	// Session & Token operations
-	Logout(ctx context.Context, sc model.Scope) error
-	ValidateToken(ctx context.Context, token string) (*TokenValidationResult, error)
-	RevokeToken(ctx context.Context, jti string) error
-	RevokeAllUserTokens(ctx context.Context, userID string) error
-
-	// OAuth flow
-	InitiateOAuthLogin(ctx context.Context, input OAuthLoginInput) (*OAuthLoginOutput, error)
-	ProcessOAuthCallback(ctx context.Context, input OAuthCallbackInput) (*OAuthCallbackOutput, error)
-}
+package authentication
+
+import (
+	"context"
+	"identity-srv/internal/model"
+)
+
+// UseCase interface for authentication module
+type UseCase interface {
+	// User operations
+	GetCurrentUser(ctx context.Context, sc model.Scope) (*model.User, error)
+	GetUserByID(ctx context.Context, userID string) (*model.User, error)
+
+	// Session & Token operations
+	Logout(ctx context.Context, sc model.Scope) error
+	EndSession(ctx context.Context, input EndSessionInput) (*EndSessionOutput, error)
+	ValidateToken(ctx context.Context, input ValidateTokenInput) (*TokenValidationResult, error)
+	RevokeToken(ctx context.Context, jti string) error
+	RevokeAllUserTokens(ctx context.Context, userID string) error
+	Impersonate(ctx context.Context, sc model.Scope, input ImpersonateInput) (*ImpersonateOutput, error)
+	DeactivateUser(ctx context.Context, sc model.Scope, userID string) (*model.User, error)
+	ExchangeToken(ctx context.Context, input ExchangeTokenInput) (*ExchangeTokenOutput, error)
+	AuthorizeSelfService(ctx context.Context, input AuthorizeSelfServiceInput) error
+	AuthorizeAdmin(ctx context.Context, input AuthorizeAdminInput) error
+
+	// OAuth flow
+	InitiateOAuthLogin(ctx context.Context, input OAuthLoginInput) (*OAuthLoginOutput, error)
+	ProcessOAuthCallback(ctx context.Context, input OAuthCallbackInput) (*OAuthCallbackOutput, error)
+
+	// One-time codes that hand a login token to the frontend through the redirect URL
+	CreateLoginCode(ctx context.Context, token string) (string, error)
+	RedeemLoginCode(ctx context.Context, code string) (*RedeemLoginCodeOutput, error)
+
+	// MFA step-up challenge (issued by ProcessOAuthCallback)
+	EnrollMFAChallenge(ctx context.Context, challenge string) (*MFAChallengeEnrollOutput, error)
+	VerifyMFAChallenge(ctx context.Context, input VerifyMFAChallengeInput) (*VerifyMFAChallengeOutput, error)
+	BeginMFAPasskeyChallenge(ctx context.Context, challenge string) (*PasskeyCeremonyOutput, error)
+	VerifyMFAPasskeyChallenge(ctx context.Context, input VerifyMFAPasskeyInput) (*VerifyMFAChallengeOutput, error)
+
+	// Passwordless login with a discoverable passkey
+	BeginPasskeyLogin(ctx context.Context) (*PasskeyCeremonyOutput, error)
+	FinishPasskeyLogin(ctx context.Context, input PasskeyLoginInput) (*PasskeyLoginOutput, error)
+
+	// Passwordless login with an emailed link; the MFA challenge applies as after the OAuth callback
+	RequestMagicLink(ctx context.Context, input MagicLinkRequestInput) error
+	MagicLinkLogin(ctx context.Context, input MagicLinkLoginInput) (*OAuthCallbackOutput, error)
+}
@@ -1,6 +1,45 @@
 package repository
 
+import (
+	"context"
+	"time"
+)
+
 // Repository interface for authentication module
 type Repository interface {
 	// User related (if needed, currently mostly handled by internal/user)
 }
+
+// SessionManager stores login sessions keyed by token JTI.
+// The backend (redis, postgres, memory) is selected by session.backend.
+type SessionManager interface {
+	CreateSession(ctx context.Context, opts CreateSessionOptions) error
+	GetSession(ctx context.Context, jti string) (*SessionData, error)
+	DeleteSession(ctx context.Context, jti string) error
+	GetAllUserSessions(ctx context.Context, userID string) ([]string, error)
+	DeleteUserSessions(ctx context.Context, userID string) error
+	SessionExists(ctx context.Context, jti string) (bool, error)
+}
+
+// BlacklistManager stores revoked token JTIs until the token would have expired,
+// plus a per-user "revoked before" watermark that rejects every older token.
+// The backend (redis, postgres, memory) is selected by blacklist.backend.
+type BlacklistManager interface {
+	AddToken(ctx context.Context, jti string, expiresAt time.Time) error
+	IsBlacklisted(ctx context.Context, jti string) (bool, error)
+	RemoveToken(ctx context.Context, jti string) error
+	// SetRevokedBefore rejects all tokens of userID issued before `before`.
+	// The watermark may be dropped after expiresAt, when no such token can still be valid.
+	SetRevokedBefore(ctx context.Context, userID string, before, expiresAt time.Time) error
+	// GetRevokedBefore returns the zero time when the user has no watermark.
+	GetRevokedBefore(ctx context.Context, userID string) (time.Time, error)
+}
+
+// LoginCodeStore holds the one-time codes that hand a login token to the
+// frontend. The backend (redis, memory) is selected by login_code.backend.
+type LoginCodeStore interface {
+	SaveLoginCode(ctx context.Context, code, token string, ttl time.Duration) error
+	// TakeLoginCode returns the code's token and deletes the code, so each code
+	// is redeemed once. It returns "" when the code is unknown or expired.
+	TakeLoginCode(ctx context.Context, code string) (string, error)
+}
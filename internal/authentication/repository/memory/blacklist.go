@@ -0,0 +1,81 @@
+package memory
+
+import (
+	"context"
+	"time"
+)
+
+// AddToken adds a token to the blacklist until its expiry
+func (bm *implBlacklistManager) AddToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	now := bm.clock()
+	if !expiresAt.After(now) {
+		// Token already expired, no need to blacklist
+		return nil
+	}
+
+	bm.mu.Lock()
+	defer bm.mu.Unlock()
+
+	bm.purgeExpiredLocked(now)
+	bm.entries[jti] = expiresAt
+	return nil
+}
+
+// IsBlacklisted checks if a token is blacklisted by JTI
+func (bm *implBlacklistManager) IsBlacklisted(ctx context.Context, jti string) (bool, error) {
+	bm.mu.RLock()
+	defer bm.mu.RUnlock()
+
+	expiresAt, ok := bm.entries[jti]
+	return ok && expiresAt.After(bm.clock()), nil
+}
+
+// RemoveToken removes a token from the blacklist
+func (bm *implBlacklistManager) RemoveToken(ctx context.Context, jti string) error {
+	bm.mu.Lock()
+	defer bm.mu.Unlock()
+
+	delete(bm.entries, jti)
+	return nil
+}
+
+// SetRevokedBefore stores the user's revocation watermark until expiresAt
+func (bm *implBlacklistManager) SetRevokedBefore(ctx context.Context, userID string, before, expiresAt time.Time) error {
+	now := bm.clock()
+	if !expiresAt.After(now) {
+		return nil
+	}
+
+	bm.mu.Lock()
+	defer bm.mu.Unlock()
+
+	bm.purgeExpiredLocked(now)
+	bm.watermarks[userID] = watermark{before: before, expiresAt: expiresAt}
+	return nil
+}
+
+// GetRevokedBefore returns the user's revocation watermark, zero if none
+func (bm *implBlacklistManager) GetRevokedBefore(ctx context.Context, userID string) (time.Time, error) {
+	bm.mu.RLock()
+	defer bm.mu.RUnlock()
+
+	w, ok := bm.watermarks[userID]
+	if !ok || !w.expiresAt.After(bm.clock()) {
+		return time.Time{}, nil
+	}
+	return w.before, nil
+}
+
+// purgeExpiredLocked drops expired entries; the caller must hold the write lock
+func (bm *implBlacklistManager) purgeExpiredLocked(now time.Time) {
+	for jti, expiresAt := range bm.entries {
+		if !expiresAt.After(now) {
+			delete(bm.entries, jti)
+		}
+	}
+	for userID, w := range bm.watermarks {
+		if !w.expiresAt.After(now) {
+			delete(bm.watermarks, userID)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package memory
+
+import (
+	"context"
+	"time"
+)
+
+// SaveLoginCode stores the token under the code until ttl
+func (s *implLoginCodeStore) SaveLoginCode(ctx context.Context, code, token string, ttl time.Duration) error {
+	now := s.clock()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.purgeExpiredLocked(now)
+	s.codes[code] = loginCode{token: token, expiresAt: now.Add(ttl)}
+	return nil
+}
+
+// TakeLoginCode returns the code's token and deletes the code
+func (s *implLoginCodeStore) TakeLoginCode(ctx context.Context, code string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, ok := s.codes[code]
+	delete(s.codes, code)
+	if !ok || !c.expiresAt.After(s.clock()) {
+		return "", nil
+	}
+	return c.token, nil
+}
+
+// purgeExpiredLocked drops expired codes; the caller must hold the lock
+func (s *implLoginCodeStore) purgeExpiredLocked(now time.Time) {
+	for code, c := range s.codes {
+		if !c.expiresAt.After(now) {
+			delete(s.codes, code)
+		}
+	}
+}
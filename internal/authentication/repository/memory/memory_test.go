@@ -0,0 +1,114 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"identity-srv/internal/authentication/repository"
+)
+
+func TestSessionManagerExpiry(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	sm := NewSessionManager(time.Hour, 24*time.Hour).(*implSessionManager)
+	sm.clock = func() time.Time { return now }
+
+	if err := sm.CreateSession(ctx, repository.CreateSessionOptions{UserID: "user-1", JTI: "short"}); err != nil {
+		t.Fatalf("CreateSession: %v", err)
+	}
+	if err := sm.CreateSession(ctx, repository.CreateSessionOptions{UserID: "user-1", JTI: "long", RememberMe: true}); err != nil {
+		t.Fatalf("CreateSession: %v", err)
+	}
+
+	now = now.Add(2 * time.Hour)
+
+	if ok, _ := sm.SessionExists(ctx, "short"); ok {
+		t.Fatalf("short session should have expired")
+	}
+	jtis, _ := sm.GetAllUserSessions(ctx, "user-1")
+	if len(jtis) != 1 || jtis[0] != "long" {
+		t.Fatalf("GetAllUserSessions = %v, want [long]", jtis)
+	}
+
+	if err := sm.DeleteUserSessions(ctx, "user-1"); err != nil {
+		t.Fatalf("DeleteUserSessions: %v", err)
+	}
+	if ok, _ := sm.SessionExists(ctx, "long"); ok {
+		t.Fatalf("long session should have been deleted")
+	}
+}
+
+func TestBlacklistManagerExpiry(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	bm := NewBlacklistManager().(*implBlacklistManager)
+	bm.clock = func() time.Time { return now }
+
+	if err := bm.AddToken(ctx, "jti-1", now.Add(time.Hour)); err != nil {
+		t.Fatalf("AddToken: %v", err)
+	}
+	if err := bm.AddToken(ctx, "jti-expired", now.Add(-time.Minute)); err != nil {
+		t.Fatalf("AddToken: %v", err)
+	}
+
+	if ok, _ := bm.IsBlacklisted(ctx, "jti-1"); !ok {
+		t.Fatalf("jti-1 should be blacklisted")
+	}
+	if ok, _ := bm.IsBlacklisted(ctx, "jti-expired"); ok {
+		t.Fatalf("already expired token should not be stored")
+	}
+
+	now = now.Add(2 * time.Hour)
+	if ok, _ := bm.IsBlacklisted(ctx, "jti-1"); ok {
+		t.Fatalf("jti-1 should have expired from the blacklist")
+	}
+}
+
+func TestBlacklistManagerRevokedBefore(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	bm := NewBlacklistManager().(*implBlacklistManager)
+	bm.clock = func() time.Time { return now }
+
+	if before, _ := bm.GetRevokedBefore(ctx, "user-1"); !before.IsZero() {
+		t.Fatalf("GetRevokedBefore = %v, want zero", before)
+	}
+
+	if err := bm.SetRevokedBefore(ctx, "user-1", now, now.Add(time.Hour)); err != nil {
+		t.Fatalf("SetRevokedBefore: %v", err)
+	}
+	if before, _ := bm.GetRevokedBefore(ctx, "user-1"); !before.Equal(now) {
+		t.Fatalf("GetRevokedBefore = %v, want %v", before, now)
+	}
+
+	now = now.Add(2 * time.Hour)
+	if before, _ := bm.GetRevokedBefore(ctx, "user-1"); !before.IsZero() {
+		t.Fatalf("watermark should expire with the longest token lifetime")
+	}
+}
+
+func TestLoginCodeStoreOneTime(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := NewLoginCodeStore().(*implLoginCodeStore)
+	s.clock = func() time.Time { return now }
+
+	if err := s.SaveLoginCode(ctx, "code-1", "token-1", time.Minute); err != nil {
+		t.Fatalf("SaveLoginCode: %v", err)
+	}
+	if token, _ := s.TakeLoginCode(ctx, "code-1"); token != "token-1" {
+		t.Fatalf("TakeLoginCode = %q, want token-1", token)
+	}
+	if token, _ := s.TakeLoginCode(ctx, "code-1"); token != "" {
+		t.Fatalf("a code should be redeemed only once, got %q", token)
+	}
+
+	if err := s.SaveLoginCode(ctx, "code-2", "token-2", time.Minute); err != nil {
+		t.Fatalf("SaveLoginCode: %v", err)
+	}
+	now = now.Add(2 * time.Minute)
+	if token, _ := s.TakeLoginCode(ctx, "code-2"); token != "" {
+		t.Fatalf("expired code should not be redeemed, got %q", token)
+	}
+}
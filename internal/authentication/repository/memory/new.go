@@ -0,0 +1,74 @@
+package memory
+
+import (
+	"sync"
+	"time"
+
+	"identity-srv/internal/authentication/repository"
+)
+
+// The memory backends keep state in process. They are meant for local
+// development and unit tests; a multi-replica deployment must use redis or
+// postgres because each instance would see its own sessions and blacklist.
+
+type implSessionManager struct {
+	mu            sync.RWMutex
+	sessions      map[string]repository.SessionData
+	ttl           time.Duration
+	rememberMeTTL time.Duration
+	clock         func() time.Time
+}
+
+type implBlacklistManager struct {
+	mu         sync.RWMutex
+	entries    map[string]time.Time
+	watermarks map[string]watermark
+	clock      func() time.Time
+}
+
+type watermark struct {
+	before    time.Time
+	expiresAt time.Time
+}
+
+type implLoginCodeStore struct {
+	mu    sync.Mutex
+	codes map[string]loginCode
+	clock func() time.Time
+}
+
+type loginCode struct {
+	token     string
+	expiresAt time.Time
+}
+
+var _ repository.SessionManager = &implSessionManager{}
+var _ repository.BlacklistManager = &implBlacklistManager{}
+var _ repository.LoginCodeStore = &implLoginCodeStore{}
+
+// NewSessionManager creates an in-memory session manager
+func NewSessionManager(ttl, rememberMeTTL time.Duration) repository.SessionManager {
+	return &implSessionManager{
+		sessions:      make(map[string]repository.SessionData),
+		ttl:           ttl,
+		rememberMeTTL: rememberMeTTL,
+		clock:         time.Now,
+	}
+}
+
+// NewBlacklistManager creates an in-memory blacklist manager
+func NewBlacklistManager() repository.BlacklistManager {
+	return &implBlacklistManager{
+		entries:    make(map[string]time.Time),
+		watermarks: make(map[string]watermark),
+		clock:      time.Now,
+	}
+}
+
+// NewLoginCodeStore creates an in-memory login code store
+func NewLoginCodeStore() repository.LoginCodeStore {
+	return &implLoginCodeStore{
+		codes: make(map[string]loginCode),
+		clock: time.Now,
+	}
+}
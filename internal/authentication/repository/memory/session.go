@@ -0,0 +1,97 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"identity-srv/internal/authentication"
+	"identity-srv/internal/authentication/repository"
+)
+
+// CreateSession stores a new session
+func (sm *implSessionManager) CreateSession(ctx context.Context, opts repository.CreateSessionOptions) error {
+	ttl := opts.SessionTTL(sm.ttl, sm.rememberMeTTL)
+	now := sm.clock()
+
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	sm.purgeExpiredLocked()
+	sm.sessions[opts.JTI] = repository.SessionData{
+		UserID:         opts.UserID,
+		JTI:            opts.JTI,
+		CreatedAt:      now,
+		ExpiresAt:      now.Add(ttl),
+		Impersonated:   opts.ImpersonatorID != "",
+		ImpersonatorID: opts.ImpersonatorID,
+	}
+	return nil
+}
+
+// GetSession retrieves an unexpired session by JTI
+func (sm *implSessionManager) GetSession(ctx context.Context, jti string) (*repository.SessionData, error) {
+	sm.mu.RLock()
+	defer sm.mu.RUnlock()
+
+	session, ok := sm.sessions[jti]
+	if !ok || !session.ExpiresAt.After(sm.clock()) {
+		return nil, fmt.Errorf("%w: session not found", authentication.ErrInternalSystem)
+	}
+	return &session, nil
+}
+
+// DeleteSession deletes a session by JTI
+func (sm *implSessionManager) DeleteSession(ctx context.Context, jti string) error {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	delete(sm.sessions, jti)
+	return nil
+}
+
+// GetAllUserSessions retrieves the JTIs of all unexpired sessions for a user
+func (sm *implSessionManager) GetAllUserSessions(ctx context.Context, userID string) ([]string, error) {
+	sm.mu.RLock()
+	defer sm.mu.RUnlock()
+
+	now := sm.clock()
+	jtis := []string{}
+	for jti, session := range sm.sessions {
+		if session.UserID == userID && session.ExpiresAt.After(now) {
+			jtis = append(jtis, jti)
+		}
+	}
+	return jtis, nil
+}
+
+// DeleteUserSessions deletes all sessions for a user
+func (sm *implSessionManager) DeleteUserSessions(ctx context.Context, userID string) error {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	for jti, session := range sm.sessions {
+		if session.UserID == userID {
+			delete(sm.sessions, jti)
+		}
+	}
+	return nil
+}
+
+// SessionExists checks if an unexpired session exists
+func (sm *implSessionManager) SessionExists(ctx context.Context, jti string) (bool, error) {
+	sm.mu.RLock()
+	defer sm.mu.RUnlock()
+
+	session, ok := sm.sessions[jti]
+	return ok && session.ExpiresAt.After(sm.clock()), nil
+}
+
+// purgeExpiredLocked drops expired sessions; the caller must hold the write lock
+func (sm *implSessionManager) purgeExpiredLocked() {
+	now := sm.clock()
+	for jti, session := range sm.sessions {
+		if !session.ExpiresAt.After(now) {
+			delete(sm.sessions, jti)
+		}
+	}
+}
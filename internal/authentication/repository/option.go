@@ -0,0 +1,51 @@
+package repository
+
+import "time"
+
+// Revocation event types published on blacklist.event_channel
+const (
+	RevocationEventToken = "token" // a single JTI was revoked
+	RevocationEventUser  = "user"  // every token of UserID issued before RevokedBefore was revoked
+)
+
+// RevocationEvent is the JSON message published when tokens are revoked.
+// Consumers can cache it in-process until ExpiresAt instead of querying the blacklist per request.
+type RevocationEvent struct {
+	Type          string `json:"type"`
+	JTI           string `json:"jti,omitempty"`
+	UserID        string `json:"user_id,omitempty"`
+	RevokedBefore int64  `json:"revoked_before,omitempty"` // unix seconds, compare with the token iat
+	ExpiresAt     int64  `json:"expires_at"`               // unix seconds, the event is irrelevant after this
+	Timestamp     int64  `json:"ts"`
+}
+
+// CreateSessionOptions describes a new session
+type CreateSessionOptions struct {
+	UserID         string
+	JTI            string
+	RememberMe     bool          // use the remember-me TTL instead of the session TTL
+	TTL            time.Duration // overrides both TTLs when set, e.g. for short-lived impersonation
+	ImpersonatorID string        // admin acting as UserID, empty for normal logins
+}
+
+// SessionData represents session information stored by a SessionManager
+type SessionData struct {
+	UserID         string    `json:"user_id"`
+	JTI            string    `json:"jti"`
+	CreatedAt      time.Time `json:"created_at"`
+	ExpiresAt      time.Time `json:"expires_at"`
+	Impersonated   bool      `json:"impersonated,omitempty"`
+	ImpersonatorID string    `json:"impersonator_id,omitempty"`
+}
+
+// SessionTTL picks the lifetime of a new session from the backend's configured TTLs
+func (o CreateSessionOptions) SessionTTL(ttl, rememberMeTTL time.Duration) time.Duration {
+	switch {
+	case o.TTL > 0:
+		return o.TTL
+	case o.RememberMe:
+		return rememberMeTTL
+	default:
+		return ttl
+	}
+}
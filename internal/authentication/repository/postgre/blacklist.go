@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"identity-srv/internal/authentication"
+	"identity-srv/internal/sqlboiler"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// AddToken adds a token to the blacklist by JTI
+// The row is kept until the token's own expiry
+func (bm *implBlacklistManager) AddToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	// Token already expired, no need to blacklist
+	now := bm.clock()
+	if !expiresAt.After(now) {
+		return nil
+	}
+
+	entry := &sqlboiler.TokenBlacklist{
+		Jti:       jti,
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+	}
+	err := entry.Upsert(ctx, bm.db, true,
+		[]string{sqlboiler.TokenBlacklistColumns.Jti},
+		boil.Whitelist(sqlboiler.TokenBlacklistColumns.ExpiresAt),
+		boil.Infer(),
+	)
+	if err != nil {
+		return fmt.Errorf("%w: failed to add token to blacklist: %v", authentication.ErrInternalSystem, err)
+	}
+
+	bm.purgeExpired(ctx)
+
+	return nil
+}
+
+// IsBlacklisted checks if a token is in the blacklist
+func (bm *implBlacklistManager) IsBlacklisted(ctx context.Context, jti string) (bool, error) {
+	exists, err := sqlboiler.TokenBlacklists(
+		sqlboiler.TokenBlacklistWhere.Jti.EQ(jti),
+		sqlboiler.TokenBlacklistWhere.ExpiresAt.GT(bm.clock()),
+	).Exists(ctx, bm.db)
+	if err != nil {
+		return false, fmt.Errorf("%w: failed to check blacklist: %v", authentication.ErrInternalSystem, err)
+	}
+	return exists, nil
+}
+
+// RemoveToken removes a token from the blacklist
+func (bm *implBlacklistManager) RemoveToken(ctx context.Context, jti string) error {
+	_, err := sqlboiler.TokenBlacklists(
+		sqlboiler.TokenBlacklistWhere.Jti.EQ(jti),
+	).DeleteAll(ctx, bm.db)
+	if err != nil {
+		return fmt.Errorf("%w: failed to remove token from blacklist: %v", authentication.ErrInternalSystem, err)
+	}
+	return nil
+}
+
+// SetRevokedBefore stores the user's revocation watermark on the users row
+func (bm *implBlacklistManager) SetRevokedBefore(ctx context.Context, userID string, before, expiresAt time.Time) error {
+	_, err := sqlboiler.Users(
+		sqlboiler.UserWhere.ID.EQ(userID),
+	).UpdateAll(ctx, bm.db, sqlboiler.M{
+		sqlboiler.UserColumns.TokensRevokedBefore: null.TimeFrom(before),
+	})
+	if err != nil {
+		return fmt.Errorf("%w: failed to store revoked_before: %v", authentication.ErrInternalSystem, err)
+	}
+	return nil
+}
+
+// GetRevokedBefore returns the user's revocation watermark, zero if none
+func (bm *implBlacklistManager) GetRevokedBefore(ctx context.Context, userID string) (time.Time, error) {
+	user, err := sqlboiler.Users(
+		qm.Select(sqlboiler.UserColumns.TokensRevokedBefore),
+		sqlboiler.UserWhere.ID.EQ(userID),
+	).One(ctx, bm.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return time.Time{}, nil
+		}
+		return time.Time{}, fmt.Errorf("%w: failed to get revoked_before: %v", authentication.ErrInternalSystem, err)
+	}
+
+	if !user.TokensRevokedBefore.Valid {
+		return time.Time{}, nil
+	}
+	return user.TokensRevokedBefore.Time, nil
+}
+
+func (bm *implBlacklistManager) purgeExpired(ctx context.Context) {
+	_, err := sqlboiler.TokenBlacklists(
+		sqlboiler.TokenBlacklistWhere.ExpiresAt.LTE(bm.clock()),
+	).DeleteAll(ctx, bm.db)
+	if err != nil {
+		bm.l.Warnf(ctx, "authentication.repository.postgres.purgeExpired: %v", err)
+	}
+}
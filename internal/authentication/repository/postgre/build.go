@@ -0,0 +1,33 @@
+package postgres
+
+import (
+	"identity-srv/internal/authentication/repository"
+	"identity-srv/internal/sqlboiler"
+
+	"github.com/aarondl/null/v8"
+)
+
+func (sm *implSessionManager) buildSession(opts repository.CreateSessionOptions) *sqlboiler.Session {
+	now := sm.clock()
+	session := &sqlboiler.Session{
+		Jti:       opts.JTI,
+		UserID:    opts.UserID,
+		CreatedAt: now,
+		ExpiresAt: now.Add(opts.SessionTTL(sm.ttl, sm.rememberMeTTL)),
+	}
+	if opts.ImpersonatorID != "" {
+		session.ImpersonatorID = null.StringFrom(opts.ImpersonatorID)
+	}
+	return session
+}
+
+func toSessionData(session *sqlboiler.Session) *repository.SessionData {
+	return &repository.SessionData{
+		UserID:         session.UserID,
+		JTI:            session.Jti,
+		CreatedAt:      session.CreatedAt,
+		ExpiresAt:      session.ExpiresAt,
+		Impersonated:   session.ImpersonatorID.Valid,
+		ImpersonatorID: session.ImpersonatorID.String,
+	}
+}
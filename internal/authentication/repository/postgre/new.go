@@ -0,0 +1,47 @@
+package postgres
+
+import (
+	"database/sql"
+	"time"
+
+	"identity-srv/internal/authentication/repository"
+
+	"github.com/smap-hcmut/shared-libs/go/log"
+)
+
+type implSessionManager struct {
+	l             log.Logger
+	db            *sql.DB
+	ttl           time.Duration
+	rememberMeTTL time.Duration
+	clock         func() time.Time
+}
+
+type implBlacklistManager struct {
+	l     log.Logger
+	db    *sql.DB
+	clock func() time.Time
+}
+
+var _ repository.SessionManager = &implSessionManager{}
+var _ repository.BlacklistManager = &implBlacklistManager{}
+
+// NewSessionManager creates a Postgres-backed session manager
+func NewSessionManager(l log.Logger, db *sql.DB, ttl, rememberMeTTL time.Duration) repository.SessionManager {
+	return &implSessionManager{
+		l:             l,
+		db:            db,
+		ttl:           ttl,
+		rememberMeTTL: rememberMeTTL,
+		clock:         time.Now,
+	}
+}
+
+// NewBlacklistManager creates a Postgres-backed blacklist manager
+func NewBlacklistManager(l log.Logger, db *sql.DB) repository.BlacklistManager {
+	return &implBlacklistManager{
+		l:     l,
+		db:    db,
+		clock: time.Now,
+	}
+}
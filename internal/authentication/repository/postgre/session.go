@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"identity-srv/internal/authentication"
+	"identity-srv/internal/authentication/repository"
+	"identity-srv/internal/sqlboiler"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+)
+
+// CreateSession inserts a new session row
+func (sm *implSessionManager) CreateSession(ctx context.Context, opts repository.CreateSessionOptions) error {
+	session := sm.buildSession(opts)
+	if err := session.Insert(ctx, sm.db, boil.Infer()); err != nil {
+		return fmt.Errorf("%w: failed to store session: %v", authentication.ErrInternalSystem, err)
+	}
+
+	// Expired rows are ignored on read; purge this user's leftovers opportunistically
+	sm.purgeExpired(ctx, opts.UserID)
+
+	return nil
+}
+
+// GetSession retrieves an unexpired session by JTI
+func (sm *implSessionManager) GetSession(ctx context.Context, jti string) (*repository.SessionData, error) {
+	session, err := sqlboiler.Sessions(
+		sqlboiler.SessionWhere.Jti.EQ(jti),
+		sqlboiler.SessionWhere.ExpiresAt.GT(sm.clock()),
+	).One(ctx, sm.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: session not found", authentication.ErrInternalSystem)
+		}
+		return nil, fmt.Errorf("%w: failed to get session: %v", authentication.ErrInternalSystem, err)
+	}
+
+	return toSessionData(session), nil
+}
+
+// DeleteSession deletes a session by JTI
+func (sm *implSessionManager) DeleteSession(ctx context.Context, jti string) error {
+	_, err := sqlboiler.Sessions(
+		sqlboiler.SessionWhere.Jti.EQ(jti),
+	).DeleteAll(ctx, sm.db)
+	if err != nil {
+		return fmt.Errorf("%w: failed to delete session: %v", authentication.ErrInternalSystem, err)
+	}
+	return nil
+}
+
+// GetAllUserSessions retrieves the JTIs of all unexpired sessions for a user
+func (sm *implSessionManager) GetAllUserSessions(ctx context.Context, userID string) ([]string, error) {
+	sessions, err := sqlboiler.Sessions(
+		sqlboiler.SessionWhere.UserID.EQ(userID),
+		sqlboiler.SessionWhere.ExpiresAt.GT(sm.clock()),
+	).All(ctx, sm.db)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to get user sessions: %v", authentication.ErrInternalSystem, err)
+	}
+
+	jtis := make([]string, 0, len(sessions))
+	for _, session := range sessions {
+		jtis = append(jtis, session.Jti)
+	}
+	return jtis, nil
+}
+
+// DeleteUserSessions deletes all sessions for a user
+func (sm *implSessionManager) DeleteUserSessions(ctx context.Context, userID string) error {
+	_, err := sqlboiler.Sessions(
+		sqlboiler.SessionWhere.UserID.EQ(userID),
+	).DeleteAll(ctx, sm.db)
+	if err != nil {
+		return fmt.Errorf("%w: failed to delete user sessions: %v", authentication.ErrInternalSystem, err)
+	}
+	return nil
+}
+
+// SessionExists checks if an unexpired session exists
+func (sm *implSessionManager) SessionExists(ctx context.Context, jti string) (bool, error) {
+	return sqlboiler.Sessions(
+		sqlboiler.SessionWhere.Jti.EQ(jti),
+		sqlboiler.SessionWhere.ExpiresAt.GT(sm.clock()),
+	).Exists(ctx, sm.db)
+}
+
+func (sm *implSessionManager) purgeExpired(ctx context.Context, userID string) {
+	_, err := sqlboiler.Sessions(
+		sqlboiler.SessionWhere.UserID.EQ(userID),
+		sqlboiler.SessionWhere.ExpiresAt.LTE(sm.clock()),
+	).DeleteAll(ctx, sm.db)
+	if err != nil {
+		sm.l.Warnf(ctx, "authentication.repository.postgres.purgeExpired: %v", err)
+	}
+}
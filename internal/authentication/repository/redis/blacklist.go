@@ -0,0 +1,91 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"identity-srv/internal/authentication"
+	"strconv"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// AddToken adds a token to the blacklist by JTI
+// TTL is set to the remaining token lifetime to automatically expire
+func (bm *implBlacklistManager) AddToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	// Calculate TTL as remaining token lifetime
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		// Token already expired, no need to blacklist
+		return nil
+	}
+
+	// Store in Redis with key: {prefix}{jti}
+	key := bm.key(jti)
+	if err := bm.redis.Set(ctx, key, "1", ttl); err != nil {
+		return fmt.Errorf("%w: failed to add token to blacklist: %v", authentication.ErrInternalSystem, err)
+	}
+
+	return nil
+}
+
+// IsBlacklisted checks if a token is blacklisted by JTI
+func (bm *implBlacklistManager) IsBlacklisted(ctx context.Context, jti string) (bool, error) {
+	key := bm.key(jti)
+	exists, err := bm.redis.Exists(ctx, key)
+	if err != nil {
+		return false, fmt.Errorf("%w: failed to check blacklist: %v", authentication.ErrInternalSystem, err)
+	}
+	return exists, nil
+}
+
+// RemoveToken removes a token from the blacklist (rarely used)
+func (bm *implBlacklistManager) RemoveToken(ctx context.Context, jti string) error {
+	key := bm.key(jti)
+	if err := bm.redis.Delete(ctx, key); err != nil {
+		return fmt.Errorf("%w: failed to remove token from blacklist: %v", authentication.ErrInternalSystem, err)
+	}
+	return nil
+}
+
+// SetRevokedBefore stores the user's revocation watermark as unix seconds
+// TTL is set so the key disappears once every older token has expired
+func (bm *implBlacklistManager) SetRevokedBefore(ctx context.Context, userID string, before, expiresAt time.Time) error {
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		return nil
+	}
+
+	key := bm.revokedBeforeKey(userID)
+	if err := bm.redis.Set(ctx, key, strconv.FormatInt(before.Unix(), 10), ttl); err != nil {
+		return fmt.Errorf("%w: failed to store revoked_before: %v", authentication.ErrInternalSystem, err)
+	}
+	return nil
+}
+
+// GetRevokedBefore returns the user's revocation watermark, zero if none
+func (bm *implBlacklistManager) GetRevokedBefore(ctx context.Context, userID string) (time.Time, error) {
+	// The raw client keeps goredis.Nil, which tells a missing key from a failure
+	data, err := bm.redis.GetClient().Get(ctx, bm.revokedBeforeKey(userID)).Result()
+	if err != nil {
+		if errors.Is(err, goredis.Nil) {
+			return time.Time{}, nil
+		}
+		return time.Time{}, fmt.Errorf("%w: failed to get revoked_before: %v", authentication.ErrInternalSystem, err)
+	}
+
+	unix, err := strconv.ParseInt(data, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: invalid revoked_before value: %v", authentication.ErrInternalSystem, err)
+	}
+	return time.Unix(unix, 0), nil
+}
+
+func (bm *implBlacklistManager) key(jti string) string {
+	return bm.keyPrefix + jti
+}
+
+func (bm *implBlacklistManager) revokedBeforeKey(userID string) string {
+	return bm.keyPrefix + "revoked_before:" + userID
+}
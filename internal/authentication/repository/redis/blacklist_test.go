@@ -0,0 +1,141 @@
+package redis
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+	pkgRedis "github.com/smap-hcmut/shared-libs/go/redis"
+)
+
+// fakeServer answers GET and SET over RESP2, enough for the blacklist
+type fakeServer struct {
+	mu     sync.Mutex
+	values map[string]string
+}
+
+func newFakeRedis(t *testing.T) (*fakeServer, *goredis.Client) {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen: %v", err)
+	}
+	srv := &fakeServer{values: map[string]string{}}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go srv.serve(conn)
+		}
+	}()
+
+	client := goredis.NewClient(&goredis.Options{
+		Addr:            ln.Addr().String(),
+		Protocol:        2,
+		DisableIdentity: true,
+	})
+	t.Cleanup(func() {
+		client.Close()
+		ln.Close()
+	})
+	return srv, client
+}
+
+func (s *fakeServer) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	for {
+		args, err := readCommand(r)
+		if err != nil {
+			return
+		}
+		s.mu.Lock()
+		switch strings.ToUpper(args[0]) {
+		case "GET":
+			if v, ok := s.values[args[1]]; ok {
+				fmt.Fprintf(conn, "$%d\r\n%s\r\n", len(v), v)
+			} else {
+				io.WriteString(conn, "$-1\r\n")
+			}
+		case "SET":
+			s.values[args[1]] = args[2]
+			io.WriteString(conn, "+OK\r\n")
+		default:
+			fmt.Fprintf(conn, "-ERR unknown command '%s'\r\n", args[0])
+		}
+		s.mu.Unlock()
+	}
+}
+
+func (s *fakeServer) set(key, value string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.values[key] = value
+}
+
+func readCommand(r *bufio.Reader) ([]string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(line[1:]))
+	if err != nil || line[0] != '*' {
+		return nil, fmt.Errorf("unexpected %q", line)
+	}
+	args := make([]string, n)
+	for i := range args {
+		if _, err := r.ReadString('\n'); err != nil {
+			return nil, err
+		}
+		arg, err := r.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		args[i] = strings.TrimSuffix(arg, "\r\n")
+	}
+	return args, nil
+}
+
+// rawClient exposes only GetClient; the wrapper methods are not relied on
+type rawClient struct {
+	pkgRedis.IRedis
+	client *goredis.Client
+}
+
+func (c rawClient) GetClient() *goredis.Client { return c.client }
+
+func TestGetRevokedBefore(t *testing.T) {
+	ctx := context.Background()
+	srv, client := newFakeRedis(t)
+	bm := NewBlacklistManager(rawClient{client: client}, "blacklist:")
+
+	before, err := bm.GetRevokedBefore(ctx, "user-1")
+	if err != nil {
+		t.Fatalf("GetRevokedBefore without a watermark: %v", err)
+	}
+	if !before.IsZero() {
+		t.Fatalf("GetRevokedBefore without a watermark = %s, want zero", before)
+	}
+
+	want := time.Unix(1767225600, 0)
+	srv.set("blacklist:revoked_before:user-1", strconv.FormatInt(want.Unix(), 10))
+	before, err = bm.GetRevokedBefore(ctx, "user-1")
+	if err != nil || !before.Equal(want) {
+		t.Fatalf("GetRevokedBefore = %s, %v, want %s", before, err, want)
+	}
+
+	srv.set("blacklist:revoked_before:user-2", "garbage")
+	if _, err := bm.GetRevokedBefore(ctx, "user-2"); err == nil {
+		t.Fatalf("GetRevokedBefore accepted a malformed watermark")
+	}
+}
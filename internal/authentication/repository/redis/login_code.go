@@ -0,0 +1,36 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"identity-srv/internal/authentication"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// SaveLoginCode stores the token under the code until ttl
+func (s *implLoginCodeStore) SaveLoginCode(ctx context.Context, code, token string, ttl time.Duration) error {
+	if err := s.redis.Set(ctx, s.key(code), token, ttl); err != nil {
+		return fmt.Errorf("%w: failed to save login code: %v", authentication.ErrInternalSystem, err)
+	}
+	return nil
+}
+
+// TakeLoginCode reads and deletes the code in one GETDEL, so two concurrent
+// redemptions cannot both get the token
+func (s *implLoginCodeStore) TakeLoginCode(ctx context.Context, code string) (string, error) {
+	token, err := s.redis.GetClient().GetDel(ctx, s.key(code)).Result()
+	if errors.Is(err, goredis.Nil) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("%w: failed to take login code: %v", authentication.ErrInternalSystem, err)
+	}
+	return token, nil
+}
+
+func (s *implLoginCodeStore) key(code string) string {
+	return s.keyPrefix + code
+}
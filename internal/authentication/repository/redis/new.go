@@ -0,0 +1,62 @@
+package redis
+
+import (
+	"time"
+
+	"identity-srv/internal/authentication/repository"
+
+	"github.com/smap-hcmut/shared-libs/go/log"
+	pkgRedis "github.com/smap-hcmut/shared-libs/go/redis"
+)
+
+type implSessionManager struct {
+	l             log.Logger
+	redis         pkgRedis.IRedis
+	keyPrefix     string
+	ttl           time.Duration
+	rememberMeTTL time.Duration
+}
+
+type implBlacklistManager struct {
+	redis     pkgRedis.IRedis
+	keyPrefix string
+}
+
+type implLoginCodeStore struct {
+	redis     pkgRedis.IRedis
+	keyPrefix string
+}
+
+var _ repository.SessionManager = &implSessionManager{}
+var _ repository.BlacklistManager = &implBlacklistManager{}
+var _ repository.LoginCodeStore = &implLoginCodeStore{}
+
+// NewSessionManager creates a Redis-backed session manager.
+// keyPrefix namespaces the session:* and user_sessions:* keys.
+func NewSessionManager(l log.Logger, redisClient pkgRedis.IRedis, keyPrefix string, ttl, rememberMeTTL time.Duration) repository.SessionManager {
+	return &implSessionManager{
+		l:             l,
+		redis:         redisClient,
+		keyPrefix:     keyPrefix,
+		ttl:           ttl,
+		rememberMeTTL: rememberMeTTL,
+	}
+}
+
+// NewBlacklistManager creates a Redis-backed blacklist manager.
+// Entries are stored as {keyPrefix}{jti}.
+func NewBlacklistManager(redisClient pkgRedis.IRedis, keyPrefix string) repository.BlacklistManager {
+	return &implBlacklistManager{
+		redis:     redisClient,
+		keyPrefix: keyPrefix,
+	}
+}
+
+// NewLoginCodeStore creates a Redis-backed login code store.
+// Codes are stored as {keyPrefix}{code}.
+func NewLoginCodeStore(redisClient pkgRedis.IRedis, keyPrefix string) repository.LoginCodeStore {
+	return &implLoginCodeStore{
+		redis:     redisClient,
+		keyPrefix: keyPrefix,
+	}
+}
@@ -0,0 +1,81 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"identity-srv/internal/authentication/repository"
+
+	"github.com/smap-hcmut/shared-libs/go/log"
+	pkgRedis "github.com/smap-hcmut/shared-libs/go/redis"
+)
+
+// implPublishingBlacklistManager wraps any blacklist backend and announces
+// every revocation on a Redis pub/sub channel, so downstream services can keep
+// an in-process revocation cache instead of hitting Redis per request.
+type implPublishingBlacklistManager struct {
+	repository.BlacklistManager
+	l       log.Logger
+	redis   pkgRedis.IRedis
+	channel string
+	clock   func() time.Time
+}
+
+// NewPublishingBlacklistManager decorates next so that AddToken and
+// SetRevokedBefore also publish a RevocationEvent on channel.
+func NewPublishingBlacklistManager(l log.Logger, next repository.BlacklistManager, redisClient pkgRedis.IRedis, channel string) repository.BlacklistManager {
+	return &implPublishingBlacklistManager{
+		BlacklistManager: next,
+		l:                l,
+		redis:            redisClient,
+		channel:          channel,
+		clock:            time.Now,
+	}
+}
+
+// AddToken blacklists the token and publishes a token event
+func (pm *implPublishingBlacklistManager) AddToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	if err := pm.BlacklistManager.AddToken(ctx, jti, expiresAt); err != nil {
+		return err
+	}
+
+	pm.publish(ctx, repository.RevocationEvent{
+		Type:      repository.RevocationEventToken,
+		JTI:       jti,
+		ExpiresAt: expiresAt.Unix(),
+	})
+	return nil
+}
+
+// SetRevokedBefore stores the watermark and publishes a user-wide
+// "revoke everything issued before T" event
+func (pm *implPublishingBlacklistManager) SetRevokedBefore(ctx context.Context, userID string, before, expiresAt time.Time) error {
+	if err := pm.BlacklistManager.SetRevokedBefore(ctx, userID, before, expiresAt); err != nil {
+		return err
+	}
+
+	pm.publish(ctx, repository.RevocationEvent{
+		Type:          repository.RevocationEventUser,
+		UserID:        userID,
+		RevokedBefore: before.Unix(),
+		ExpiresAt:     expiresAt.Unix(),
+	})
+	return nil
+}
+
+// publish is best effort: the blacklist entry is already stored, so consumers
+// that miss the event still reject the token through the blacklist lookup.
+func (pm *implPublishingBlacklistManager) publish(ctx context.Context, event repository.RevocationEvent) {
+	event.Timestamp = pm.clock().Unix()
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		pm.l.Errorf(ctx, "authentication.repository.redis.publish.Marshal: %v", err)
+		return
+	}
+
+	if err := pm.redis.GetClient().Publish(ctx, pm.channel, data).Err(); err != nil {
+		pm.l.Warnf(ctx, "authentication.repository.redis.publish.Publish: channel=%s: %v", pm.channel, err)
+	}
+}
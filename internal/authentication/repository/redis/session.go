@@ -1,27 +1,28 @@
-package usecase
+package redis
 
 import (
 	"context"
 	"encoding/json"
 	"fmt"
 	"identity-srv/internal/authentication"
+	"identity-srv/internal/authentication/repository"
 	"time"
 )
 
 // CreateSession creates a new session in Redis
-func (sm *SessionManager) CreateSession(ctx context.Context, userID, jti string, rememberMe bool) error {
+func (sm *implSessionManager) CreateSession(ctx context.Context, opts repository.CreateSessionOptions) error {
 	// Calculate TTL based on remember me flag
-	ttl := sm.ttl
-	if rememberMe {
-		ttl = 7 * 24 * time.Hour // 7 days
-	}
+	ttl := opts.SessionTTL(sm.ttl, sm.rememberMeTTL)
+	userID, jti := opts.UserID, opts.JTI
 
 	now := time.Now()
-	sessionData := SessionData{
-		UserID:    userID,
-		JTI:       jti,
-		CreatedAt: now,
-		ExpiresAt: now.Add(ttl),
+	sessionData := repository.SessionData{
+		UserID:         userID,
+		JTI:            jti,
+		CreatedAt:      now,
+		ExpiresAt:      now.Add(ttl),
+		Impersonated:   opts.ImpersonatorID != "",
+		ImpersonatorID: opts.ImpersonatorID,
 	}
 
 	// Serialize session data
@@ -30,15 +31,15 @@ func (sm *SessionManager) CreateSession(ctx context.Context, userID, jti string,
 		return fmt.Errorf("%w: failed to marshal session data: %v", authentication.ErrInternalSystem, err)
 	}
 
-	// Store in Redis with key: session:{jti}
-	key := fmt.Sprintf("session:%s", jti)
+	// Store in Redis with key: {prefix}session:{jti}
+	key := sm.sessionKey(jti)
 	if err := sm.redis.Set(ctx, key, data, ttl); err != nil {
 		return fmt.Errorf("%w: failed to store session: %v", authentication.ErrInternalSystem, err)
 	}
 
 	// Also store user-to-session mapping for logout all functionality
-	// Key: user_sessions:{userID}, Value: JSON array of JTIs
-	userSessionsKey := fmt.Sprintf("user_sessions:%s", userID)
+	// Key: {prefix}user_sessions:{userID}, Value: JSON array of JTIs
+	userSessionsKey := sm.userSessionsKey(userID)
 
 	// Get existing JTIs
 	existingJTIs := []string{}
@@ -49,7 +50,7 @@ func (sm *SessionManager) CreateSession(ctx context.Context, userID, jti string,
 			// Filter out expired/invalid JTIs by checking if session still exists
 			validJTIs := []string{}
 			for _, existingJTI := range existingJTIs {
-				sessionKey := fmt.Sprintf("session:%s", existingJTI)
+				sessionKey := sm.sessionKey(existingJTI)
 				exists, _ := sm.redis.Exists(ctx, sessionKey)
 				if exists {
 					validJTIs = append(validJTIs, existingJTI)
@@ -68,8 +69,8 @@ func (sm *SessionManager) CreateSession(ctx context.Context, userID, jti string,
 		return fmt.Errorf("%w: failed to marshal JTIs: %v", authentication.ErrInternalSystem, err)
 	}
 
-	// Use longest TTL for the mapping (7 days for remember me)
-	mappingTTL := 7 * 24 * time.Hour
+	// Use longest TTL for the mapping (remember me)
+	mappingTTL := sm.rememberMeTTL
 	if err := sm.redis.Set(ctx, userSessionsKey, jtisData, mappingTTL); err != nil {
 		return fmt.Errorf("%w: failed to store user session mapping: %v", authentication.ErrInternalSystem, err)
 	}
@@ -78,14 +79,14 @@ func (sm *SessionManager) CreateSession(ctx context.Context, userID, jti string,
 }
 
 // GetSession retrieves session data by JTI
-func (sm *SessionManager) GetSession(ctx context.Context, jti string) (*SessionData, error) {
-	key := fmt.Sprintf("session:%s", jti)
+func (sm *implSessionManager) GetSession(ctx context.Context, jti string) (*repository.SessionData, error) {
+	key := sm.sessionKey(jti)
 	data, err := sm.redis.Get(ctx, key)
 	if err != nil {
 		return nil, fmt.Errorf("%w: session not found: %v", authentication.ErrInternalSystem, err)
 	}
 
-	var sessionData SessionData
+	var sessionData repository.SessionData
 	if err := json.Unmarshal([]byte(data), &sessionData); err != nil {
 		return nil, fmt.Errorf("%w: failed to unmarshal session data: %v", authentication.ErrInternalSystem, err)
 	}
@@ -94,8 +95,8 @@ func (sm *SessionManager) GetSession(ctx context.Context, jti string) (*SessionD
 }
 
 // DeleteSession deletes a session by JTI
-func (sm *SessionManager) DeleteSession(ctx context.Context, jti string) error {
-	key := fmt.Sprintf("session:%s", jti)
+func (sm *implSessionManager) DeleteSession(ctx context.Context, jti string) error {
+	key := sm.sessionKey(jti)
 	if err := sm.redis.Delete(ctx, key); err != nil {
 		return fmt.Errorf("%w: failed to delete session: %v", authentication.ErrInternalSystem, err)
 	}
@@ -103,8 +104,8 @@ func (sm *SessionManager) DeleteSession(ctx context.Context, jti string) error {
 }
 
 // GetAllUserSessions retrieves all JTIs for a user
-func (sm *SessionManager) GetAllUserSessions(ctx context.Context, userID string) ([]string, error) {
-	userSessionsKey := fmt.Sprintf("user_sessions:%s", userID)
+func (sm *implSessionManager) GetAllUserSessions(ctx context.Context, userID string) ([]string, error) {
+	userSessionsKey := sm.userSessionsKey(userID)
 	data, err := sm.redis.Get(ctx, userSessionsKey)
 	if err != nil {
 		// No sessions found, not an error
@@ -120,7 +121,7 @@ func (sm *SessionManager) GetAllUserSessions(ctx context.Context, userID string)
 }
 
 // DeleteUserSessions deletes all sessions for a user
-func (sm *SessionManager) DeleteUserSessions(ctx context.Context, userID string) error {
+func (sm *implSessionManager) DeleteUserSessions(ctx context.Context, userID string) error {
 	// Get all JTIs for the user
 	jtis, err := sm.GetAllUserSessions(ctx, userID)
 	if err != nil {
@@ -129,16 +130,16 @@ func (sm *SessionManager) DeleteUserSessions(ctx context.Context, userID string)
 
 	// Delete each session
 	for _, jti := range jtis {
-		sessionKey := fmt.Sprintf("session:%s", jti)
+		sessionKey := sm.sessionKey(jti)
 		if err := sm.redis.Delete(ctx, sessionKey); err != nil {
 			// Log error but continue deleting other sessions
-			sm.l.Errorf(ctx, "authentication.usecase.DeleteUserSessions: failed to delete session jti=%s: %v", jti, err)
+			sm.l.Errorf(ctx, "authentication.repository.redis.DeleteUserSessions: failed to delete session jti=%s: %v", jti, err)
 			continue
 		}
 	}
 
 	// Delete user sessions mapping
-	userSessionsKey := fmt.Sprintf("user_sessions:%s", userID)
+	userSessionsKey := sm.userSessionsKey(userID)
 	if err := sm.redis.Delete(ctx, userSessionsKey); err != nil {
 		return fmt.Errorf("%w: failed to delete user sessions mapping: %v", authentication.ErrInternalSystem, err)
 	}
@@ -147,7 +148,15 @@ func (sm *SessionManager) DeleteUserSessions(ctx context.Context, userID string)
 }
 
 // SessionExists checks if a session exists
-func (sm *SessionManager) SessionExists(ctx context.Context, jti string) (bool, error) {
-	key := fmt.Sprintf("session:%s", jti)
+func (sm *implSessionManager) SessionExists(ctx context.Context, jti string) (bool, error) {
+	key := sm.sessionKey(jti)
 	return sm.redis.Exists(ctx, key)
 }
+
+func (sm *implSessionManager) sessionKey(jti string) string {
+	return sm.keyPrefix + "session:" + jti
+}
+
+func (sm *implSessionManager) userSessionsKey(userID string) string {
+	return sm.keyPrefix + "user_sessions:" + userID
+}
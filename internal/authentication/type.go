@@ -1,18 +1,81 @@
 package authentication
 
 import (
+	"encoding/json"
 	"identity-srv/internal/model"
 	"time"
 )
 
+// Token types reported by ValidateToken
+const (
+	TokenTypeAccess              = "access"                // JWT issued at login
+	TokenTypePersonalAccessToken = "personal_access_token" // smap_pat_* token
+)
+
+// Authentication methods recorded in the "amr" claim of login tokens
+const (
+	AMROAuth    = "oauth"    // the OAuth/OIDC provider
+	AMRTOTP     = "totp"     // TOTP or recovery code
+	AMRWebAuthn = "webauthn" // passkey
+	AMREmail    = "email"    // magic link sent by email
+)
+
+// Authentication context classes recorded in the "acr" claim, after the
+// authenticator assurance levels of NIST SP 800-63B
+const (
+	ACRSingleFactor = "aal1"
+	ACRMultiFactor  = "aal2" // two factors, or a passkey with user verification
+)
+
+// Actor is the party acting on behalf of the token's user (RFC 8693 "act" claim):
+// an impersonating admin, or a service that exchanged the user's token.
+// Act nests the previous actor when a token is exchanged more than once.
+type Actor struct {
+	UserID   string `json:"sub"`                 // admin user ID, or the client ID for a service
+	Email    string `json:"email,omitempty"`     // set for admins
+	ClientID string `json:"client_id,omitempty"` // set for services
+	Act      *Actor `json:"act,omitempty"`
+}
+
 // TokenValidationResult contains the result of token validation
 type TokenValidationResult struct {
 	Valid     bool
+	TokenType string
 	UserID    string
 	Email     string
 	Role      string
 	Groups    []string
+	Scopes    []string // only set for personal access tokens
+	Actor     *Actor   // set when an admin is impersonating the user or a service exchanged the token
+	Audience  string   // set for tokens issued by token exchange
 	ExpiresAt time.Time
+	AuthTime  time.Time // last active login of the user, zero for impersonation and personal access tokens
+	AMR       []string  // methods of that login
+	ACR       string
+	// ReauthRequired is set when MaxAge was given and the login is older or unknown
+	ReauthRequired bool
+}
+
+// ValidateTokenInput contains a token and the audience the caller requires of it
+type ValidateTokenInput struct {
+	Token    string
+	Audience string         // when set, tokens scoped to another audience are invalid
+	MaxAge   *time.Duration // when set, report whether the login is too old for a sensitive action
+}
+
+// AuthorizeSelfServiceInput contains the token of a request that manages the
+// user's own credentials
+type AuthorizeSelfServiceInput struct {
+	Token string
+	// RecentLogin requires a login younger than passkey.registration_max_age,
+	// with MFA when the user has a second factor (passkey registration)
+	RecentLogin bool
+}
+
+// AuthorizeAdminInput contains the token of a request to identity's own admin API
+type AuthorizeAdminInput struct {
+	Token    string
+	Audience string // identity's own audience (service_account.audience)
 }
 
 // GetCurrentUser
@@ -36,21 +99,123 @@ type UpdateUserRoleInput struct {
 
 // OAuthCallbackInput contains the data extracted from the HTTP request by the handler
 type OAuthCallbackInput struct {
-	Code       string // Authorization code from OAuth provider
-	RememberMe bool   // Whether to create a long-lived session
-	IPAddress  string // Client IP address (for session metadata / security logging)
-	UserAgent  string // Client user agent (for session metadata / security logging)
+	Code string // Authorization code from OAuth provider
+	// Login context sealed in the signed state at /login, since the provider
+	// forwards nothing but code and state
+	RememberMe  bool   // Whether to create a long-lived session
+	RedirectURL string // Post-login redirect, carried through an MFA challenge
+	Provider    string // provider the login was started with
+	ClientID    string // application that started the login
+	Locale      string // UI locale of the login
+	LoginHint   string // account the client suggested
+	// AuthNotBefore is set when the login asked for max_age or prompt=login:
+	// the provider must report an authentication at or after it
+	AuthNotBefore time.Time
+	IPAddress     string // Client IP address (for session metadata / security logging)
+	UserAgent     string // Client user agent (for session metadata / security logging)
 }
 
-// OAuthCallbackOutput contains the result of the OAuth callback processing
+// Second factors an MFA challenge can be completed with
+const (
+	MFAMethodTOTP    = "totp"
+	MFAMethodPasskey = "passkey"
+)
+
+// OAuthCallbackOutput contains the result of the OAuth callback processing.
+// Exactly one of Token and MFAChallenge is set.
 type OAuthCallbackOutput struct {
-	Token string // JWT token to set as cookie
+	Token                 string   // JWT token to set as cookie
+	MFAChallenge          string   // signed challenge to complete with a second factor
+	MFAMethods            []string // factors the user has, empty when enrolment is required
+	MFAEnrollmentRequired bool     // the user must enrol a TOTP factor to complete the challenge
+	RedirectURL           string   // post-login redirect, or the application's landing page
+	CookieDomain          string   // cookie domain of the application, empty for cookie.domain
+}
+
+// MFAChallengeEnrollOutput contains the TOTP secret for a user enrolling during login
+type MFAChallengeEnrollOutput struct {
+	Secret     string
+	OTPAuthURI string
+}
+
+// VerifyMFAChallengeInput contains the code completing an MFA challenge
+type VerifyMFAChallengeInput struct {
+	Challenge string
+	Code      string // TOTP code, or a recovery code once enrolled
+	IPAddress string
+	UserAgent string
+}
+
+// VerifyMFAChallengeOutput contains the login token issued after the second factor
+type VerifyMFAChallengeOutput struct {
+	Token         string
+	RedirectURL   string   // from the original login request
+	CookieDomain  string   // cookie domain of the application, empty for cookie.domain
+	RecoveryCodes []string // set when the challenge completed an enrolment, shown once
+}
+
+// PasskeyCeremonyOutput starts a WebAuthn assertion.
+// Options go to navigator.credentials.get(); Ceremony is sent back with the result.
+type PasskeyCeremonyOutput struct {
+	Ceremony string
+	Options  json.RawMessage
+}
+
+// VerifyMFAPasskeyInput contains the passkey assertion completing an MFA challenge
+type VerifyMFAPasskeyInput struct {
+	Challenge  string
+	Ceremony   string
+	Credential json.RawMessage
+	IPAddress  string
+	UserAgent  string
+}
+
+// PasskeyLoginInput contains the assertion of a passwordless login
+type PasskeyLoginInput struct {
+	Ceremony    string
+	Credential  json.RawMessage
+	RememberMe  bool
+	RedirectURL string // validated against the allowlist of the application
+	ClientID    string // registered application the login is for, empty for none
+	IPAddress   string
+	UserAgent   string
+}
+
+// PasskeyLoginOutput contains the login token issued for a passkey
+type PasskeyLoginOutput struct {
+	Token        string
+	RedirectURL  string
+	CookieDomain string // the application's cookie domain, empty for the global one
+}
+
+// MagicLinkRequestInput contains the address to email a login link to
+type MagicLinkRequestInput struct {
+	Email       string
+	RedirectURL string // validated against the allowlist of the application
+	RememberMe  bool
+	ClientID    string // registered application the login is for, empty for none
+	IPAddress   string
+	UserAgent   string
+}
+
+// MagicLinkLoginInput contains the token of an emailed login link
+type MagicLinkLoginInput struct {
+	Token     string
+	IPAddress string
+	UserAgent string
 }
 
 // OAuthLoginInput contains the data for initiating OAuth login
 type OAuthLoginInput struct {
 	RedirectURL string // URL to redirect to after login
 	State       string // HMAC-signed CSRF state (generated by HTTP handler, not the usecase)
+	Provider    string // provider the client asked for, empty for the configured one
+	ClientID    string // registered application starting the login, empty for the global settings
+	LoginHint   string // account to pre-select on the provider's login screen
+	Locale      string // UI locale of the provider's login screen
+	// MaxAge forces re-authentication at the provider when its last one is
+	// older; 0 always forces it (prompt=login). Nil leaves the provider session alone.
+	MaxAge *time.Duration
 }
 
 // OAuthLoginOutput contains the result of initiating OAuth login
@@ -58,3 +223,54 @@ type OAuthLoginOutput struct {
 	AuthURL string // URL to redirect user to OAuth provider
 	State   string // CSRF state token to store in cookie
 }
+
+// EndSessionInput contains the data for an RP-initiated logout
+type EndSessionInput struct {
+	Token                 string // Token to revoke: id_token_hint, or on POST the session token; may be empty or expired
+	SessionToken          string // Current token from cookie or header, may be empty or expired
+	PostLogoutRedirectURI string // Where to land after logout, validated against the allowlist
+	State                 string // Opaque value echoed back on the final redirect
+	IdPLogout             bool   // Also end the session at the identity provider
+}
+
+// EndSessionOutput contains the result of an RP-initiated logout
+type EndSessionOutput struct {
+	RedirectURL string // IdP logout URL or the validated post-logout redirect
+	LoggedOut   bool   // the token was revoked and the session belongs to its user: expire the cookie
+}
+
+// ImpersonateInput contains the data for an admin impersonating a user
+type ImpersonateInput struct {
+	TargetUserID string
+	Reason       string // free text kept in the audit record
+	IPAddress    string
+	UserAgent    string
+}
+
+// ImpersonateOutput contains the short-lived token issued for the target user
+type ImpersonateOutput struct {
+	Token     string
+	ExpiresAt time.Time
+	User      model.User
+}
+
+// RedeemLoginCodeOutput contains the login token a one-time code stood for
+type RedeemLoginCodeOutput struct {
+	Token     string
+	ExpiresAt time.Time
+}
+
+// ExchangeTokenInput contains an RFC 8693 token exchange request from an
+// authenticated service
+type ExchangeTokenInput struct {
+	SubjectToken string        // user access token presented by the service
+	ClientID     string        // service performing the exchange
+	Audience     string        // downstream service the new token is scoped to
+	TTL          time.Duration // upper bound, the subject token's expiry still applies
+}
+
+// ExchangeTokenOutput contains the audience-scoped token
+type ExchangeTokenOutput struct {
+	Token     string
+	ExpiresAt time.Time
+}
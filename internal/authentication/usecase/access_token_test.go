@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"identity-srv/internal/accesstoken"
+	"identity-srv/internal/model"
+	"identity-srv/internal/user"
+)
+
+// staticAccessTokens resolves every token to the same access token
+type staticAccessTokens struct {
+	accesstoken.UseCase
+	token model.AccessToken
+}
+
+func (s staticAccessTokens) Validate(ctx context.Context, token string) (model.AccessToken, error) {
+	return s.token, nil
+}
+
+// staticUsers returns the same user for every ID
+type staticUsers struct {
+	user.UseCase
+	user model.User
+}
+
+func (s staticUsers) Detail(ctx context.Context, id string) (model.User, error) {
+	return s.user, nil
+}
+
+func TestValidateAccessTokenWatermark(t *testing.T) {
+	created := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	before, after := created.Add(-time.Hour), created.Add(time.Hour)
+
+	tests := []struct {
+		name      string
+		active    bool
+		watermark *time.Time
+		want      bool
+	}{
+		{name: "no watermark", active: true, want: true},
+		{name: "created after logout-all", active: true, watermark: &before, want: true},
+		{name: "created before logout-all", active: true, watermark: &after},
+		{name: "deactivated user", active: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &ImplUsecase{
+				accessTokenUC: staticAccessTokens{token: model.AccessToken{ID: "pat1", UserID: "u1", CreatedAt: created}},
+				userUC:        staticUsers{user: model.User{ID: "u1", IsActive: tt.active, TokensRevokedBefore: tt.watermark}},
+			}
+
+			result, err := u.validateAccessToken(context.Background(), model.AccessTokenPrefix+"secret")
+			if err != nil {
+				t.Fatalf("validateAccessToken() error = %v", err)
+			}
+			if result.Valid != tt.want {
+				t.Fatalf("validateAccessToken() valid = %v, want %v", result.Valid, tt.want)
+			}
+		})
+	}
+}
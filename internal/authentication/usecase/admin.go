@@ -0,0 +1,46 @@
+package usecase
+
+import (
+	"context"
+	"identity-srv/internal/authentication"
+	"identity-srv/internal/model"
+)
+
+// AuthorizeAdmin checks the token of a request to identity's own admin API
+// (service accounts, webhooks, invitations, access requests, audit logs,
+// lockouts, revocation, impersonation, deactivation). The shared auth
+// middleware only checks the signature and expiry; an admin token revoked by
+// logout, logout-all or another admin must lose its power here at once.
+// Impersonation and exchanged tokens, and tokens issued for an audience,
+// never act as the admin even when they carry the ADMIN role.
+func (u *ImplUsecase) AuthorizeAdmin(ctx context.Context, input authentication.AuthorizeAdminInput) error {
+	result, err := u.ValidateToken(ctx, authentication.ValidateTokenInput{
+		Token:    input.Token,
+		Audience: input.Audience,
+	})
+	if err != nil {
+		u.l.Errorf(ctx, "authentication.usecase.AuthorizeAdmin.ValidateToken: %v", err)
+		return err
+	}
+	return checkAdmin(result)
+}
+
+// checkAdmin decides from a validated token whether it may use the admin API
+func checkAdmin(result *authentication.TokenValidationResult) error {
+	if result == nil || !result.Valid {
+		return authentication.ErrInvalidToken
+	}
+	if result.Actor != nil {
+		if result.Actor.ClientID != "" {
+			return authentication.ErrScopedToken
+		}
+		return authentication.ErrImpersonatedToken
+	}
+	if result.Audience != "" {
+		return authentication.ErrScopedToken
+	}
+	if result.Role != model.RoleAdmin {
+		return authentication.ErrAdminRequired
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"identity-srv/internal/authentication"
+	"identity-srv/internal/model"
+)
+
+func TestCheckAdmin(t *testing.T) {
+	tests := []struct {
+		name   string
+		result *authentication.TokenValidationResult
+		want   error
+	}{
+		{
+			name:   "admin token",
+			result: &authentication.TokenValidationResult{Valid: true, UserID: "a1", Role: model.RoleAdmin},
+		},
+		{
+			name:   "revoked admin token",
+			result: &authentication.TokenValidationResult{Valid: false},
+			want:   authentication.ErrInvalidToken,
+		},
+		{
+			name: "no result",
+			want: authentication.ErrInvalidToken,
+		},
+		{
+			name: "impersonation token",
+			result: &authentication.TokenValidationResult{
+				Valid: true, UserID: "u1", Role: model.RoleAdmin,
+				Actor: &authentication.Actor{UserID: "a1"},
+			},
+			want: authentication.ErrImpersonatedToken,
+		},
+		{
+			name: "exchanged token",
+			result: &authentication.TokenValidationResult{
+				Valid: true, UserID: "a1", Role: model.RoleAdmin, Audience: "billing",
+				Actor: &authentication.Actor{ClientID: "billing-svc"},
+			},
+			want: authentication.ErrScopedToken,
+		},
+		{
+			name:   "token issued for an application audience",
+			result: &authentication.TokenValidationResult{Valid: true, UserID: "a1", Role: model.RoleAdmin, Audience: "console"},
+			want:   authentication.ErrScopedToken,
+		},
+		{
+			name:   "viewer token",
+			result: &authentication.TokenValidationResult{Valid: true, UserID: "u1", Role: model.RoleViewer},
+			want:   authentication.ErrAdminRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkAdmin(tt.result)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("checkAdmin() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"identity-srv/internal/authentication"
+	"slices"
+)
+
+// application is a registered frontend. The zero value with the global
+// redirect validator stands for a login without client_id: global cookie
+// domain, tokens without audience, every role permitted.
+type application struct {
+	clientID        string
+	name            string
+	redirects       *RedirectValidator
+	cookieDomain    string
+	audience        string
+	defaultRedirect string
+	allowedRoles    []string
+}
+
+// lookup finds a registered application
+func (r *ApplicationRegistry) lookup(clientID string) (application, bool) {
+	if r == nil {
+		return application{}, false
+	}
+	app, ok := r.applications[clientID]
+	return app, ok
+}
+
+// isLoginAudience reports whether aud is the audience logins to a registered
+// application are issued with
+func (r *ApplicationRegistry) isLoginAudience(aud string) bool {
+	if r == nil || aud == "" {
+		return false
+	}
+	for _, app := range r.applications {
+		if app.audience == aud {
+			return true
+		}
+	}
+	return false
+}
+
+// loginApplication returns the application a login was started for; an empty
+// clientID selects the global settings
+func (u *ImplUsecase) loginApplication(clientID string) (application, error) {
+	if clientID == "" {
+		return application{redirects: u.redirectValidator}, nil
+	}
+	app, ok := u.applications.lookup(clientID)
+	if !ok {
+		return application{}, authentication.ErrUnknownClient
+	}
+	return app, nil
+}
+
+// validateRedirect checks a post-login redirect against the application's allowlist
+func (a application) validateRedirect(redirectURL string) error {
+	if a.redirects == nil {
+		return nil
+	}
+	return a.redirects.ValidateRedirectURL(redirectURL)
+}
+
+// permitsRole reports whether users with role may log in to the application
+func (a application) permitsRole(role string) bool {
+	return len(a.allowedRoles) == 0 || slices.Contains(a.allowedRoles, role)
+}
+
+// landingPage returns the redirect of a login, or the application's default
+// when the login named none
+func (a application) landingPage(redirectURL string) string {
+	if redirectURL == "" {
+		return a.defaultRedirect
+	}
+	return redirectURL
+}
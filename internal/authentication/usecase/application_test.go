@@ -0,0 +1,32 @@
+package usecase
+
+import "testing"
+
+func TestAcceptsAudience(t *testing.T) {
+	u := &ImplUsecase{applications: &ApplicationRegistry{applications: map[string]application{
+		"smap-admin": {clientID: "smap-admin", audience: "smap-admin"},
+	}}}
+
+	tests := []struct {
+		name string
+		aud  string
+		want string
+		ok   bool
+	}{
+		{name: "no audience asked", aud: "", want: "", ok: true},
+		{name: "application token, no audience asked", aud: "smap-admin", want: "", ok: true},
+		{name: "matching audience", aud: "smap-admin", want: "smap-admin", ok: true},
+		{name: "token for another audience", aud: "report-srv", want: "smap-admin"},
+		{name: "global token at a registered application", aud: "", want: "smap-admin"},
+		{name: "global token at an unregistered service", aud: "", want: "report-srv", ok: true},
+		{name: "application token at another service", aud: "smap-admin", want: "report-srv"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := u.acceptsAudience(tt.aud, tt.want); got != tt.ok {
+				t.Fatalf("acceptsAudience(%q, %q) = %v, want %v", tt.aud, tt.want, got, tt.ok)
+			}
+		})
+	}
+}
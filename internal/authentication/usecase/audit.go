@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"identity-srv/internal/audit"
+	"identity-srv/internal/authentication"
+	"identity-srv/internal/model"
+	"identity-srv/internal/securityalert"
+	"strconv"
+	"strings"
+
+	"github.com/smap-hcmut/shared-libs/go/auth"
+)
+
+// record appends an audit entry when the audit log is enabled, then checks the
+// event against the security alert rules
+func (u *ImplUsecase) record(ctx context.Context, ip audit.RecordInput) {
+	if u.auditUC != nil {
+		u.auditUC.Record(ctx, ip)
+	}
+	if u.securityAlertUC != nil {
+		meta := audit.GetRequestMetaFromContext(ctx)
+		u.securityAlertUC.Observe(ctx, securityalert.ObserveInput{
+			EventType:   ip.EventType,
+			Outcome:     ip.Outcome,
+			Reason:      ip.Reason,
+			ActorID:     ip.ActorID,
+			ActorEmail:  ip.ActorEmail,
+			TargetID:    ip.TargetID,
+			TargetEmail: ip.TargetEmail,
+			Metadata:    ip.Metadata,
+			IPAddress:   meta.IPAddress,
+			UserAgent:   meta.UserAgent,
+		})
+	}
+}
+
+// recordLogin records a login that issued a session token
+func (u *ImplUsecase) recordLogin(ctx context.Context, usr *model.User, role string, rememberMe bool, authn loginAuth) {
+	u.record(ctx, audit.RecordInput{
+		EventType:  model.AuditEventLogin,
+		ActorID:    usr.ID,
+		ActorEmail: usr.Email,
+		Metadata: map[string]string{
+			"role":        role,
+			"amr":         strings.Join(authn.AMR, " "),
+			"acr":         authn.ACR,
+			"remember_me": strconv.FormatBool(rememberMe),
+		},
+	})
+}
+
+// recordLoginFailure records a failed login; a nil err records nothing. Refusals
+// by the domain allowlist, the blocklist or an application's roles are recorded
+// as access_denied.
+// userID and email are empty when the login failed before identifying the user.
+func (u *ImplUsecase) recordLoginFailure(ctx context.Context, method, userID, email string, err error) {
+	if err == nil || errors.Is(err, authentication.ErrConfigurationMissing) {
+		return
+	}
+
+	eventType := model.AuditEventLogin
+	switch {
+	case errors.Is(err, authentication.ErrDomainNotAllowed),
+		errors.Is(err, authentication.ErrAccountBlocked),
+		errors.Is(err, authentication.ErrAccessPending),
+		errors.Is(err, authentication.ErrRoleNotAllowed):
+		eventType = model.AuditEventAccessDenied
+	}
+
+	reason, known := loginFailureReason(err)
+	metadata := map[string]string{"method": method}
+	if !known {
+		metadata["error"] = err.Error()
+	}
+
+	u.record(ctx, audit.RecordInput{
+		EventType:  eventType,
+		Outcome:    model.AuditOutcomeFailure,
+		Reason:     reason,
+		ActorID:    userID,
+		ActorEmail: email,
+		Metadata:   metadata,
+	})
+}
+
+// recordRoleChange records a role that differs from the one the user had
+func (u *ImplUsecase) recordRoleChange(ctx context.Context, usr *model.User, oldRole, newRole string) {
+	if oldRole == "" || oldRole == newRole {
+		return
+	}
+	u.record(ctx, audit.RecordInput{
+		EventType:   model.AuditEventRoleChange,
+		TargetID:    usr.ID,
+		TargetEmail: usr.Email,
+		Metadata: map[string]string{
+			"old_role": oldRole,
+			"new_role": newRole,
+		},
+	})
+}
+
+// auditActor returns the authenticated user of the request, if any
+func auditActor(ctx context.Context) (string, string) {
+	payload, ok := auth.GetPayloadFromContext(ctx)
+	if !ok {
+		return "", ""
+	}
+	return payload.UserID, payload.Username
+}
+
+// loginFailureReason maps a login error to a stable reason; known is false
+// for unexpected errors, which are recorded verbatim in the metadata
+func loginFailureReason(err error) (reason string, known bool) {
+	switch {
+	case errors.Is(err, authentication.ErrDomainNotAllowed):
+		return "domain_not_allowed", true
+	case errors.Is(err, authentication.ErrAccountBlocked):
+		return "account_blocked", true
+	case errors.Is(err, authentication.ErrAccessPending):
+		return "access_pending", true
+	case errors.Is(err, authentication.ErrReauthRequired):
+		return "reauth_required", true
+	case errors.Is(err, authentication.ErrInvalidMFAChallenge):
+		return "invalid_mfa_challenge", true
+	case errors.Is(err, authentication.ErrWrongOTP):
+		return "wrong_code", true
+	case errors.Is(err, authentication.ErrTooManyAttempts):
+		return "too_many_attempts", true
+	case errors.Is(err, authentication.ErrOTPExpired):
+		return "expired", true
+	case errors.Is(err, authentication.ErrMFANotEnrolled):
+		return "mfa_not_enrolled", true
+	case errors.Is(err, authentication.ErrInvalidPasskey):
+		return "invalid_passkey_ceremony", true
+	case errors.Is(err, authentication.ErrPasskeyNotVerified):
+		return "passkey_not_verified", true
+	case errors.Is(err, authentication.ErrInvalidMagicLink):
+		return "invalid_magic_link", true
+	case errors.Is(err, authentication.ErrUserNotFound):
+		return "user_not_found", true
+	case errors.Is(err, authentication.ErrInvalidRedirectURL), errors.Is(err, authentication.ErrRedirectURLNotAllowed):
+		return "redirect_url_not_allowed", true
+	case errors.Is(err, authentication.ErrUnknownClient):
+		return "unknown_client", true
+	case errors.Is(err, authentication.ErrRoleNotAllowed):
+		return "role_not_allowed", true
+	default:
+		return "error", false
+	}
+}
@@ -2,9 +2,14 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"identity-srv/internal/accesstoken"
+	"identity-srv/internal/audit"
 	"identity-srv/internal/authentication"
 	"identity-srv/internal/model"
+	"net/url"
+	"strings"
 	"time"
 )
 
@@ -28,22 +33,90 @@ func (u *ImplUsecase) GetUserByID(ctx context.Context, userID string) (*model.Us
 	return &usr, nil
 }
 
-// Logout invalidates the current session
+// Logout invalidates the current session and blacklists the current token
 func (u *ImplUsecase) Logout(ctx context.Context, sc model.Scope) error {
-	if u.sessionManager == nil {
-		return nil
-	}
-
-	if err := u.sessionManager.DeleteSession(ctx, sc.JTI); err != nil {
-		u.l.Errorf(ctx, "authentication.usecase.Logout.DeleteSession: %v", err)
+	if err := u.revokeCurrentToken(ctx, sc.JTI, sc.ExpiresAt); err != nil {
+		u.l.Errorf(ctx, "authentication.usecase.Logout.revokeCurrentToken: %v", err)
 		return err
 	}
 
+	u.record(ctx, audit.RecordInput{
+		EventType:  model.AuditEventLogout,
+		ActorID:    sc.UserID,
+		ActorEmail: sc.Username,
+	})
+	u.enqueueSessionRevoked(ctx, sc.UserID, sc.JTI)
 	return nil
 }
 
+// EndSession performs an RP-initiated logout: revokes the presented token (if any),
+// validates the post-logout redirect and optionally routes through the IdP logout
+func (u *ImplUsecase) EndSession(ctx context.Context, input authentication.EndSessionInput) (*authentication.EndSessionOutput, error) {
+	redirectURL := input.PostLogoutRedirectURI
+	if u.redirectValidator != nil {
+		if err := u.redirectValidator.ValidateRedirectURL(redirectURL); err != nil {
+			return nil, err
+		}
+	}
+	if redirectURL == "" {
+		redirectURL = "/"
+	}
+	if input.State != "" {
+		redirectURL = appendQueryParam(redirectURL, "state", input.State)
+	}
+
+	// An expired or invalid token has nothing left to revoke
+	loggedOut := false
+	if input.Token != "" && u.jwtManager != nil {
+		if payload, err := u.jwtManager.Verify(input.Token); err == nil {
+			if err := u.revokeCurrentToken(ctx, payload.Id, payload.ExpiresAt); err != nil {
+				u.l.Errorf(ctx, "authentication.usecase.EndSession.revokeCurrentToken: %v", err)
+				return nil, err
+			}
+			u.record(ctx, audit.RecordInput{
+				EventType:  model.AuditEventLogout,
+				ActorID:    payload.UserID,
+				ActorEmail: payload.Username,
+				Metadata:   map[string]string{"method": "end_session"},
+			})
+			u.enqueueSessionRevoked(ctx, payload.UserID, payload.Id)
+
+			// Only end the browser session of the same user, so a hint for
+			// someone else's token cannot log this browser out
+			session, err := u.jwtManager.Verify(input.SessionToken)
+			loggedOut = err != nil || session.UserID == payload.UserID
+		}
+	}
+
+	if input.IdPLogout && u.oauthProvider != nil {
+		// The IdP can only return to an absolute URL
+		postLogoutURI := ""
+		if parsed, err := url.Parse(redirectURL); err == nil && parsed.IsAbs() {
+			postLogoutURI = redirectURL
+		}
+		if endSessionURL := u.oauthProvider.GetEndSessionURL(postLogoutURI); endSessionURL != "" {
+			return &authentication.EndSessionOutput{RedirectURL: endSessionURL, LoggedOut: loggedOut}, nil
+		}
+	}
+
+	return &authentication.EndSessionOutput{RedirectURL: redirectURL, LoggedOut: loggedOut}, nil
+}
+
 // ValidateToken verifies a JWT token
-func (u *ImplUsecase) ValidateToken(ctx context.Context, token string) (*authentication.TokenValidationResult, error) {
+func (u *ImplUsecase) ValidateToken(ctx context.Context, input authentication.ValidateTokenInput) (*authentication.TokenValidationResult, error) {
+	token := input.Token
+	if strings.HasPrefix(token, model.AccessTokenPrefix) {
+		result, err := u.validateAccessToken(ctx, token)
+		if err != nil {
+			return nil, err
+		}
+		if !u.acceptsAudience(result.Audience, input.Audience) {
+			return &authentication.TokenValidationResult{Valid: false}, nil
+		}
+		u.checkMaxAge(result, input.MaxAge)
+		return result, nil
+	}
+
 	if u.jwtManager == nil {
 		return nil, fmt.Errorf("jwt manager not configured")
 	}
@@ -64,21 +137,79 @@ func (u *ImplUsecase) ValidateToken(ctx context.Context, token string) (*authent
 		}
 	}
 
-	return &authentication.TokenValidationResult{
+	revoked, err := u.isRevokedByWatermark(ctx, payload.UserID, payload.IssuedAt)
+	if err != nil {
+		u.l.Errorf(ctx, "authentication.usecase.ValidateToken.isRevokedByWatermark: %v", err)
+		return nil, err
+	}
+	if revoked {
+		return &authentication.TokenValidationResult{Valid: false}, nil
+	}
+
+	result := &authentication.TokenValidationResult{
 		Valid:     true,
+		TokenType: authentication.TokenTypeAccess,
 		UserID:    payload.UserID,
 		Email:     payload.Username,
 		Role:      payload.Role,
 		Groups:    []string{},
 		ExpiresAt: time.Unix(payload.ExpiresAt, 0),
-	}, nil
+	}
+
+	// Surface the impersonating admin or exchanging service so downstream
+	// services can log it
+	if claims, err := parseClaims(token); err == nil {
+		result.Actor = claims.Act
+		result.Audience = claims.Audience
+		if claims.AuthTime > 0 {
+			result.AuthTime = time.Unix(claims.AuthTime, 0)
+		}
+		result.AMR = claims.AMR
+		result.ACR = claims.ACR
+	}
+
+	if !u.acceptsAudience(result.Audience, input.Audience) {
+		return &authentication.TokenValidationResult{Valid: false}, nil
+	}
+
+	u.checkMaxAge(result, input.MaxAge)
+	return result, nil
+}
+
+// acceptsAudience reports whether a token issued for aud may be used by a
+// caller validating for want. Exchanged tokens and logins to a registered
+// application are only valid for the audience they were issued to. Tokens
+// without audience (logins without client_id, personal access tokens) are
+// accepted everywhere except by a registered application, whose allowed_roles
+// they would otherwise bypass.
+func (u *ImplUsecase) acceptsAudience(aud, want string) bool {
+	switch {
+	case want == "" || aud == want:
+		return true
+	case aud == "":
+		return !u.applications.isLoginAudience(want)
+	default:
+		return false
+	}
+}
+
+// checkMaxAge flags a valid token whose login is older than maxAge, or unknown
+// as for impersonation and personal access tokens
+func (u *ImplUsecase) checkMaxAge(result *authentication.TokenValidationResult, maxAge *time.Duration) {
+	if maxAge == nil || !result.Valid {
+		return
+	}
+	result.ReauthRequired = result.AuthTime.IsZero() || result.AuthTime.Before(u.clock().Add(-*maxAge))
 }
 
 // RevokeToken revokes a specific token
 func (u *ImplUsecase) RevokeToken(ctx context.Context, jti string) error {
-	if u.sessionManager == nil || u.blacklistManager == nil {
+	if u.sessionManager == nil {
 		return authentication.ErrConfigurationMissing
 	}
+	if u.blacklistManager == nil {
+		return authentication.ErrBlacklistDisabled
+	}
 
 	session, err := u.sessionManager.GetSession(ctx, jti)
 	if err != nil {
@@ -89,10 +220,88 @@ func (u *ImplUsecase) RevokeToken(ctx context.Context, jti string) error {
 		return err
 	}
 
-	return u.sessionManager.DeleteSession(ctx, jti)
+	if err := u.sessionManager.DeleteSession(ctx, jti); err != nil {
+		return err
+	}
+
+	actorID, actorEmail := auditActor(ctx)
+	u.record(ctx, audit.RecordInput{
+		EventType:  model.AuditEventTokenRevoke,
+		ActorID:    actorID,
+		ActorEmail: actorEmail,
+		TargetID:   session.UserID,
+		Metadata:   map[string]string{"jti": jti},
+	})
+	u.enqueueSessionRevoked(ctx, session.UserID, jti)
+	return nil
 }
 
-// RevokeAllUserTokens revokes all tokens for a user
+// RevokeAllUserTokens revokes all tokens for a user. It is audited as
+// logout_all when users revoke their own tokens, else as token_revoke.
 func (u *ImplUsecase) RevokeAllUserTokens(ctx context.Context, userID string) error {
-	return u.revokeAllUserTokensInternal(ctx, userID)
+	if err := u.revokeAllUserTokensInternal(ctx, userID); err != nil {
+		return err
+	}
+
+	actorID, actorEmail := auditActor(ctx)
+	if actorID == userID {
+		u.record(ctx, audit.RecordInput{
+			EventType:  model.AuditEventLogoutAll,
+			ActorID:    actorID,
+			ActorEmail: actorEmail,
+		})
+		return nil
+	}
+	u.record(ctx, audit.RecordInput{
+		EventType:  model.AuditEventTokenRevoke,
+		ActorID:    actorID,
+		ActorEmail: actorEmail,
+		TargetID:   userID,
+		Metadata:   map[string]string{"jti": "all"},
+	})
+	return nil
+}
+
+// validateAccessToken validates a personal access token. The role comes from
+// the user's current record, so a demotion applies to existing tokens.
+func (u *ImplUsecase) validateAccessToken(ctx context.Context, token string) (*authentication.TokenValidationResult, error) {
+	if u.accessTokenUC == nil {
+		return &authentication.TokenValidationResult{Valid: false}, nil
+	}
+
+	pat, err := u.accessTokenUC.Validate(ctx, token)
+	if err != nil {
+		if errors.Is(err, accesstoken.ErrInvalidToken) {
+			return &authentication.TokenValidationResult{Valid: false}, nil
+		}
+		u.l.Errorf(ctx, "authentication.usecase.validateAccessToken.Validate: %v", err)
+		return nil, fmt.Errorf("%w: %v", authentication.ErrInternalSystem, err)
+	}
+
+	usr, err := u.userUC.Detail(ctx, pat.UserID)
+	if err != nil || !usr.IsActive {
+		return &authentication.TokenValidationResult{Valid: false}, nil
+	}
+
+	// "Revoke all user tokens" also covers access tokens created before it. The
+	// watermark on the user row never expires, unlike its blacklist mirror, and
+	// revokeAllUserTokensInternal writes it whether or not the blacklist is
+	// enabled.
+	if usr.TokensRevokedBefore != nil && pat.CreatedAt.Before(*usr.TokensRevokedBefore) {
+		return &authentication.TokenValidationResult{Valid: false}, nil
+	}
+
+	result := &authentication.TokenValidationResult{
+		Valid:     true,
+		TokenType: authentication.TokenTypePersonalAccessToken,
+		UserID:    usr.ID,
+		Email:     usr.Email,
+		Role:      usr.GetRole(),
+		Groups:    []string{},
+		Scopes:    pat.Scopes,
+	}
+	if pat.ExpiresAt != nil {
+		result.ExpiresAt = *pat.ExpiresAt
+	}
+	return result, nil
 }
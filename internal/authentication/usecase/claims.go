@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"identity-srv/internal/authentication"
+
+	"github.com/golang-jwt/jwt"
+)
+
+// mfaChallengeType is the "type" claim of MFA challenges
+const mfaChallengeType = "mfa_challenge"
+
+// signClaims signs claims with the JWT secret
+func (u *ImplUsecase) signClaims(claims tokenClaims) (string, error) {
+	if len(u.signingKey) == 0 {
+		return "", fmt.Errorf("token signing key not configured")
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(u.signingKey)
+}
+
+// parseClaims reads the extension claims of a token whose signature was
+// already verified by auth.Manager
+func parseClaims(token string) (tokenClaims, error) {
+	var claims tokenClaims
+	if _, _, err := new(jwt.Parser).ParseUnverified(token, &claims); err != nil {
+		return tokenClaims{}, err
+	}
+	return claims, nil
+}
+
+// generateJTI returns a random token ID
+func generateJTI() (string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", fmt.Errorf("rand.Read: %w", err)
+	}
+	return hex.EncodeToString(id), nil
+}
+
+// mfaChallengeKey derives the HMAC key of MFA challenges from the JWT secret
+func (u *ImplUsecase) mfaChallengeKey() []byte {
+	mac := hmac.New(sha256.New, u.signingKey)
+	mac.Write([]byte(mfaChallengeType))
+	return mac.Sum(nil)
+}
+
+// signMFAChallenge signs challenge claims with the derived key
+func (u *ImplUsecase) signMFAChallenge(claims mfaChallengeClaims) (string, error) {
+	if len(u.signingKey) == 0 {
+		return "", fmt.Errorf("token signing key not configured")
+	}
+	claims.Type = mfaChallengeType
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(u.mfaChallengeKey())
+}
+
+// parseMFAChallenge verifies a challenge. An expired challenge returns ErrOTPExpired.
+func (u *ImplUsecase) parseMFAChallenge(challenge string) (mfaChallengeClaims, error) {
+	var claims mfaChallengeClaims
+	_, err := jwt.ParseWithClaims(challenge, &claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return u.mfaChallengeKey(), nil
+	})
+	if err != nil {
+		var vErr *jwt.ValidationError
+		if errors.As(err, &vErr) && vErr.Errors == jwt.ValidationErrorExpired {
+			return mfaChallengeClaims{}, authentication.ErrOTPExpired
+		}
+		return mfaChallengeClaims{}, authentication.ErrInvalidMFAChallenge
+	}
+	if claims.Type != mfaChallengeType || claims.Issuer != u.tokenIssuer || claims.Subject == "" {
+		return mfaChallengeClaims{}, authentication.ErrInvalidMFAChallenge
+	}
+	return claims, nil
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"identity-srv/internal/audit"
+	"identity-srv/internal/authentication"
+	"identity-srv/internal/model"
+)
+
+// DeactivateUser marks a user inactive so they can no longer log in, and
+// revokes the tokens they already hold. Admins cannot deactivate themselves.
+func (u *ImplUsecase) DeactivateUser(ctx context.Context, sc model.Scope, userID string) (*model.User, error) {
+	if userID == sc.UserID {
+		return nil, fmt.Errorf("%w: cannot deactivate yourself", authentication.ErrCannotDeactivate)
+	}
+
+	usr, err := u.userUC.Deactivate(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, authentication.ErrUserNotFound
+		}
+		u.l.Errorf(ctx, "authentication.usecase.DeactivateUser.Deactivate: %v", err)
+		return nil, fmt.Errorf("%w: %v", authentication.ErrInternalSystem, err)
+	}
+
+	if err := u.revokeAllUserTokensInternal(ctx, userID); err != nil {
+		u.l.Errorf(ctx, "authentication.usecase.DeactivateUser.revokeAllUserTokensInternal: %v", err)
+		return nil, err
+	}
+
+	u.record(ctx, audit.RecordInput{
+		EventType:   model.AuditEventDeactivation,
+		ActorID:     sc.UserID,
+		ActorEmail:  sc.Username,
+		TargetID:    usr.ID,
+		TargetEmail: usr.Email,
+	})
+
+	return &usr, nil
+}
@@ -0,0 +1,27 @@
+package usecase
+
+import (
+	"context"
+	"identity-srv/internal/model"
+	"identity-srv/internal/outbox"
+)
+
+// enqueueSessionRevoked publishes session.revoked for a single token. The
+// session lives outside Postgres, so the event cannot share its transaction;
+// a failure is logged and the revocation itself stands. Revoking every token
+// of a user writes its event with the user change instead.
+func (u *ImplUsecase) enqueueSessionRevoked(ctx context.Context, userID, jti string) {
+	if u.outboxUC == nil || userID == "" {
+		return
+	}
+	if err := u.outboxUC.Enqueue(ctx, outbox.EnqueueInput{
+		EventType:   model.EventSessionRevoked,
+		AggregateID: userID,
+		Payload: model.SessionRevokedEvent{
+			UserID: userID,
+			JTI:    jti,
+		},
+	}); err != nil {
+		u.l.Errorf(ctx, "authentication.usecase.enqueueSessionRevoked: %v", err)
+	}
+}
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"identity-srv/internal/authentication"
+	"time"
+
+	"github.com/smap-hcmut/shared-libs/go/auth"
+)
+
+// ExchangeToken issues a short-lived token for the subject token's user, scoped to
+// a single downstream audience (RFC 8693). The exchanging service is recorded in
+// the "act" claim. The subject token must itself be unscoped or scoped to the
+// exchanging service, so a token can only be narrowed along the call chain.
+func (u *ImplUsecase) ExchangeToken(ctx context.Context, input authentication.ExchangeTokenInput) (*authentication.ExchangeTokenOutput, error) {
+	subject, err := u.ValidateToken(ctx, authentication.ValidateTokenInput{
+		Token:    input.SubjectToken,
+		Audience: input.ClientID,
+	})
+	if err != nil {
+		u.l.Errorf(ctx, "authentication.usecase.ExchangeToken.ValidateToken: %v", err)
+		return nil, fmt.Errorf("%w: %v", authentication.ErrInternalSystem, err)
+	}
+	// Personal access tokens are long-lived credentials, not tokens a service receives on behalf of a user
+	if !subject.Valid || subject.TokenType != authentication.TokenTypeAccess {
+		return nil, authentication.ErrInvalidSubjectToken
+	}
+
+	jti, err := generateJTI()
+	if err != nil {
+		u.l.Errorf(ctx, "authentication.usecase.ExchangeToken.generateJTI: %v", err)
+		return nil, fmt.Errorf("%w: %v", authentication.ErrInternalSystem, err)
+	}
+
+	now := u.clock()
+	expiresAt := now.Add(input.TTL)
+	if subject.ExpiresAt.Before(expiresAt) {
+		expiresAt = subject.ExpiresAt
+	}
+
+	claims := tokenClaims{
+		Payload: auth.Payload{
+			UserID:   subject.UserID,
+			Username: subject.Email,
+			Role:     subject.Role,
+			Type:     "access",
+		},
+		Act: &authentication.Actor{
+			UserID:   input.ClientID,
+			ClientID: input.ClientID,
+			Act:      subject.Actor,
+		},
+		// The exchanged token stands for the same login
+		AMR: subject.AMR,
+		ACR: subject.ACR,
+	}
+	if !subject.AuthTime.IsZero() {
+		claims.AuthTime = subject.AuthTime.Unix()
+	}
+	claims.Id = jti
+	claims.Subject = subject.UserID
+	claims.Audience = input.Audience
+	claims.Issuer = u.tokenIssuer
+	claims.IssuedAt = now.Unix()
+	claims.ExpiresAt = expiresAt.Unix()
+
+	token, err := u.signClaims(claims)
+	if err != nil {
+		u.l.Errorf(ctx, "authentication.usecase.ExchangeToken.signClaims: %v", err)
+		return nil, fmt.Errorf("%w: %v", authentication.ErrInternalSystem, err)
+	}
+
+	u.l.Infof(ctx, "Token exchanged: UserID=%s Client=%s Audience=%s JTI=%s ExpiresAt=%s",
+		subject.UserID, input.ClientID, input.Audience, jti, expiresAt.Format(time.RFC3339))
+
+	return &authentication.ExchangeTokenOutput{
+		Token:     token,
+		ExpiresAt: expiresAt,
+	}, nil
+}
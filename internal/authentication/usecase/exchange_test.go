@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"identity-srv/internal/authentication"
+	"identity-srv/internal/model"
+
+	"github.com/smap-hcmut/shared-libs/go/auth"
+	"github.com/smap-hcmut/shared-libs/go/log"
+)
+
+type testLogger struct{ log.Logger }
+
+func (testLogger) Debugf(context.Context, string, ...any) {}
+func (testLogger) Infof(context.Context, string, ...any)  {}
+func (testLogger) Warnf(context.Context, string, ...any)  {}
+func (testLogger) Errorf(context.Context, string, ...any) {}
+
+// staticJWT accepts every token as the same payload
+type staticJWT struct {
+	auth.Manager
+	payload auth.Payload
+}
+
+func (s staticJWT) Verify(token string) (auth.Payload, error) {
+	return s.payload, nil
+}
+
+func TestExchangeTokenExpiry(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		subjectExpiry time.Time
+		want          time.Time
+	}{
+		{name: "exchange ttl", subjectExpiry: now.Add(time.Hour), want: now.Add(5 * time.Minute)},
+		{name: "capped by the subject token", subjectExpiry: now.Add(2 * time.Minute), want: now.Add(2 * time.Minute)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload := auth.Payload{UserID: "u1", Username: "a@tantai.dev", Role: model.RoleViewer, Type: "access"}
+			payload.Id = "jti1"
+			payload.IssuedAt = now.Add(-time.Minute).Unix()
+			payload.ExpiresAt = tt.subjectExpiry.Unix()
+
+			u := &ImplUsecase{
+				l:           testLogger{},
+				clock:       func() time.Time { return now },
+				jwtManager:  staticJWT{payload: payload},
+				userUC:      staticUsers{user: model.User{ID: "u1", IsActive: true}},
+				signingKey:  []byte("0123456789abcdef0123456789abcdef"),
+				tokenIssuer: "identity-srv",
+			}
+
+			out, err := u.ExchangeToken(context.Background(), authentication.ExchangeTokenInput{
+				SubjectToken: "subject",
+				ClientID:     "gateway-srv",
+				Audience:     "report-srv",
+				TTL:          5 * time.Minute,
+			})
+			if err != nil {
+				t.Fatalf("ExchangeToken() error = %v", err)
+			}
+			if !out.ExpiresAt.Equal(tt.want) {
+				t.Fatalf("ExchangeToken() expires at %v, want %v", out.ExpiresAt, tt.want)
+			}
+			claims, err := parseClaims(out.Token)
+			if err != nil || claims.ExpiresAt != tt.want.Unix() || claims.Audience != "report-srv" {
+				t.Fatalf("exchanged token claims = %+v, %v, want exp %d for report-srv", claims, err, tt.want.Unix())
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"identity-srv/internal/audit"
+	"identity-srv/internal/authentication"
+	"identity-srv/internal/authentication/repository"
+	"identity-srv/internal/model"
+	"time"
+
+	"github.com/smap-hcmut/shared-libs/go/auth"
+)
+
+// Impersonate issues a short-lived token for the target user on behalf of an admin.
+// The token carries an "act" claim with the admin, its session is marked as
+// impersonated, and other admins cannot be impersonated.
+func (u *ImplUsecase) Impersonate(ctx context.Context, sc model.Scope, input authentication.ImpersonateInput) (*authentication.ImpersonateOutput, error) {
+	if sc.Role != model.RoleAdmin {
+		return nil, authentication.ErrCannotImpersonate
+	}
+	if input.TargetUserID == sc.UserID {
+		return nil, fmt.Errorf("%w: cannot impersonate yourself", authentication.ErrCannotImpersonate)
+	}
+
+	target, err := u.userUC.Detail(ctx, input.TargetUserID)
+	if err != nil {
+		u.l.Errorf(ctx, "authentication.usecase.Impersonate.Detail: %v", err)
+		return nil, authentication.ErrUserNotFound
+	}
+	if !target.IsActive {
+		return nil, authentication.ErrAccountBlocked
+	}
+	role := target.GetRole()
+	if role == model.RoleAdmin {
+		u.l.Warnf(ctx, "authentication.usecase.Impersonate: admin %s tried to impersonate admin %s", sc.UserID, target.ID)
+		u.record(ctx, audit.RecordInput{
+			EventType:   model.AuditEventImpersonation,
+			Outcome:     model.AuditOutcomeFailure,
+			Reason:      "target_is_admin",
+			ActorID:     sc.UserID,
+			ActorEmail:  sc.Username,
+			TargetID:    target.ID,
+			TargetEmail: target.Email,
+		})
+		return nil, fmt.Errorf("%w: target is an admin", authentication.ErrCannotImpersonate)
+	}
+
+	jti, err := generateJTI()
+	if err != nil {
+		u.l.Errorf(ctx, "authentication.usecase.Impersonate.generateJTI: %v", err)
+		return nil, fmt.Errorf("%w: %v", authentication.ErrInternalSystem, err)
+	}
+
+	now := u.clock()
+	expiresAt := now.Add(u.impersonationTTL)
+	claims := tokenClaims{
+		Payload: auth.Payload{
+			UserID:   target.ID,
+			Username: target.Email,
+			Role:     role,
+			Type:     "access",
+		},
+		Act: &authentication.Actor{
+			UserID: sc.UserID,
+			Email:  sc.Username,
+		},
+	}
+	claims.Id = jti
+	claims.Subject = target.ID
+	claims.Issuer = u.tokenIssuer
+	claims.IssuedAt = now.Unix()
+	claims.ExpiresAt = expiresAt.Unix()
+
+	token, err := u.signClaims(claims)
+	if err != nil {
+		u.l.Errorf(ctx, "authentication.usecase.Impersonate.signClaims: %v", err)
+		return nil, fmt.Errorf("%w: %v", authentication.ErrInternalSystem, err)
+	}
+
+	if err := u.createSession(ctx, repository.CreateSessionOptions{
+		UserID:         target.ID,
+		JTI:            jti,
+		TTL:            u.impersonationTTL,
+		ImpersonatorID: sc.UserID,
+	}); err != nil {
+		u.l.Errorf(ctx, "authentication.usecase.Impersonate.createSession: %v", err)
+		return nil, err
+	}
+
+	u.l.Warnf(ctx, "AUDIT impersonation: actor=%s (%s) target=%s (%s) jti=%s expires_at=%s ip=%s ua=%q reason=%q",
+		sc.UserID, sc.Username, target.ID, target.Email, jti, expiresAt.Format(time.RFC3339), input.IPAddress, input.UserAgent, input.Reason)
+	u.record(ctx, audit.RecordInput{
+		EventType:   model.AuditEventImpersonation,
+		ActorID:     sc.UserID,
+		ActorEmail:  sc.Username,
+		TargetID:    target.ID,
+		TargetEmail: target.Email,
+		Metadata: map[string]string{
+			"jti":        jti,
+			"expires_at": expiresAt.Format(time.RFC3339),
+			"reason":     input.Reason,
+		},
+	})
+
+	return &authentication.ImpersonateOutput{
+		Token:     token,
+		ExpiresAt: expiresAt,
+		User:      target,
+	}, nil
+}
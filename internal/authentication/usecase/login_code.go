@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"identity-srv/internal/authentication"
+)
+
+// CreateLoginCode stores the login token under a random one-time code, so the
+// redirect URL carries the code instead of the token
+func (u *ImplUsecase) CreateLoginCode(ctx context.Context, token string) (string, error) {
+	if u.loginCodeStore == nil {
+		return "", authentication.ErrConfigurationMissing
+	}
+
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		u.l.Errorf(ctx, "authentication.usecase.CreateLoginCode.rand.Read: %v", err)
+		return "", fmt.Errorf("%w: %v", authentication.ErrInternalSystem, err)
+	}
+	code := base64.RawURLEncoding.EncodeToString(b)
+
+	if err := u.loginCodeStore.SaveLoginCode(ctx, code, token, u.loginCodeTTL); err != nil {
+		u.l.Errorf(ctx, "authentication.usecase.CreateLoginCode.SaveLoginCode: %v", err)
+		return "", err
+	}
+	return code, nil
+}
+
+// RedeemLoginCode returns the token of a one-time code. A code works once and
+// only until login_code.ttl; a token revoked in the meantime is refused.
+func (u *ImplUsecase) RedeemLoginCode(ctx context.Context, code string) (*authentication.RedeemLoginCodeOutput, error) {
+	if u.loginCodeStore == nil {
+		return nil, authentication.ErrConfigurationMissing
+	}
+
+	token, err := u.loginCodeStore.TakeLoginCode(ctx, code)
+	if err != nil {
+		u.l.Errorf(ctx, "authentication.usecase.RedeemLoginCode.TakeLoginCode: %v", err)
+		return nil, err
+	}
+	if token == "" {
+		return nil, authentication.ErrInvalidLoginCode
+	}
+
+	result, err := u.ValidateToken(ctx, authentication.ValidateTokenInput{Token: token})
+	if err != nil {
+		u.l.Errorf(ctx, "authentication.usecase.RedeemLoginCode.ValidateToken: %v", err)
+		return nil, fmt.Errorf("%w: %v", authentication.ErrInternalSystem, err)
+	}
+	if !result.Valid {
+		return nil, authentication.ErrInvalidLoginCode
+	}
+
+	return &authentication.RedeemLoginCodeOutput{
+		Token:     token,
+		ExpiresAt: result.ExpiresAt,
+	}, nil
+}
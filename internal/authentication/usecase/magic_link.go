@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"identity-srv/internal/authentication"
+	"identity-srv/internal/magiclink"
+	"identity-srv/internal/ratelimit"
+	"net/mail"
+	"slices"
+)
+
+// RequestMagicLink emails a login link. Addresses that may not log in get no
+// email but the same answer, so the endpoint does not reveal who has access.
+func (u *ImplUsecase) RequestMagicLink(ctx context.Context, input authentication.MagicLinkRequestInput) error {
+	if u.magicLinkUC == nil {
+		return authentication.ErrConfigurationMissing
+	}
+
+	// 1. Validate the application and its post-login redirect, which the link carries signed
+	app, err := u.loginApplication(input.ClientID)
+	if err != nil {
+		return err
+	}
+	if err := app.validateRedirect(input.RedirectURL); err != nil {
+		return err
+	}
+
+	// 2. Validate the address
+	addr, err := mail.ParseAddress(input.Email)
+	if err != nil || addr.Name != "" {
+		return authentication.ErrInvalidEmail
+	}
+	email := normalizeAccessControlValue(addr.Address)
+	if err := u.allowEmail(ctx, ratelimit.RouteMagicLink, email); err != nil {
+		return err
+	}
+
+	// 3. Apply the access rules (business rule)
+	if u.isBlockedEmail(email) || !u.isMagicLinkAllowed(ctx, email) {
+		u.l.Warnf(ctx, "Magic link refused: email=%s ip=%s ua=%q", email, input.IPAddress, input.UserAgent)
+		return nil
+	}
+
+	// 4. Send the link
+	if err := u.magicLinkUC.Send(ctx, magiclink.SendInput{
+		Email:       email,
+		RedirectURL: input.RedirectURL,
+		RememberMe:  input.RememberMe,
+		ClientID:    app.clientID,
+		IPAddress:   input.IPAddress,
+		UserAgent:   input.UserAgent,
+	}); err != nil {
+		return u.mapMagicLinkError(ctx, "RequestMagicLink", err)
+	}
+	return nil
+}
+
+// MagicLinkLogin redeems a link and completes the login the same way as the
+// OAuth callback, MFA challenge included. The link proves control of the
+// mailbox, a single factor.
+func (u *ImplUsecase) MagicLinkLogin(ctx context.Context, input authentication.MagicLinkLoginInput) (output *authentication.OAuthCallbackOutput, err error) {
+	var email string
+	defer func() { u.recordLoginFailure(ctx, authentication.AMREmail, "", email, err) }()
+
+	if u.magicLinkUC == nil {
+		return nil, authentication.ErrConfigurationMissing
+	}
+
+	// 1. Redeem the link, which identifies the address
+	link, err := u.magicLinkUC.Redeem(ctx, input.Token)
+	if err != nil {
+		if errors.Is(err, magiclink.ErrInvalidLink) || errors.Is(err, magiclink.ErrLinkUsed) {
+			u.l.Warnf(ctx, "Magic link login failed: ip=%s ua=%q", input.IPAddress, input.UserAgent)
+		}
+		return nil, u.mapMagicLinkError(ctx, "MagicLinkLogin", err)
+	}
+	email = link.Email
+
+	// 2. Re-apply the access rules, which may have changed since the link was sent
+	if !u.isMagicLinkAllowed(ctx, link.Email) {
+		return nil, authentication.ErrDomainNotAllowed
+	}
+	if u.isBlockedEmail(link.Email) {
+		return nil, authentication.ErrAccountBlocked
+	}
+
+	// 3. Create the session, or an MFA challenge, for the application the link was sent for
+	output, err = u.completeLogin(ctx, link.Email, "", "", authentication.OAuthCallbackInput{
+		RedirectURL: link.RedirectURL,
+		RememberMe:  link.RememberMe,
+		ClientID:    link.ClientID,
+		IPAddress:   input.IPAddress,
+		UserAgent:   input.UserAgent,
+	}, loginAuth{
+		Time: u.clock(),
+		AMR:  []string{authentication.AMREmail},
+		ACR:  authentication.ACRSingleFactor,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	u.l.Infof(ctx, "Magic link login: Email=%s", link.Email)
+	return output, nil
+}
+
+// isMagicLinkAllowed checks the domain allowlist, extended by invitations and
+// magic_link.allowed_domains
+func (u *ImplUsecase) isMagicLinkAllowed(ctx context.Context, email string) bool {
+	return slices.Contains(u.magicLinkDomains, u.extractDomain(email)) || u.isAllowedEmail(ctx, email)
+}
+
+func (u *ImplUsecase) mapMagicLinkError(ctx context.Context, method string, err error) error {
+	switch {
+	case errors.Is(err, magiclink.ErrInvalidLink), errors.Is(err, magiclink.ErrLinkUsed):
+		return authentication.ErrInvalidMagicLink
+	case errors.Is(err, magiclink.ErrLinkExpired):
+		return authentication.ErrOTPExpired
+	case errors.Is(err, magiclink.ErrTooManyRequests):
+		return authentication.ErrTooManyAttempts
+	default:
+		u.l.Errorf(ctx, "authentication.usecase.%s: %v", method, err)
+		return fmt.Errorf("%w: %v", authentication.ErrInternalSystem, err)
+	}
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"identity-srv/internal/authentication"
+	"identity-srv/internal/magiclink"
+)
+
+// recordingMagicLinks records the links it is asked to send
+type recordingMagicLinks struct {
+	magiclink.UseCase
+	sent []magiclink.SendInput
+}
+
+func (r *recordingMagicLinks) Send(ctx context.Context, ip magiclink.SendInput) error {
+	r.sent = append(r.sent, ip)
+	return nil
+}
+
+func TestRequestMagicLinkApplication(t *testing.T) {
+	adminRedirects, err := NewRedirectValidator([]string{"https://admin.tantai.dev"})
+	if err != nil {
+		t.Fatalf("NewRedirectValidator: %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		clientID     string
+		redirect     string
+		want         error
+		wantClientID string
+	}{
+		{name: "no application, global allowlist", redirect: "https://app.tantai.dev/reports"},
+		{name: "registered application", clientID: "smap-admin", redirect: "https://admin.tantai.dev/users", wantClientID: "smap-admin"},
+		{name: "redirect outside the application's allowlist", clientID: "smap-admin", redirect: "https://app.tantai.dev/reports", want: authentication.ErrRedirectURLNotAllowed},
+		{name: "unknown application", clientID: "unknown", redirect: "https://admin.tantai.dev/users", want: authentication.ErrUnknownClient},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			links := &recordingMagicLinks{}
+			u := &ImplUsecase{
+				redirectValidator: newTestRedirectValidator(t),
+				applications: &ApplicationRegistry{applications: map[string]application{
+					"smap-admin": {clientID: "smap-admin", redirects: adminRedirects, audience: "smap-admin"},
+				}},
+				magicLinkUC:      links,
+				magicLinkDomains: []string{"tantai.dev"},
+			}
+
+			err := u.RequestMagicLink(context.Background(), authentication.MagicLinkRequestInput{
+				Email:       "a@tantai.dev",
+				RedirectURL: tt.redirect,
+				ClientID:    tt.clientID,
+			})
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("RequestMagicLink() error = %v, want %v", err, tt.want)
+			}
+			if tt.want != nil {
+				if len(links.sent) != 0 {
+					t.Fatalf("RequestMagicLink() sent %d links, want none", len(links.sent))
+				}
+				return
+			}
+			if len(links.sent) != 1 || links.sent[0].ClientID != tt.wantClientID {
+				t.Fatalf("RequestMagicLink() sent %+v, want one link for client %q", links.sent, tt.wantClientID)
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"identity-srv/internal/authentication"
+	"identity-srv/internal/mfa"
+	"identity-srv/internal/model"
+	"slices"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+)
+
+// EnrollMFAChallenge starts TOTP enrolment for a user whose role requires MFA
+// but who has no factor yet. The challenge stands in for a session.
+func (u *ImplUsecase) EnrollMFAChallenge(ctx context.Context, challenge string) (*authentication.MFAChallengeEnrollOutput, error) {
+	if u.mfaUC == nil {
+		return nil, authentication.ErrConfigurationMissing
+	}
+
+	claims, err := u.parseMFAChallenge(challenge)
+	if err != nil {
+		return nil, err
+	}
+	if !claims.Enroll {
+		return nil, authentication.ErrMFAAlreadyEnrolled
+	}
+
+	usr, err := u.challengeUser(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := u.mfaUC.Enroll(ctx, model.Scope{UserID: usr.ID, Username: usr.Email, Role: claims.Role})
+	if err != nil {
+		return nil, u.mapMFAError(ctx, "EnrollMFAChallenge", err)
+	}
+
+	return &authentication.MFAChallengeEnrollOutput{
+		Secret:     output.Secret,
+		OTPAuthURI: output.OTPAuthURI,
+	}, nil
+}
+
+// VerifyMFAChallenge completes a login with a TOTP or recovery code. For an
+// enrolment challenge the code also confirms the new factor.
+func (u *ImplUsecase) VerifyMFAChallenge(ctx context.Context, input authentication.VerifyMFAChallengeInput) (output *authentication.VerifyMFAChallengeOutput, err error) {
+	var userID, email string
+	defer func() { u.recordLoginFailure(ctx, authentication.AMRTOTP, userID, email, err) }()
+
+	if u.mfaUC == nil {
+		return nil, authentication.ErrConfigurationMissing
+	}
+
+	claims, err := u.parseMFAChallenge(input.Challenge)
+	if err != nil {
+		return nil, err
+	}
+	app, err := u.loginApplication(claims.ClientID)
+	if err != nil {
+		return nil, err
+	}
+
+	usr, err := u.challengeUser(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+	userID, email = usr.ID, usr.Email
+
+	var recoveryCodes []string
+	if claims.Enroll {
+		output, err := u.mfaUC.Confirm(ctx, model.Scope{UserID: usr.ID, Username: usr.Email, Role: claims.Role}, input.Code)
+		if err != nil {
+			return nil, u.mapMFAError(ctx, "VerifyMFAChallenge.Confirm", err)
+		}
+		recoveryCodes = output.RecoveryCodes
+	} else if err := u.mfaUC.Verify(ctx, usr.ID, input.Code); err != nil {
+		if errors.Is(err, mfa.ErrWrongCode) || errors.Is(err, mfa.ErrTooManyAttempts) {
+			u.l.Warnf(ctx, "MFA challenge failed: UserID=%s ip=%s ua=%q err=%v", usr.ID, input.IPAddress, input.UserAgent, err)
+		}
+		return nil, u.mapMFAError(ctx, "VerifyMFAChallenge.Verify", err)
+	}
+
+	token, err := u.issueLoginToken(ctx, usr, claims.Role, []string{}, claims.RememberMe, challengeAuth(claims, app, authentication.AMRTOTP))
+	if err != nil {
+		return nil, err
+	}
+
+	u.l.Infof(ctx, "MFA challenge passed: UserID=%s Enrolment=%t", usr.ID, claims.Enroll)
+	return &authentication.VerifyMFAChallengeOutput{
+		Token:         token,
+		RedirectURL:   claims.Redirect,
+		CookieDomain:  app.cookieDomain,
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+// mfaRequirement reports whether the login needs a second factor and which
+// factors the user has. Users with any factor are always challenged.
+func (u *ImplUsecase) mfaRequirement(ctx context.Context, userID, role string) (bool, []string, error) {
+	if u.mfaUC == nil {
+		return false, nil, nil
+	}
+
+	var methods []string
+	enrolled, err := u.mfaUC.IsEnrolled(ctx, userID)
+	if err != nil {
+		u.l.Errorf(ctx, "authentication.usecase.mfaRequirement.IsEnrolled: %v", err)
+		return false, nil, fmt.Errorf("%w: %v", authentication.ErrInternalSystem, err)
+	}
+	if enrolled {
+		methods = append(methods, authentication.MFAMethodTOTP)
+	}
+
+	if u.passkeyUC != nil {
+		hasPasskey, err := u.passkeyUC.HasPasskey(ctx, userID)
+		if err != nil {
+			u.l.Errorf(ctx, "authentication.usecase.mfaRequirement.HasPasskey: %v", err)
+			return false, nil, fmt.Errorf("%w: %v", authentication.ErrInternalSystem, err)
+		}
+		if hasPasskey {
+			methods = append(methods, authentication.MFAMethodPasskey)
+		}
+	}
+
+	return len(methods) > 0 || slices.Contains(u.mfaRequiredRoles, role), methods, nil
+}
+
+// newMFAChallenge signs the state of a login waiting for its second factor
+func (u *ImplUsecase) newMFAChallenge(userID, role string, input authentication.OAuthCallbackInput, methods []string, authn loginAuth) (string, error) {
+	jti, err := generateJTI()
+	if err != nil {
+		return "", err
+	}
+
+	now := u.clock()
+	return u.signMFAChallenge(mfaChallengeClaims{
+		StandardClaims: jwt.StandardClaims{
+			Id:        jti,
+			Subject:   userID,
+			Issuer:    u.tokenIssuer,
+			IssuedAt:  now.Unix(),
+			ExpiresAt: now.Add(u.mfaChallengeTTL).Unix(),
+		},
+		Role:       role,
+		RememberMe: input.RememberMe,
+		Redirect:   input.RedirectURL,
+		ClientID:   input.ClientID,
+		Enroll:     len(methods) == 0,
+		Methods:    methods,
+		AuthTime:   authn.Time.Unix(),
+		AMR:        authn.AMR,
+	})
+}
+
+// challengeAuth describes a login to app completed through a challenge. auth_time stays
+// that of the first factor: the second factor does not make the first one fresher.
+func challengeAuth(claims mfaChallengeClaims, app application, method string) loginAuth {
+	authTime := time.Unix(claims.AuthTime, 0)
+	if claims.AuthTime == 0 {
+		authTime = time.Unix(claims.IssuedAt, 0)
+	}
+	amr := claims.AMR
+	if len(amr) == 0 {
+		amr = []string{authentication.AMROAuth}
+	}
+	return loginAuth{
+		Time:     authTime,
+		AMR:      append(slices.Clone(amr), method),
+		ACR:      authentication.ACRMultiFactor,
+		Audience: app.audience,
+	}
+}
+
+// challengeUser loads the user of a challenge, refusing accounts blocked since the callback
+func (u *ImplUsecase) challengeUser(ctx context.Context, claims mfaChallengeClaims) (*model.User, error) {
+	usr, err := u.userUC.Detail(ctx, claims.Subject)
+	if err != nil {
+		u.l.Errorf(ctx, "authentication.usecase.challengeUser.Detail: %v", err)
+		return nil, authentication.ErrUserNotFound
+	}
+	if !usr.IsActive {
+		return nil, authentication.ErrAccountBlocked
+	}
+	return &usr, nil
+}
+
+// mapMFAError maps mfa usecase errors to authentication errors
+func (u *ImplUsecase) mapMFAError(ctx context.Context, method string, err error) error {
+	switch {
+	case errors.Is(err, mfa.ErrWrongCode):
+		return authentication.ErrWrongOTP
+	case errors.Is(err, mfa.ErrTooManyAttempts):
+		return authentication.ErrTooManyAttempts
+	case errors.Is(err, mfa.ErrNotEnrolled):
+		return authentication.ErrMFANotEnrolled
+	case errors.Is(err, mfa.ErrAlreadyEnrolled):
+		return authentication.ErrMFAAlreadyEnrolled
+	default:
+		u.l.Errorf(ctx, "authentication.usecase.%s: %v", method, err)
+		return fmt.Errorf("%w: %v", authentication.ErrInternalSystem, err)
+	}
+}
@@ -1,6 +1,18 @@
 package usecase
 
 import (
+	"fmt"
+	"identity-srv/internal/accessrequest"
+	"identity-srv/internal/accesstoken"
+	"identity-srv/internal/audit"
+	"identity-srv/internal/authentication/repository"
+	"identity-srv/internal/invitation"
+	"identity-srv/internal/magiclink"
+	"identity-srv/internal/mfa"
+	"identity-srv/internal/outbox"
+	"identity-srv/internal/passkey"
+	"identity-srv/internal/ratelimit"
+	"identity-srv/internal/securityalert"
 	"identity-srv/internal/user"
 	"identity-srv/pkg/oauth"
 	"time"
@@ -8,7 +20,6 @@ import (
 	"github.com/smap-hcmut/shared-libs/go/auth"
 	"github.com/smap-hcmut/shared-libs/go/encrypter"
 	"github.com/smap-hcmut/shared-libs/go/log"
-	"github.com/smap-hcmut/shared-libs/go/redis"
 
 	"identity-srv/config"
 )
@@ -18,41 +29,38 @@ type ImplUsecase struct {
 	scope             auth.Manager
 	encrypt           encrypter.Encrypter
 	userUC            user.UseCase
+	accessTokenUC     accesstoken.UseCase
+	mfaUC             mfa.UseCase
+	passkeyUC         passkey.UseCase
+	passkeyMaxAge     time.Duration
+	magicLinkUC       magiclink.UseCase
+	magicLinkDomains  []string
+	invitationUC      invitation.UseCase
+	accessRequestUC   accessrequest.UseCase
+	auditUC           audit.UseCase
+	outboxUC          outbox.UseCase
+	securityAlertUC   securityalert.UseCase
+	rateLimitUC       ratelimit.UseCase
+	loginCodeStore    repository.LoginCodeStore
+	loginCodeTTL      time.Duration
 	clock             func() time.Time
-	sessionManager    *SessionManager
-	blacklistManager  *BlacklistManager
+	sessionManager    repository.SessionManager
+	blacklistManager  repository.BlacklistManager
 	jwtManager        auth.Manager
+	tokenTTL          time.Duration
+	signingKey        []byte
+	tokenIssuer       string
+	impersonationTTL  time.Duration
+	mfaRequiredRoles  []string
+	mfaChallengeTTL   time.Duration
 	roleMapper        *RoleMapper
 	oauthProvider     oauth.Provider
 	redirectValidator *RedirectValidator
+	applications      *ApplicationRegistry
 	allowedDomains    []string
 	blockedEmails     []string
 }
 
-// --- Session types ---
-
-// SessionManager handles session storage and retrieval
-type SessionManager struct {
-	l     log.Logger
-	redis redis.IRedis
-	ttl   time.Duration
-}
-
-// SessionData represents session information stored in Redis
-type SessionData struct {
-	UserID    string    `json:"user_id"`
-	JTI       string    `json:"jti"`
-	CreatedAt time.Time `json:"created_at"`
-	ExpiresAt time.Time `json:"expires_at"`
-}
-
-// --- Blacklist types ---
-
-// BlacklistManager handles token blacklist operations
-type BlacklistManager struct {
-	redis redis.IRedis
-}
-
 // --- Role mapping types ---
 
 // RoleMapper handles email-to-role mapping logic
@@ -65,37 +73,30 @@ type RoleMapper struct {
 
 // RedirectValidator validates redirect URLs against allowed list
 type RedirectValidator struct {
-	allowedURLs []string
+	rules []redirectRule
+}
+
+// --- Application types ---
+
+// ApplicationRegistry holds the frontends registered for the login flow, by client_id
+type ApplicationRegistry struct {
+	applications map[string]application
 }
 
 func New(l log.Logger, scope auth.Manager, encrypt encrypter.Encrypter, userUC user.UseCase) *ImplUsecase {
 	return &ImplUsecase{
-		l:       l,
-		scope:   scope,
-		encrypt: encrypt,
-		userUC:  userUC,
-		clock:   time.Now,
+		l:                l,
+		scope:            scope,
+		encrypt:          encrypt,
+		userUC:           userUC,
+		clock:            time.Now,
+		tokenTTL:         7 * 24 * time.Hour,
+		impersonationTTL: 15 * time.Minute,
 	}
 }
 
 // --- Sub-manager factory functions ---
 
-// NewSessionManager creates a new session manager
-func NewSessionManager(redisClient redis.IRedis, ttl time.Duration, l log.Logger) *SessionManager {
-	return &SessionManager{
-		l:     l,
-		redis: redisClient,
-		ttl:   ttl,
-	}
-}
-
-// NewBlacklistManager creates a new blacklist manager
-func NewBlacklistManager(redisClient redis.IRedis) *BlacklistManager {
-	return &BlacklistManager{
-		redis: redisClient,
-	}
-}
-
 // NewRoleMapper creates a new role mapper
 func NewRoleMapper(cfg *config.Config) *RoleMapper {
 	return &RoleMapper{
@@ -104,27 +105,154 @@ func NewRoleMapper(cfg *config.Config) *RoleMapper {
 	}
 }
 
-// NewRedirectValidator creates a new redirect validator
-func NewRedirectValidator(allowedURLs []string) *RedirectValidator {
+// NewRedirectValidator creates a new redirect validator. It fails on an
+// allowlist entry it cannot parse rather than silently ignoring it.
+func NewRedirectValidator(allowedURLs []string) (*RedirectValidator, error) {
+	rules := make([]redirectRule, 0, len(allowedURLs))
+	for _, allowed := range allowedURLs {
+		rule, err := newRedirectRule(allowed)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
 	return &RedirectValidator{
-		allowedURLs: allowedURLs,
+		rules: rules,
+	}, nil
+}
+
+// NewApplicationRegistry creates the registry of config.Applications. It fails
+// on a redirect rule it cannot parse or a default_redirect its rules refuse.
+func NewApplicationRegistry(apps []config.ApplicationConfig) (*ApplicationRegistry, error) {
+	registry := &ApplicationRegistry{
+		applications: make(map[string]application, len(apps)),
 	}
+	for _, app := range apps {
+		redirects, err := NewRedirectValidator(app.AllowedRedirectURLs)
+		if err != nil {
+			return nil, fmt.Errorf("application %s: %w", app.ClientID, err)
+		}
+		if app.DefaultRedirect != "" {
+			if err := redirects.ValidateRedirectURL(app.DefaultRedirect); err != nil {
+				return nil, fmt.Errorf("application %s: default_redirect %q: %w", app.ClientID, app.DefaultRedirect, err)
+			}
+		}
+		registry.applications[app.ClientID] = application{
+			clientID:        app.ClientID,
+			name:            app.Name,
+			redirects:       redirects,
+			cookieDomain:    app.CookieDomain,
+			audience:        app.Audience,
+			defaultRedirect: app.DefaultRedirect,
+			allowedRoles:    app.AllowedRoles,
+		}
+	}
+	return registry, nil
 }
 
 // --- Setters (called after initialization) ---
 
-func (u *ImplUsecase) SetSessionManager(manager *SessionManager) {
+func (u *ImplUsecase) SetSessionManager(manager repository.SessionManager) {
 	u.sessionManager = manager
 }
 
-func (u *ImplUsecase) SetBlacklistManager(manager *BlacklistManager) {
+// SetBlacklistManager sets the blacklist store; nil disables revocation checks
+func (u *ImplUsecase) SetBlacklistManager(manager repository.BlacklistManager) {
 	u.blacklistManager = manager
 }
 
+// SetAccessTokenUseCase enables personal access tokens in ValidateToken
+func (u *ImplUsecase) SetAccessTokenUseCase(uc accesstoken.UseCase) {
+	u.accessTokenUC = uc
+}
+
+// SetMFA enables the TOTP step-up challenge after the OAuth callback for users
+// with a confirmed factor and for the required roles; a nil usecase disables it
+func (u *ImplUsecase) SetMFA(uc mfa.UseCase, requiredRoles []string, challengeTTL time.Duration) {
+	u.mfaUC = uc
+	u.mfaRequiredRoles = requiredRoles
+	u.mfaChallengeTTL = challengeTTL
+}
+
+// SetPasskey enables passwordless login and passkeys as a second factor in the
+// MFA challenge; a nil usecase disables both. Registering a passkey needs a
+// login younger than registrationMaxAge.
+func (u *ImplUsecase) SetPasskey(uc passkey.UseCase, registrationMaxAge time.Duration) {
+	u.passkeyUC = uc
+	u.passkeyMaxAge = registrationMaxAge
+}
+
+// SetMagicLink enables login with an emailed link. allowedDomains extends the
+// domain allowlist for this method only; a nil usecase disables it.
+func (u *ImplUsecase) SetMagicLink(uc magiclink.UseCase, allowedDomains []string) {
+	u.magicLinkUC = uc
+	u.magicLinkDomains = normalizeAccessControlList(allowedDomains)
+}
+
+// SetInvitation lets invited addresses log in whatever their domain, with the
+// invited role; a nil usecase disables invitations
+func (u *ImplUsecase) SetInvitation(uc invitation.UseCase) {
+	u.invitationUC = uc
+}
+
+// SetAccessRequest files an access request when the domain allowlist refuses
+// an OAuth login, and lets approved addresses in; nil disables the workflow
+func (u *ImplUsecase) SetAccessRequest(uc accessrequest.UseCase) {
+	u.accessRequestUC = uc
+}
+
+// SetAudit records logins, logouts, revocations, role changes, impersonation
+// and access denials in the audit log; nil disables it
+func (u *ImplUsecase) SetAudit(uc audit.UseCase) {
+	u.auditUC = uc
+}
+
+// SetOutbox publishes session.revoked when a single token is revoked; nil
+// disables it. User changes write their events through the user repository.
+func (u *ImplUsecase) SetOutbox(uc outbox.UseCase) {
+	u.outboxUC = uc
+}
+
+// SetSecurityAlert checks audited events against the security alert rules;
+// nil disables alerts
+func (u *ImplUsecase) SetSecurityAlert(uc securityalert.UseCase) {
+	u.securityAlertUC = uc
+}
+
+// SetLoginCodeStore enables the one-time codes that replace the token in
+// post-login redirects; each code expires after ttl
+func (u *ImplUsecase) SetLoginCodeStore(store repository.LoginCodeStore, ttl time.Duration) {
+	u.loginCodeStore = store
+	u.loginCodeTTL = ttl
+}
+
+// SetRateLimit applies the per-email limits of the callback and magic-link
+// routes and locks out repeated failed callbacks; nil disables both
+func (u *ImplUsecase) SetRateLimit(uc ratelimit.UseCase) {
+	u.rateLimitUC = uc
+}
+
 func (u *ImplUsecase) SetJWTManager(manager auth.Manager) {
 	u.jwtManager = manager
 }
 
+// SetTokenTTL sets the longest lifetime of an issued token, used to expire revocation state
+func (u *ImplUsecase) SetTokenTTL(ttl time.Duration) {
+	u.tokenTTL = ttl
+}
+
+// SetTokenSigner sets the HS256 key and issuer for tokens that carry extra
+// claims (e.g. act). The key must be the one auth.Manager verifies with.
+func (u *ImplUsecase) SetTokenSigner(secretKey, issuer string) {
+	u.signingKey = []byte(secretKey)
+	u.tokenIssuer = issuer
+}
+
+// SetImpersonationTTL sets the lifetime of impersonation tokens
+func (u *ImplUsecase) SetImpersonationTTL(ttl time.Duration) {
+	u.impersonationTTL = ttl
+}
+
 func (u *ImplUsecase) SetRoleMapper(mapper *RoleMapper) {
 	u.roleMapper = mapper
 }
@@ -137,6 +265,12 @@ func (u *ImplUsecase) SetRedirectValidator(validator *RedirectValidator) {
 	u.redirectValidator = validator
 }
 
+// SetApplications lets a login select a registered application with its
+// client_id; nil refuses every client_id
+func (u *ImplUsecase) SetApplications(registry *ApplicationRegistry) {
+	u.applications = registry
+}
+
 func (u *ImplUsecase) SetAccessControl(allowedDomains, blockedEmails []string) {
 	u.allowedDomains = normalizeAccessControlList(allowedDomains)
 	u.blockedEmails = normalizeAccessControlList(blockedEmails)
@@ -2,18 +2,45 @@ package usecase
 
 import (
 	"context"
+	"fmt"
 	"identity-srv/internal/authentication"
+	"identity-srv/internal/ratelimit"
+	"identity-srv/pkg/oauth"
+	"strings"
+	"time"
 
 	"golang.org/x/oauth2"
 )
 
-// InitiateOAuthLogin generates the OAuth authorization URL and state
+// authTimeLeeway absorbs clock skew between the provider and this service when
+// checking the auth_time of a forced re-authentication
+const authTimeLeeway = time.Minute
+
+// InitiateOAuthLogin validates the application and post-login redirect and
+// generates the OAuth authorization URL
 func (u *ImplUsecase) InitiateOAuthLogin(ctx context.Context, input authentication.OAuthLoginInput) (*authentication.OAuthLoginOutput, error) {
 	if u.oauthProvider == nil {
 		return nil, authentication.ErrInvalidProvider
 	}
 
-	authURL := u.oauthProvider.GetAuthCodeURL(input.State, oauth2.AccessTypeOffline)
+	providerName := u.oauthProvider.GetProviderName()
+	if input.Provider != "" && input.Provider != providerName {
+		return nil, authentication.ErrInvalidProvider
+	}
+	app, err := u.loginApplication(input.ClientID)
+	if err != nil {
+		return nil, err
+	}
+	if err := app.validateRedirect(input.RedirectURL); err != nil {
+		return nil, err
+	}
+
+	opts := []oauth2.AuthCodeOption{oauth2.AccessTypeOffline}
+	if input.MaxAge != nil {
+		opts = append(opts, oauth.ReauthenticateOptions(providerName, *input.MaxAge)...)
+	}
+	opts = append(opts, oauth.LoginContextOptions(providerName, input.LoginHint, input.Locale)...)
+	authURL := u.oauthProvider.GetAuthCodeURL(input.State, opts...)
 
 	return &authentication.OAuthLoginOutput{
 		AuthURL: authURL,
@@ -22,9 +49,32 @@ func (u *ImplUsecase) InitiateOAuthLogin(ctx context.Context, input authenticati
 }
 
 // ProcessOAuthCallback handles the entire OAuth callback business logic:
-// exchange code → get user info → validate domain → create/update user →
-// fetch groups → map role → generate JWT → create session
-func (u *ImplUsecase) ProcessOAuthCallback(ctx context.Context, input authentication.OAuthCallbackInput) (*authentication.OAuthCallbackOutput, error) {
+// check lockout → exchange code → get user info → check auth_time → validate
+// domain → check blocklist → completeLogin. Failures are audited with their
+// reason and count towards the lockout.
+func (u *ImplUsecase) ProcessOAuthCallback(ctx context.Context, input authentication.OAuthCallbackInput) (output *authentication.OAuthCallbackOutput, err error) {
+	var email string
+	defer func() {
+		u.recordLoginFailure(ctx, authentication.AMROAuth, "", email, err)
+		u.recordCallbackFailure(ctx, email, input.IPAddress, err)
+	}()
+
+	// 0. Refuse a locked-out IP before calling the provider
+	if err := u.checkLockout(ctx, "", input.IPAddress); err != nil {
+		return nil, err
+	}
+	if input.Provider != "" && input.Provider != u.oauthProvider.GetProviderName() {
+		return nil, authentication.ErrInvalidProvider
+	}
+	// The application and its allowlist may have changed since the login started
+	app, err := u.loginApplication(input.ClientID)
+	if err != nil {
+		return nil, err
+	}
+	if err := app.validateRedirect(input.RedirectURL); err != nil {
+		return nil, err
+	}
+
 	// 1. Exchange code for token via OAuth provider
 	token, err := u.oauthProvider.ExchangeCode(ctx, input.Code)
 	if err != nil {
@@ -38,50 +88,138 @@ func (u *ImplUsecase) ProcessOAuthCallback(ctx context.Context, input authentica
 		u.l.Errorf(ctx, "authentication.usecase.ProcessOAuthCallback.GetUserInfo: %v", err)
 		return nil, err
 	}
+	email = userInfo.Email
+	if input.LoginHint != "" && !strings.EqualFold(input.LoginHint, email) {
+		u.l.Debugf(ctx, "authentication.usecase.ProcessOAuthCallback: user chose %s over login_hint %s", email, input.LoginHint)
+	}
+	if err := u.checkLockout(ctx, email, ""); err != nil {
+		return nil, err
+	}
+	if err := u.allowEmail(ctx, ratelimit.RouteCallback, email); err != nil {
+		return nil, err
+	}
+
+	// 3. Check the provider honoured max_age/prompt=login (business rule)
+	authTime, err := u.providerAuthTime(ctx, userInfo.Email, userInfo.AuthTime, input.AuthNotBefore)
+	if err != nil {
+		return nil, err
+	}
 
-	// 3. Validate domain (business rule)
-	if !u.isAllowedDomain(userInfo.Email) {
-		return nil, authentication.ErrDomainNotAllowed
+	// 4. Validate domain, unless the address is invited or approved (business rule)
+	if !u.isAllowedEmail(ctx, userInfo.Email) {
+		return nil, u.refuseDomain(ctx, userInfo.Email, userInfo.Name, input)
 	}
 
-	// 4. Check blocklist (business rule)
+	// 5. Check blocklist (business rule)
 	if u.isBlockedEmail(userInfo.Email) {
 		return nil, authentication.ErrAccountBlocked
 	}
 
-	// 5. Create or update user
-	usr, err := u.createOrUpdateUser(ctx, userInfo.Email, userInfo.Name, userInfo.Picture)
+	// 6. Create the session, or an MFA challenge
+	return u.completeLogin(ctx, userInfo.Email, userInfo.Name, userInfo.Picture, input, loginAuth{
+		Time: authTime,
+		AMR:  []string{authentication.AMROAuth},
+		ACR:  authentication.ACRSingleFactor,
+	})
+}
+
+// completeLogin finishes a login once the first factor has identified the user
+// and the access rules passed. It is shared by the OAuth callback and the
+// magic-link login: create/update user → map role → check the application
+// permits it → MFA challenge or generate JWT → create session
+func (u *ImplUsecase) completeLogin(ctx context.Context, email, name, avatarURL string, input authentication.OAuthCallbackInput, authn loginAuth) (*authentication.OAuthCallbackOutput, error) {
+	app, err := u.loginApplication(input.ClientID)
 	if err != nil {
 		return nil, err
 	}
+	input.RedirectURL = app.landingPage(input.RedirectURL)
+	authn.Audience = app.audience
 
-	// 6. Map email to role
+	// 1. Create or update user
+	usr, err := u.createOrUpdateUser(ctx, email, name, avatarURL)
+	if err != nil {
+		return nil, err
+	}
+	if !usr.IsActive {
+		return nil, authentication.ErrAccountBlocked
+	}
+
+	// 2. Map email to role
 	u.l.Debugf(ctx, "Mapping email to role")
 	groups := []string{} // Empty groups array as we no longer use Google Groups
-	role := u.mapEmailToRole(userInfo.Email)
+	role := u.mapEmailToRole(ctx, email)
 	u.l.Debugf(ctx, "Role mapped: %s", role)
 
-	// 7. Update user role
+	// 3. Update user role
 	u.l.Debugf(ctx, "Setting user role in memory")
+	oldRole := usr.GetRole()
 	usr.SetRole(role)
 	u.l.Debugf(ctx, "Updating user role in DB")
 	if err := u.updateUserRole(ctx, usr.ID, role); err != nil {
-		u.l.Errorf(ctx, "authentication.usecase.ProcessOAuthCallback.UpdateUserRole: %v", err)
+		u.l.Errorf(ctx, "authentication.usecase.completeLogin.UpdateUserRole: %v", err)
+	} else {
+		u.recordRoleChange(ctx, usr, oldRole, role)
+	}
+
+	// 4. Check the application admits the role (business rule)
+	if !app.permitsRole(role) {
+		u.l.Warnf(ctx, "Role not allowed: Email=%s Role=%s Application=%s (%s)", email, role, app.clientID, app.name)
+		return nil, authentication.ErrRoleNotAllowed
 	}
 
-	// 8. Generate JWT token
-	u.l.Debugf(ctx, "Generating JWT token")
-	jwtToken, jti, err := u.generateToken(ctx, usr, role, groups)
+	// 5. Require a second factor (business rule)
+	required, methods, err := u.mfaRequirement(ctx, usr.ID, role)
 	if err != nil {
 		return nil, err
 	}
+	if required {
+		challenge, err := u.newMFAChallenge(usr.ID, role, input, methods, authn)
+		if err != nil {
+			u.l.Errorf(ctx, "authentication.usecase.completeLogin.newMFAChallenge: %v", err)
+			return nil, fmt.Errorf("%w: %v", authentication.ErrInternalSystem, err)
+		}
+		u.l.Infof(ctx, "MFA challenge issued: UserID=%s Role=%s Methods=%v", usr.ID, role, methods)
+		return &authentication.OAuthCallbackOutput{
+			MFAChallenge:          challenge,
+			MFAMethods:            methods,
+			MFAEnrollmentRequired: len(methods) == 0,
+			RedirectURL:           input.RedirectURL,
+			CookieDomain:          app.cookieDomain,
+		}, nil
+	}
 
-	// 9. Create session
-	if err := u.createSession(ctx, usr.ID, jti, input.RememberMe); err != nil {
+	// 6. Generate JWT token and create session
+	jwtToken, err := u.issueLoginToken(ctx, usr, role, groups, input.RememberMe, authn)
+	if err != nil {
 		return nil, err
 	}
 
 	return &authentication.OAuthCallbackOutput{
-		Token: jwtToken,
+		Token:        jwtToken,
+		RedirectURL:  input.RedirectURL,
+		CookieDomain: app.cookieDomain,
 	}, nil
 }
+
+// providerAuthTime returns when the user authenticated at the provider. When the
+// login forced re-authentication, an older auth_time is rejected, and so is a
+// missing one: freshness the provider does not vouch for is not stamped on the
+// token.
+func (u *ImplUsecase) providerAuthTime(ctx context.Context, email string, reported, notBefore time.Time) (time.Time, error) {
+	now := u.clock()
+	if reported.IsZero() || reported.After(now) {
+		if !notBefore.IsZero() {
+			u.l.Warnf(ctx, "Provider did not report auth_time for a forced re-authentication: Email=%s required=%s",
+				email, notBefore.Format(time.RFC3339))
+			return time.Time{}, authentication.ErrReauthRequired
+		}
+		return now, nil
+	}
+
+	if !notBefore.IsZero() && reported.Before(notBefore.Add(-authTimeLeeway)) {
+		u.l.Warnf(ctx, "Provider skipped re-authentication: Email=%s auth_time=%s required=%s",
+			email, reported.Format(time.RFC3339), notBefore.Format(time.RFC3339))
+		return time.Time{}, authentication.ErrReauthRequired
+	}
+	return reported, nil
+}
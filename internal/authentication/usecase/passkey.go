@@ -0,0 +1,201 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"identity-srv/internal/authentication"
+	"identity-srv/internal/passkey"
+)
+
+// BeginMFAPasskeyChallenge starts a passkey assertion bound to the user of an MFA challenge
+func (u *ImplUsecase) BeginMFAPasskeyChallenge(ctx context.Context, challenge string) (*authentication.PasskeyCeremonyOutput, error) {
+	if u.mfaUC == nil || u.passkeyUC == nil {
+		return nil, authentication.ErrConfigurationMissing
+	}
+
+	claims, err := u.parseMFAChallenge(challenge)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Enroll {
+		return nil, authentication.ErrMFANotEnrolled
+	}
+
+	usr, err := u.challengeUser(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := u.passkeyUC.BeginLogin(ctx, usr.ID)
+	if err != nil {
+		return nil, u.mapPasskeyError(ctx, "BeginMFAPasskeyChallenge", err)
+	}
+	return &authentication.PasskeyCeremonyOutput{
+		Ceremony: output.Ceremony,
+		Options:  output.Options,
+	}, nil
+}
+
+// VerifyMFAPasskeyChallenge completes a login with a passkey assertion
+func (u *ImplUsecase) VerifyMFAPasskeyChallenge(ctx context.Context, input authentication.VerifyMFAPasskeyInput) (output *authentication.VerifyMFAChallengeOutput, err error) {
+	var userID, email string
+	defer func() { u.recordLoginFailure(ctx, authentication.AMRWebAuthn, userID, email, err) }()
+
+	if u.mfaUC == nil || u.passkeyUC == nil {
+		return nil, authentication.ErrConfigurationMissing
+	}
+
+	claims, err := u.parseMFAChallenge(input.Challenge)
+	if err != nil {
+		return nil, err
+	}
+	app, err := u.loginApplication(claims.ClientID)
+	if err != nil {
+		return nil, err
+	}
+
+	usr, err := u.challengeUser(ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+	userID, email = usr.ID, usr.Email
+
+	if _, err := u.passkeyUC.FinishLogin(ctx, passkey.FinishLoginInput{
+		Ceremony:   input.Ceremony,
+		UserID:     usr.ID,
+		Credential: input.Credential,
+	}); err != nil {
+		if errors.Is(err, passkey.ErrVerificationFailed) {
+			u.l.Warnf(ctx, "MFA passkey challenge failed: UserID=%s ip=%s ua=%q", usr.ID, input.IPAddress, input.UserAgent)
+		}
+		return nil, u.mapPasskeyError(ctx, "VerifyMFAPasskeyChallenge", err)
+	}
+
+	token, err := u.issueLoginToken(ctx, usr, claims.Role, []string{}, claims.RememberMe, challengeAuth(claims, app, authentication.AMRWebAuthn))
+	if err != nil {
+		return nil, err
+	}
+
+	u.l.Infof(ctx, "MFA challenge passed: UserID=%s Method=%s", usr.ID, authentication.MFAMethodPasskey)
+	return &authentication.VerifyMFAChallengeOutput{
+		Token:        token,
+		RedirectURL:  claims.Redirect,
+		CookieDomain: app.cookieDomain,
+	}, nil
+}
+
+// BeginPasskeyLogin starts a passwordless login with any discoverable passkey
+func (u *ImplUsecase) BeginPasskeyLogin(ctx context.Context) (*authentication.PasskeyCeremonyOutput, error) {
+	if u.passkeyUC == nil {
+		return nil, authentication.ErrConfigurationMissing
+	}
+
+	output, err := u.passkeyUC.BeginLogin(ctx, "")
+	if err != nil {
+		return nil, u.mapPasskeyError(ctx, "BeginPasskeyLogin", err)
+	}
+	return &authentication.PasskeyCeremonyOutput{
+		Ceremony: output.Ceremony,
+		Options:  output.Options,
+	}, nil
+}
+
+// FinishPasskeyLogin logs a user in with a passkey instead of the OAuth provider.
+// The passkey requires user verification, so it satisfies the MFA requirement on
+// its own. Only existing users can have a passkey; the access rules of the
+// OAuth callback, those of the application included, still apply.
+func (u *ImplUsecase) FinishPasskeyLogin(ctx context.Context, input authentication.PasskeyLoginInput) (output *authentication.PasskeyLoginOutput, err error) {
+	var userID, email string
+	defer func() { u.recordLoginFailure(ctx, authentication.AMRWebAuthn, userID, email, err) }()
+
+	if u.passkeyUC == nil {
+		return nil, authentication.ErrConfigurationMissing
+	}
+
+	// 1. Validate the application and its post-login redirect (it is not carried
+	// in a signed state here)
+	app, err := u.loginApplication(input.ClientID)
+	if err != nil {
+		return nil, err
+	}
+	if err := app.validateRedirect(input.RedirectURL); err != nil {
+		return nil, err
+	}
+
+	// 2. Verify the assertion, which identifies the user
+	userID, err = u.passkeyUC.FinishLogin(ctx, passkey.FinishLoginInput{
+		Ceremony:   input.Ceremony,
+		Credential: input.Credential,
+	})
+	if err != nil {
+		if errors.Is(err, passkey.ErrVerificationFailed) {
+			u.l.Warnf(ctx, "Passkey login failed: ip=%s ua=%q", input.IPAddress, input.UserAgent)
+		}
+		return nil, u.mapPasskeyError(ctx, "FinishPasskeyLogin", err)
+	}
+
+	// 3. Load the user and apply the access rules (business rule)
+	usr, err := u.userUC.Detail(ctx, userID)
+	if err != nil {
+		u.l.Errorf(ctx, "authentication.usecase.FinishPasskeyLogin.Detail: %v", err)
+		return nil, authentication.ErrUserNotFound
+	}
+	email = usr.Email
+	if !usr.IsActive || u.isBlockedEmail(usr.Email) {
+		return nil, authentication.ErrAccountBlocked
+	}
+	if !u.isAllowedEmail(ctx, usr.Email) {
+		return nil, authentication.ErrDomainNotAllowed
+	}
+
+	// 4. Map email to role and check the application admits it, as the OAuth callback does
+	role := u.mapEmailToRole(ctx, usr.Email)
+	oldRole := usr.GetRole()
+	usr.SetRole(role)
+	if err := u.updateUserRole(ctx, usr.ID, role); err != nil {
+		u.l.Errorf(ctx, "authentication.usecase.FinishPasskeyLogin.UpdateUserRole: %v", err)
+	} else {
+		u.recordRoleChange(ctx, &usr, oldRole, role)
+	}
+	if !app.permitsRole(role) {
+		u.l.Warnf(ctx, "Role not allowed: Email=%s Role=%s Application=%s (%s)", usr.Email, role, app.clientID, app.name)
+		return nil, authentication.ErrRoleNotAllowed
+	}
+
+	// 5. Generate JWT token and create session. The passkey verified the user, so
+	// it counts as two factors on its own.
+	token, err := u.issueLoginToken(ctx, &usr, role, []string{}, input.RememberMe, loginAuth{
+		Time:     u.clock(),
+		AMR:      []string{authentication.AMRWebAuthn},
+		ACR:      authentication.ACRMultiFactor,
+		Audience: app.audience,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	u.l.Infof(ctx, "Passkey login: UserID=%s Role=%s", usr.ID, role)
+	return &authentication.PasskeyLoginOutput{
+		Token:        token,
+		RedirectURL:  app.landingPage(input.RedirectURL),
+		CookieDomain: app.cookieDomain,
+	}, nil
+}
+
+// mapPasskeyError maps passkey usecase errors to authentication errors
+func (u *ImplUsecase) mapPasskeyError(ctx context.Context, method string, err error) error {
+	switch {
+	case errors.Is(err, passkey.ErrInvalidCeremony):
+		return authentication.ErrInvalidPasskey
+	case errors.Is(err, passkey.ErrCeremonyExpired):
+		return authentication.ErrOTPExpired
+	case errors.Is(err, passkey.ErrVerificationFailed), errors.Is(err, passkey.ErrPasskeyNotFound):
+		return authentication.ErrPasskeyNotVerified
+	case errors.Is(err, passkey.ErrNoPasskeys):
+		return authentication.ErrMFANotEnrolled
+	default:
+		u.l.Errorf(ctx, "authentication.usecase.%s: %v", method, err)
+		return fmt.Errorf("%w: %v", authentication.ErrInternalSystem, err)
+	}
+}
@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"identity-srv/internal/authentication"
+	"identity-srv/internal/ratelimit"
+)
+
+// checkLockout refuses a callback while its email or IP is locked out
+func (u *ImplUsecase) checkLockout(ctx context.Context, email, ipAddress string) error {
+	if u.rateLimitUC == nil {
+		return nil
+	}
+	if err := u.rateLimitUC.CheckLockout(ctx, ratelimit.LockoutInput{Email: email, IPAddress: ipAddress}); err != nil {
+		return fmt.Errorf("%w: %w", authentication.ErrTooManyAttempts, err)
+	}
+	return nil
+}
+
+// allowEmail applies the route's per-email limit
+func (u *ImplUsecase) allowEmail(ctx context.Context, route, email string) error {
+	if u.rateLimitUC == nil {
+		return nil
+	}
+	if err := u.rateLimitUC.Allow(ctx, ratelimit.AllowInput{
+		Route:     route,
+		Dimension: ratelimit.DimensionEmail,
+		Subject:   email,
+	}); err != nil {
+		return fmt.Errorf("%w: %w", authentication.ErrTooManyAttempts, err)
+	}
+	return nil
+}
+
+// recordCallbackFailure counts a failed callback towards the lockout of its
+// email and IP. Refusals by the limiter itself and server-side errors do not
+// count, so a locked-out client cannot extend its lockout and an outage
+// cannot lock anyone out.
+func (u *ImplUsecase) recordCallbackFailure(ctx context.Context, email, ipAddress string, err error) {
+	if u.rateLimitUC == nil || err == nil {
+		return
+	}
+	if errors.Is(err, authentication.ErrTooManyAttempts) ||
+		errors.Is(err, authentication.ErrInternalSystem) ||
+		errors.Is(err, authentication.ErrConfigurationMissing) {
+		return
+	}
+	u.rateLimitUC.RecordFailure(ctx, ratelimit.LockoutInput{Email: email, IPAddress: ipAddress})
+}
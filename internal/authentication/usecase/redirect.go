@@ -4,52 +4,151 @@ import (
 	"fmt"
 	"identity-srv/internal/authentication"
 	"net/url"
+	"path"
 	"strings"
 )
 
-// ValidateRedirectURL validates if a redirect URL is in the allowed list
-// Returns error if URL is not allowed (prevents open redirect attacks)
-func (rv *RedirectValidator) ValidateRedirectURL(redirectURL string) error {
-	if redirectURL == "" {
-		return nil // Empty redirect is allowed (will use default)
+// redirectRule is one entry of access_control.allowed_redirect_urls:
+//   - "/dashboard": relative redirects under the path ("/" allows every path)
+//   - "https://app.example.com:8443/console": that scheme, host and port, under the path
+//   - "https://*.example.com": any subdomain of example.com, not example.com itself
+//   - "*.example.com" or "example.com": shorthand for the https form
+//
+// Without a port the scheme's default port is required. Paths match at a
+// segment boundary, so "/app" allows "/app/x" but not "/apple".
+type redirectRule struct {
+	scheme   string // empty for a relative rule
+	host     string // lowercase, without the "*."
+	wildcard bool
+	port     string
+	path     string
+}
+
+// newRedirectRule parses an allowlist entry
+func newRedirectRule(entry string) (redirectRule, error) {
+	entry = strings.TrimSpace(entry)
+	if strings.HasPrefix(entry, "/") && !strings.HasPrefix(entry, "//") {
+		if strings.ContainsAny(entry, "\\?#") || hasControlChar(entry) {
+			return redirectRule{}, fmt.Errorf("invalid relative redirect rule %q", entry)
+		}
+		return redirectRule{path: path.Clean(entry)}, nil
+	}
+	if !strings.Contains(entry, "://") {
+		entry = "https://" + entry
 	}
 
-	// Parse the redirect URL
-	parsedURL, err := url.Parse(redirectURL)
+	// url.Parse rejects "*" in a host, so the wildcard is taken off first
+	scheme, rest, _ := strings.Cut(entry, "://")
+	wildcard := strings.HasPrefix(rest, "*.")
+	if wildcard {
+		rest = strings.TrimPrefix(rest, "*.")
+	}
+	u, err := url.Parse(scheme + "://" + rest)
 	if err != nil {
-		return fmt.Errorf("%w: %v", authentication.ErrInvalidRedirectURL, err)
+		return redirectRule{}, fmt.Errorf("invalid redirect rule %q: %w", entry, err)
+	}
+
+	rule := redirectRule{
+		scheme:   strings.ToLower(u.Scheme),
+		host:     normalizeHost(u.Hostname()),
+		wildcard: wildcard,
+		port:     portOrDefault(u),
+		path:     path.Clean("/" + u.Path),
 	}
+	if (rule.scheme != "http" && rule.scheme != "https") || rule.host == "" || rule.port == "" ||
+		u.User != nil || u.RawQuery != "" || u.Fragment != "" {
+		return redirectRule{}, fmt.Errorf("invalid redirect rule %q", entry)
+	}
+	return rule, nil
+}
 
-	// Check if URL is relative (always allowed)
-	if parsedURL.Scheme == "" && parsedURL.Host == "" {
+// ValidateRedirectURL checks a redirect URL against the allowlist (prevents
+// open redirects). Relative URLs must start with a single "/" and match a
+// relative rule; absolute URLs must be http(s) and match an absolute rule on
+// scheme, host, port and path. Empty is allowed and means the default.
+func (rv *RedirectValidator) ValidateRedirectURL(redirectURL string) error {
+	if redirectURL == "" {
 		return nil
 	}
+	// Browsers read "\" as "/" and drop tabs and newlines, which turns values
+	// like "/\evil.com" into a protocol-relative URL
+	if strings.Contains(redirectURL, "\\") || hasControlChar(redirectURL) {
+		return fmt.Errorf("%w: %q", authentication.ErrInvalidRedirectURL, redirectURL)
+	}
 
-	// For absolute URLs, check against allowed list
-	redirectBase := fmt.Sprintf("%s://%s", parsedURL.Scheme, parsedURL.Host)
+	u, err := url.Parse(redirectURL)
+	if err != nil {
+		return fmt.Errorf("%w: %v", authentication.ErrInvalidRedirectURL, err)
+	}
 
-	for _, allowed := range rv.allowedURLs {
-		// Parse allowed URL
-		allowedURL, err := url.Parse(allowed)
-		if err != nil {
-			continue
+	// Relative: "/path" only, never "//host" or "path"
+	if u.Scheme == "" && u.Host == "" && u.Opaque == "" {
+		if !strings.HasPrefix(redirectURL, "/") || strings.HasPrefix(redirectURL, "//") {
+			return fmt.Errorf("%w: %q", authentication.ErrInvalidRedirectURL, redirectURL)
+		}
+		p := path.Clean(u.Path)
+		for _, rule := range rv.rules {
+			if rule.scheme == "" && pathHasPrefix(p, rule.path) {
+				return nil
+			}
 		}
+		return fmt.Errorf("%w: %s", authentication.ErrRedirectURLNotAllowed, redirectURL)
+	}
 
-		allowedBase := fmt.Sprintf("%s://%s", allowedURL.Scheme, allowedURL.Host)
+	scheme := strings.ToLower(u.Scheme)
+	host := normalizeHost(u.Hostname())
+	if (scheme != "http" && scheme != "https") || host == "" || u.User != nil || u.Opaque != "" {
+		return fmt.Errorf("%w: %q", authentication.ErrInvalidRedirectURL, redirectURL)
+	}
+	port := portOrDefault(u)
+	p := path.Clean("/" + u.Path)
 
-		// Exact match
-		if redirectBase == allowedBase {
+	for _, rule := range rv.rules {
+		if rule.scheme == scheme && rule.port == port && rule.matchHost(host) && pathHasPrefix(p, rule.path) {
 			return nil
 		}
+	}
+	return fmt.Errorf("%w: %s", authentication.ErrRedirectURLNotAllowed, redirectURL)
+}
 
-		// Wildcard subdomain match (e.g., *.example.com)
-		if strings.HasPrefix(allowed, "*.") {
-			domain := strings.TrimPrefix(allowed, "*.")
-			if strings.HasSuffix(parsedURL.Host, domain) {
-				return nil
-			}
-		}
+// matchHost compares hosts exactly, or for a wildcard rule requires at least
+// one more label: *.example.com matches a.example.com, not evil-example.com
+func (r redirectRule) matchHost(host string) bool {
+	if !r.wildcard {
+		return host == r.host
+	}
+	sub, ok := strings.CutSuffix(host, "."+r.host)
+	return ok && sub != "" && !strings.HasSuffix(sub, ".")
+}
+
+// pathHasPrefix matches prefix at a segment boundary; both are cleaned
+func pathHasPrefix(p, prefix string) bool {
+	if prefix == "/" || p == prefix {
+		return true
 	}
+	return strings.HasPrefix(p, prefix+"/")
+}
 
-	return fmt.Errorf("%w: %s", authentication.ErrRedirectURLNotAllowed, redirectURL)
+// normalizeHost lowercases a host and drops the trailing dot of a fully
+// qualified name, so "App.Example.com." matches "app.example.com"
+func normalizeHost(host string) string {
+	return strings.TrimSuffix(strings.ToLower(host), ".")
+}
+
+// portOrDefault returns the URL's port, or the scheme's default port
+func portOrDefault(u *url.URL) string {
+	if port := u.Port(); port != "" {
+		return port
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "http":
+		return "80"
+	case "https":
+		return "443"
+	}
+	return ""
+}
+
+func hasControlChar(s string) bool {
+	return strings.ContainsFunc(s, func(r rune) bool { return r < 0x20 || r == 0x7f })
 }
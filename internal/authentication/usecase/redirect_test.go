@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"errors"
+	"net/url"
+	"path"
+	"strings"
+	"testing"
+
+	"identity-srv/internal/authentication"
+)
+
+var testRedirectRules = []string{
+	"/dashboard",
+	"https://app.tantai.dev",
+	"https://*.tantai.dev/console",
+	"http://localhost:3000",
+}
+
+func newTestRedirectValidator(t testing.TB) *RedirectValidator {
+	t.Helper()
+	rv, err := NewRedirectValidator(testRedirectRules)
+	if err != nil {
+		t.Fatalf("NewRedirectValidator: %v", err)
+	}
+	return rv
+}
+
+func TestValidateRedirectURL(t *testing.T) {
+	rv := newTestRedirectValidator(t)
+
+	allowed := []string{
+		"",
+		"/dashboard",
+		"/dashboard/reports?range=7d#top",
+		"https://app.tantai.dev",
+		"https://APP.tantai.dev.:443/anything",
+		"https://a.tantai.dev/console",
+		"https://a.b.tantai.dev/console/users",
+		"http://localhost:3000/callback",
+	}
+	for _, raw := range allowed {
+		if err := rv.ValidateRedirectURL(raw); err != nil {
+			t.Errorf("ValidateRedirectURL(%q) = %v, want allowed", raw, err)
+		}
+	}
+
+	refused := []string{
+		"//evil.com",
+		"/\\evil.com",
+		"/\t/evil.com",
+		"dashboard",
+		"/dashboards",
+		"/dashboard/../admin",
+		"javascript:alert(1)",
+		"https:app.tantai.dev",
+		"http://app.tantai.dev",
+		"https://app.tantai.dev:8443",
+		"https://evil.com@app.tantai.dev",
+		"https://app.tantai.dev@evil.com",
+		"https://evil-tantai.dev/console",
+		"https://tantai.dev/console",
+		"https://a.tantai.dev/consoles",
+		"https://a.tantai.dev.evil.com/console",
+		"http://localhost:3001",
+	}
+	for _, raw := range refused {
+		err := rv.ValidateRedirectURL(raw)
+		if !errors.Is(err, authentication.ErrInvalidRedirectURL) && !errors.Is(err, authentication.ErrRedirectURLNotAllowed) {
+			t.Errorf("ValidateRedirectURL(%q) = %v, want refused", raw, err)
+		}
+	}
+}
+
+func TestNewRedirectValidatorRejectsBadRules(t *testing.T) {
+	for _, rule := range []string{"//evil.com", "ftp://files.tantai.dev", "https://", "/a?b", "https://user@tantai.dev"} {
+		if _, err := NewRedirectValidator([]string{rule}); err == nil {
+			t.Errorf("NewRedirectValidator(%q) should fail", rule)
+		}
+	}
+}
+
+// FuzzValidateRedirectURL checks that whatever is accepted stays on an allowed
+// origin and path, the way a browser would resolve it
+func FuzzValidateRedirectURL(f *testing.F) {
+	for _, seed := range []string{
+		"/dashboard", "//evil.com", "/\\evil.com", "https://a.tantai.dev/console",
+		"https://evil-tantai.dev/console", "https://app.tantai.dev@evil.com", "http://localhost:3000",
+		"/dashboard/%2e%2e/admin", "HTTPS://APP.TANTAI.DEV", "https://[::1]/", "/%2F%2Fevil.com",
+	} {
+		f.Add(seed)
+	}
+	rv := newTestRedirectValidator(f)
+
+	f.Fuzz(func(t *testing.T, raw string) {
+		if rv.ValidateRedirectURL(raw) != nil || raw == "" {
+			return
+		}
+		if strings.ContainsAny(raw, "\\\t\r\n") {
+			t.Fatalf("accepted %q with a character browsers rewrite", raw)
+		}
+		u, err := url.Parse(raw)
+		if err != nil {
+			t.Fatalf("accepted unparsable %q: %v", raw, err)
+		}
+
+		if u.Scheme == "" {
+			if !strings.HasPrefix(raw, "/") || strings.HasPrefix(raw, "//") || u.Host != "" {
+				t.Fatalf("accepted %q that is not a same-origin path", raw)
+			}
+			if p := path.Clean(u.Path); p != "/dashboard" && !strings.HasPrefix(p, "/dashboard/") {
+				t.Fatalf("accepted %q outside /dashboard", raw)
+			}
+			return
+		}
+
+		if u.User != nil {
+			t.Fatalf("accepted %q with user info", raw)
+		}
+		host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+		switch {
+		case host == "app.tantai.dev", host == "localhost":
+		case strings.HasSuffix(host, ".tantai.dev") && !strings.HasPrefix(host, "."):
+			if p := path.Clean("/" + u.Path); p != "/console" && !strings.HasPrefix(p, "/console/") {
+				t.Fatalf("accepted %q outside /console", raw)
+			}
+		default:
+			t.Fatalf("accepted %q on host %q", raw, host)
+		}
+	})
+}
@@ -11,6 +11,12 @@ func (rm *RoleMapper) MapEmailToRole(email string) string {
 	return rm.defaultRole
 }
 
+// UserRole returns the role configured for the email, if any
+func (rm *RoleMapper) UserRole(email string) (string, bool) {
+	role, ok := rm.userRoles[strings.ToLower(strings.TrimSpace(email))]
+	return role, ok
+}
+
 // GetUserRoles returns the current user roles configuration
 func (rm *RoleMapper) GetUserRoles() map[string]string {
 	return rm.userRoles
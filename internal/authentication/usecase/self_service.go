@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"identity-srv/internal/authentication"
+	"time"
+)
+
+// AuthorizeSelfService checks the token of a request that manages the user's
+// own credentials (personal access tokens, passkeys, MFA, logout-all). The
+// shared auth middleware only checks the signature and expiry; a token revoked
+// by logout, logout-all or an admin must not be able to mint new credentials,
+// and neither may an admin impersonating the user or a service holding an
+// exchanged token.
+func (u *ImplUsecase) AuthorizeSelfService(ctx context.Context, input authentication.AuthorizeSelfServiceInput) error {
+	var maxAge *time.Duration
+	if input.RecentLogin {
+		maxAge = &u.passkeyMaxAge
+	}
+	result, err := u.ValidateToken(ctx, authentication.ValidateTokenInput{Token: input.Token, MaxAge: maxAge})
+	if err != nil {
+		u.l.Errorf(ctx, "authentication.usecase.AuthorizeSelfService.ValidateToken: %v", err)
+		return err
+	}
+	if err := checkSelfService(result, u.applications); err != nil {
+		return err
+	}
+
+	// The session records impersonation too, for tokens whose claims do not
+	// say so. Personal access tokens have no session.
+	if u.sessionManager != nil {
+		if claims, err := parseClaims(input.Token); err == nil && claims.Id != "" {
+			session, err := u.sessionManager.GetSession(ctx, claims.Id)
+			if err == nil && session.Impersonated {
+				u.l.Warnf(ctx, "authentication.usecase.AuthorizeSelfService: impersonated session %s refused", claims.Id)
+				return authentication.ErrImpersonatedToken
+			}
+		}
+	}
+
+	if input.RecentLogin {
+		required, _, err := u.mfaRequirement(ctx, result.UserID, result.Role)
+		if err != nil {
+			return err
+		}
+		if err := checkRecentLogin(result, required); err != nil {
+			u.l.Warnf(ctx, "authentication.usecase.AuthorizeSelfService: user %s needs a recent login (acr=%q)", result.UserID, result.ACR)
+			return err
+		}
+	}
+	return nil
+}
+
+// checkSelfService decides from a validated token whether it may manage the
+// user's credentials. Only the user's own logins may: tokens exchanged for a
+// service, or carrying any audience but a registered application's login
+// audience, are narrowed to that service.
+func checkSelfService(result *authentication.TokenValidationResult, apps *ApplicationRegistry) error {
+	if result == nil || !result.Valid {
+		return authentication.ErrInvalidToken
+	}
+	if result.Actor != nil {
+		if result.Actor.ClientID != "" {
+			return authentication.ErrScopedToken
+		}
+		return authentication.ErrImpersonatedToken
+	}
+	if result.Audience != "" && !apps.isLoginAudience(result.Audience) {
+		return authentication.ErrScopedToken
+	}
+	return nil
+}
+
+// checkRecentLogin decides whether a token checked against a max age proves
+// a fresh login, made with a second factor when the user has one. Tokens
+// without auth_time, such as personal access tokens, never do.
+func checkRecentLogin(result *authentication.TokenValidationResult, mfaRequired bool) error {
+	if result.ReauthRequired {
+		return authentication.ErrReauthRequired
+	}
+	if mfaRequired && result.ACR != authentication.ACRMultiFactor {
+		return authentication.ErrReauthRequired
+	}
+	return nil
+}
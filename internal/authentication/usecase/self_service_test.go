@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"identity-srv/internal/authentication"
+)
+
+func TestCheckSelfService(t *testing.T) {
+	apps := &ApplicationRegistry{applications: map[string]application{
+		"smap-admin": {clientID: "smap-admin", audience: "smap-admin"},
+	}}
+
+	tests := []struct {
+		name   string
+		result *authentication.TokenValidationResult
+		want   error
+	}{
+		{
+			name:   "login token",
+			result: &authentication.TokenValidationResult{Valid: true, UserID: "u1"},
+		},
+		{
+			name:   "invalid or revoked token",
+			result: &authentication.TokenValidationResult{Valid: false},
+			want:   authentication.ErrInvalidToken,
+		},
+		{
+			name:   "no result",
+			result: nil,
+			want:   authentication.ErrInvalidToken,
+		},
+		{
+			name: "impersonation token",
+			result: &authentication.TokenValidationResult{
+				Valid:  true,
+				UserID: "u1",
+				Actor:  &authentication.Actor{UserID: "admin1", Email: "admin@tantai.dev"},
+			},
+			want: authentication.ErrImpersonatedToken,
+		},
+		{
+			name: "exchanged token",
+			result: &authentication.TokenValidationResult{
+				Valid:    true,
+				UserID:   "u1",
+				Audience: "report-srv",
+				Actor:    &authentication.Actor{UserID: "svc1", ClientID: "svc1"},
+			},
+			want: authentication.ErrScopedToken,
+		},
+		{
+			name:   "application login token",
+			result: &authentication.TokenValidationResult{Valid: true, UserID: "u1", Audience: "smap-admin"},
+		},
+		{
+			name:   "token for another audience",
+			result: &authentication.TokenValidationResult{Valid: true, UserID: "u1", Audience: "report-srv"},
+			want:   authentication.ErrScopedToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkSelfService(tt.result, apps)
+			if !errors.Is(err, tt.want) || (tt.want == nil && err != nil) {
+				t.Fatalf("checkSelfService() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckRecentLogin(t *testing.T) {
+	tests := []struct {
+		name        string
+		result      *authentication.TokenValidationResult
+		mfaRequired bool
+		want        error
+	}{
+		{
+			name:   "recent single-factor login without a second factor",
+			result: &authentication.TokenValidationResult{Valid: true, ACR: authentication.ACRSingleFactor},
+		},
+		{
+			name:        "recent multi-factor login",
+			result:      &authentication.TokenValidationResult{Valid: true, ACR: authentication.ACRMultiFactor},
+			mfaRequired: true,
+		},
+		{
+			name:        "recent single-factor login with a second factor enrolled",
+			result:      &authentication.TokenValidationResult{Valid: true, ACR: authentication.ACRSingleFactor},
+			mfaRequired: true,
+			want:        authentication.ErrReauthRequired,
+		},
+		{
+			name:   "old login",
+			result: &authentication.TokenValidationResult{Valid: true, ACR: authentication.ACRMultiFactor, ReauthRequired: true},
+			want:   authentication.ErrReauthRequired,
+		},
+		{
+			name:        "token without acr",
+			result:      &authentication.TokenValidationResult{Valid: true},
+			mfaRequired: true,
+			want:        authentication.ErrReauthRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkRecentLogin(tt.result, tt.mfaRequired)
+			if !errors.Is(err, tt.want) || (tt.want == nil && err != nil) {
+				t.Fatalf("checkRecentLogin() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -1 +1,44 @@
 package usecase
+
+import (
+	"identity-srv/internal/authentication"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/smap-hcmut/shared-libs/go/auth"
+)
+
+// tokenClaims extends the shared auth.Payload with claims auth.Manager cannot set.
+// Tokens are signed with the same HS256 key, so auth.Manager and every
+// service's middleware accept them as ordinary access tokens.
+type tokenClaims struct {
+	auth.Payload
+	Act      *authentication.Actor `json:"act,omitempty"`
+	AuthTime int64                 `json:"auth_time,omitempty"`
+	AMR      []string              `json:"amr,omitempty"`
+	ACR      string                `json:"acr,omitempty"`
+}
+
+// loginAuth describes how the user authenticated for a login token
+type loginAuth struct {
+	Time     time.Time
+	AMR      []string
+	ACR      string
+	Audience string // of the application logged in to, empty for every service
+}
+
+// mfaChallengeClaims carry a half-finished login between the OAuth callback and
+// the TOTP check. They are signed with a key derived from the JWT secret, so a
+// challenge is never accepted as an access token.
+type mfaChallengeClaims struct {
+	jwt.StandardClaims
+	Type       string   `json:"type"`
+	Role       string   `json:"role"`
+	RememberMe bool     `json:"remember_me,omitempty"`
+	Redirect   string   `json:"redirect,omitempty"`
+	ClientID   string   `json:"client_id,omitempty"` // application the login was started for
+	Enroll     bool     `json:"enroll,omitempty"`    // the user has no confirmed factor yet
+	Methods    []string `json:"methods,omitempty"`
+	AuthTime   int64    `json:"auth_time,omitempty"` // of the first factor
+	AMR        []string `json:"amr,omitempty"`       // of the first factor
+}
@@ -2,10 +2,15 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"identity-srv/internal/accessrequest"
 	"identity-srv/internal/authentication"
+	"identity-srv/internal/authentication/repository"
+	"identity-srv/internal/invitation"
 	"identity-srv/internal/model"
 	"identity-srv/internal/user"
+	"net/url"
 	"strings"
 	"time"
 
@@ -39,6 +44,74 @@ func (u *ImplUsecase) isBlockedEmail(email string) bool {
 	return false
 }
 
+// isAllowedEmail checks the domain allowlist; an invited address, or one whose
+// access request was approved, passes whatever its domain
+func (u *ImplUsecase) isAllowedEmail(ctx context.Context, email string) bool {
+	if u.isAllowedDomain(email) {
+		return true
+	}
+	if _, ok := u.findInvitation(ctx, email); ok {
+		return true
+	}
+	_, ok := u.findApprovedAccess(ctx, email)
+	return ok
+}
+
+// findInvitation returns the invitation that lets the address log in, if any.
+// A lookup error counts as no invitation.
+func (u *ImplUsecase) findInvitation(ctx context.Context, email string) (model.Invitation, bool) {
+	if u.invitationUC == nil {
+		return model.Invitation{}, false
+	}
+	inv, err := u.invitationUC.Find(ctx, normalizeAccessControlValue(email))
+	if err != nil {
+		if !errors.Is(err, invitation.ErrInvitationNotFound) {
+			u.l.Errorf(ctx, "authentication.usecase.findInvitation: %v", err)
+		}
+		return model.Invitation{}, false
+	}
+	return inv, true
+}
+
+// findApprovedAccess returns the approved access request of the address, if
+// any. A lookup error counts as no approval.
+func (u *ImplUsecase) findApprovedAccess(ctx context.Context, email string) (model.AccessRequest, bool) {
+	if u.accessRequestUC == nil {
+		return model.AccessRequest{}, false
+	}
+	request, err := u.accessRequestUC.FindApproved(ctx, email)
+	if err != nil {
+		if !errors.Is(err, accessrequest.ErrRequestNotFound) {
+			u.l.Errorf(ctx, "authentication.usecase.findApprovedAccess: %v", err)
+		}
+		return model.AccessRequest{}, false
+	}
+	return request, true
+}
+
+// refuseDomain answers a login refused by the domain allowlist. With access
+// requests enabled it files one, and tells the user while it is pending.
+func (u *ImplUsecase) refuseDomain(ctx context.Context, email, name string, input authentication.OAuthCallbackInput) error {
+	if u.accessRequestUC == nil || u.isBlockedEmail(email) {
+		return authentication.ErrDomainNotAllowed
+	}
+
+	request, err := u.accessRequestUC.Submit(ctx, accessrequest.SubmitInput{
+		Email:     email,
+		Name:      name,
+		IPAddress: input.IPAddress,
+		UserAgent: input.UserAgent,
+	})
+	if err != nil {
+		u.l.Errorf(ctx, "authentication.usecase.refuseDomain.Submit: %v", err)
+		return authentication.ErrDomainNotAllowed
+	}
+	if request.Status == model.AccessRequestStatusPending {
+		return authentication.ErrAccessPending
+	}
+	return authentication.ErrDomainNotAllowed
+}
+
 // extractDomain extracts domain from email address
 func (u *ImplUsecase) extractDomain(email string) string {
 	parts := strings.SplitN(normalizeAccessControlValue(email), "@", 2)
@@ -64,6 +137,17 @@ func (u *ImplUsecase) createOrUpdateUser(ctx context.Context, email, name, avata
 		return nil, fmt.Errorf("%w: %v", authentication.ErrUserCreation, err)
 	}
 	u.l.Infof(ctx, "User created/updated: ID=%s Email=%s", usr.ID, usr.Email)
+
+	// The first login of an invited address consumes its invitation
+	if u.invitationUC != nil {
+		_, err := u.invitationUC.Accept(ctx, invitation.AcceptInput{
+			Email:  normalizeAccessControlValue(usr.Email),
+			UserID: usr.ID,
+		})
+		if err != nil && !errors.Is(err, invitation.ErrInvitationNotFound) {
+			u.l.Errorf(ctx, "authentication.usecase.createOrUpdateUser.Accept: %v", err)
+		}
+	}
 	return &usr, nil
 }
 
@@ -75,16 +159,31 @@ func (u *ImplUsecase) updateUserRole(ctx context.Context, userID, role string) e
 	})
 }
 
-// mapEmailToRole maps email to a role using the role mapper
-func (u *ImplUsecase) mapEmailToRole(email string) string {
+// mapEmailToRole maps email to a role: access_control.user_roles first, then
+// the role of the address's invitation or approved access request, then the
+// default role
+func (u *ImplUsecase) mapEmailToRole(ctx context.Context, email string) string {
+	if u.roleMapper != nil {
+		if role, ok := u.roleMapper.UserRole(email); ok {
+			return role
+		}
+	}
+	if inv, ok := u.findInvitation(ctx, email); ok {
+		return inv.Role
+	}
+	if request, ok := u.findApprovedAccess(ctx, email); ok {
+		return *request.Role
+	}
 	if u.roleMapper == nil {
 		return "VIEWER"
 	}
-	return u.roleMapper.MapEmailToRole(email)
+	return u.roleMapper.GetDefaultRole()
 }
 
-// generateToken generates a JWT and extracts the JTI
-func (u *ImplUsecase) generateToken(ctx context.Context, usr *model.User, role string, groups []string) (string, string, error) {
+// generateToken generates a JWT and extracts the JTI. auth.Manager sets the
+// standard claims; the token is then re-signed with the same key to record how
+// the user authenticated (auth_time, amr, acr).
+func (u *ImplUsecase) generateToken(ctx context.Context, usr *model.User, role string, groups []string, authn loginAuth) (string, string, error) {
 	if u.jwtManager == nil {
 		return "", "", fmt.Errorf("jwt manager not configured")
 	}
@@ -109,32 +208,138 @@ func (u *ImplUsecase) generateToken(ctx context.Context, usr *model.User, role s
 		return "", "", err
 	}
 
+	if authn.Audience != "" {
+		verifiedPayload.Audience = authn.Audience
+	}
+	token, err = u.signClaims(tokenClaims{
+		Payload:  verifiedPayload,
+		AuthTime: authn.Time.Unix(),
+		AMR:      authn.AMR,
+		ACR:      authn.ACR,
+	})
+	if err != nil {
+		u.l.Errorf(ctx, "authentication.usecase.generateToken.signClaims: %v", err)
+		return "", "", err
+	}
+
 	return token, verifiedPayload.Id, nil
 }
 
-// createSession creates a session in Redis
-func (u *ImplUsecase) createSession(ctx context.Context, userID, jti string, rememberMe bool) error {
+// issueLoginToken generates a login JWT, records its session and audits the login
+func (u *ImplUsecase) issueLoginToken(ctx context.Context, usr *model.User, role string, groups []string, rememberMe bool, authn loginAuth) (string, error) {
+	u.l.Debugf(ctx, "Generating JWT token")
+	token, jti, err := u.generateToken(ctx, usr, role, groups, authn)
+	if err != nil {
+		return "", err
+	}
+
+	if err := u.createSession(ctx, repository.CreateSessionOptions{
+		UserID:     usr.ID,
+		JTI:        jti,
+		RememberMe: rememberMe,
+	}); err != nil {
+		return "", err
+	}
+
+	u.recordLogin(ctx, usr, role, rememberMe, authn)
+	return token, nil
+}
+
+// createSession records the session of a newly issued token
+func (u *ImplUsecase) createSession(ctx context.Context, opts repository.CreateSessionOptions) error {
 	if u.sessionManager == nil {
 		return nil
 	}
-	return u.sessionManager.CreateSession(ctx, userID, jti, rememberMe)
+	return u.sessionManager.CreateSession(ctx, opts)
+}
+
+// revokeCurrentToken blacklists a single token until its expiry and drops its session.
+// Without a blacklist only the session is removed.
+func (u *ImplUsecase) revokeCurrentToken(ctx context.Context, jti string, expiresAt int64) error {
+	if u.blacklistManager != nil {
+		exp := time.Unix(expiresAt, 0)
+		if expiresAt == 0 {
+			exp = u.clock().Add(u.tokenTTL)
+		}
+		if err := u.blacklistManager.AddToken(ctx, jti, exp); err != nil {
+			return err
+		}
+	}
+
+	if u.sessionManager != nil {
+		if err := u.sessionManager.DeleteSession(ctx, jti); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// appendQueryParam sets a query parameter on a relative or absolute URL
+func appendQueryParam(rawURL, key, value string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	q := parsed.Query()
+	q.Set(key, value)
+	parsed.RawQuery = q.Encode()
+	return parsed.String()
 }
 
-// revokeAllUserTokensInternal internal helper
+// revokeAllUserTokensInternal rejects every token of the user issued until now.
+// The watermark is always persisted on the user row, and mirrored to the
+// blacklist backend when enabled, so tokens missing from the session list are
+// revoked as well.
 func (u *ImplUsecase) revokeAllUserTokensInternal(ctx context.Context, userID string) error {
-	if u.sessionManager == nil || u.blacklistManager == nil {
+	if u.sessionManager == nil {
 		return authentication.ErrConfigurationMissing
 	}
 
-	jtis, err := u.sessionManager.GetAllUserSessions(ctx, userID)
-	if err != nil {
-		return err
+	// iat has second precision; round up so tokens issued earlier in this second are covered.
+	// A login in the same second is rejected too: the client must log in again
+	// after the watermark, at most one second later.
+	now := u.clock()
+	before := now.Truncate(time.Second).Add(time.Second)
+
+	if err := u.userUC.RevokeTokens(ctx, user.RevokeTokensInput{
+		UserID: userID,
+		Before: before,
+	}); err != nil {
+		u.l.Errorf(ctx, "authentication.usecase.revokeAllUserTokensInternal.RevokeTokens: %v", err)
+		return fmt.Errorf("%w: %v", authentication.ErrInternalSystem, err)
 	}
 
-	expiresAt := u.clock().Add(7 * 24 * time.Hour)
-	if err := u.blacklistManager.AddAllUserTokens(ctx, jtis, expiresAt); err != nil {
-		return err
+	// No token issued before the watermark outlives the longest token TTL
+	if u.blacklistManager != nil {
+		if err := u.blacklistManager.SetRevokedBefore(ctx, userID, before, now.Add(u.tokenTTL)); err != nil {
+			u.l.Errorf(ctx, "authentication.usecase.revokeAllUserTokensInternal.SetRevokedBefore: %v", err)
+			return err
+		}
 	}
 
 	return u.sessionManager.DeleteUserSessions(ctx, userID)
 }
+
+// isRevokedByWatermark reports whether the token was issued before the user's
+// revoked_before. The blacklist mirror is read when enabled; otherwise the user
+// row, which also refuses tokens of users that no longer exist.
+func (u *ImplUsecase) isRevokedByWatermark(ctx context.Context, userID string, issuedAt int64) (bool, error) {
+	if u.blacklistManager == nil {
+		usr, err := u.userUC.Detail(ctx, userID)
+		if err != nil {
+			u.l.Warnf(ctx, "authentication.usecase.isRevokedByWatermark.Detail: %v", err)
+			return true, nil
+		}
+		return usr.TokensRevokedBefore != nil && issuedAt < usr.TokensRevokedBefore.Unix(), nil
+	}
+
+	before, err := u.blacklistManager.GetRevokedBefore(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	if before.IsZero() {
+		return false, nil
+	}
+	return issuedAt < before.Unix(), nil
+}
@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"identity-srv/internal/accessrequest"
+	"identity-srv/internal/invitation"
+	"identity-srv/internal/model"
+)
+
+// revocableInvitations answers Find like the invitation usecase: the
+// invitation grants access until it is revoked.
+type revocableInvitations struct {
+	invitation.UseCase
+	inv     model.Invitation
+	revoked bool
+}
+
+func (r *revocableInvitations) Find(_ context.Context, email string) (model.Invitation, error) {
+	if r.revoked || email != r.inv.Email {
+		return model.Invitation{}, invitation.ErrInvitationNotFound
+	}
+	return r.inv, nil
+}
+
+// approvedRequests answers FindApproved like the access request usecase: the
+// request lets the address in while it is approved.
+type approvedRequests struct {
+	accessrequest.UseCase
+	request model.AccessRequest
+}
+
+func (a *approvedRequests) FindApproved(_ context.Context, email string) (model.AccessRequest, error) {
+	if email != a.request.Email || a.request.Status != model.AccessRequestStatusApproved {
+		return model.AccessRequest{}, accessrequest.ErrRequestNotFound
+	}
+	return a.request, nil
+}
+
+func TestRevokedInvitationAtNextLogin(t *testing.T) {
+	ctx := context.Background()
+	invitations := &revocableInvitations{inv: model.Invitation{ID: "inv", Email: "guest@example.com", Role: "ANALYST"}}
+	u := &ImplUsecase{l: testLogger{}}
+	u.SetAccessControl([]string{"tantai.dev"}, nil)
+	u.SetInvitation(invitations)
+
+	if !u.isAllowedEmail(ctx, "Guest@Example.com") {
+		t.Fatal("isAllowedEmail() = false for an invited address outside the allowed domains")
+	}
+	if role := u.mapEmailToRole(ctx, "Guest@Example.com"); role != "ANALYST" {
+		t.Fatalf("mapEmailToRole() = %q, want the invited role ANALYST", role)
+	}
+
+	invitations.revoked = true
+	if u.isAllowedEmail(ctx, "Guest@Example.com") {
+		t.Error("isAllowedEmail() = true after the invitation was revoked")
+	}
+	if role := u.mapEmailToRole(ctx, "Guest@Example.com"); role != "VIEWER" {
+		t.Errorf("mapEmailToRole() after revoke = %q, want the default VIEWER", role)
+	}
+}
+
+func TestApprovedAccessRequestRole(t *testing.T) {
+	ctx := context.Background()
+	role := model.RoleAnalyst
+	requests := &approvedRequests{request: model.AccessRequest{
+		ID:     "req",
+		Email:  "guest@example.com",
+		Status: model.AccessRequestStatusApproved,
+		Role:   &role,
+	}}
+	u := &ImplUsecase{l: testLogger{}}
+	u.SetAccessControl([]string{"tantai.dev"}, nil)
+	u.SetAccessRequest(requests)
+
+	if !u.isAllowedEmail(ctx, "guest@example.com") {
+		t.Fatal("isAllowedEmail() = false for an approved address outside the allowed domains")
+	}
+	if got := u.mapEmailToRole(ctx, "guest@example.com"); got != model.RoleAnalyst {
+		t.Fatalf("mapEmailToRole() = %q, want the approved role %s", got, model.RoleAnalyst)
+	}
+
+	requests.request.Status = model.AccessRequestStatusDenied
+	requests.request.Role = nil
+	if u.isAllowedEmail(ctx, "guest@example.com") {
+		t.Error("isAllowedEmail() = true after the request was denied")
+	}
+	if got := u.mapEmailToRole(ctx, "guest@example.com"); got != "VIEWER" {
+		t.Errorf("mapEmailToRole() after deny = %q, want the default VIEWER", got)
+	}
+}
@@ -0,0 +1,86 @@
+package httpserver
+
+import (
+	"fmt"
+	"time"
+
+	"identity-srv/config"
+	"identity-srv/internal/authentication/repository"
+	authmemory "identity-srv/internal/authentication/repository/memory"
+	authpostgres "identity-srv/internal/authentication/repository/postgre"
+	authredis "identity-srv/internal/authentication/repository/redis"
+)
+
+// newSessionManager builds the session store selected by session.backend.
+func newSessionManager(cfg Config) (repository.SessionManager, error) {
+	ttl := time.Duration(cfg.Config.Session.TTL) * time.Second
+	rememberMeTTL := time.Duration(cfg.Config.Session.RememberMeTTL) * time.Second
+
+	switch cfg.Config.Session.Backend {
+	case config.BackendRedis:
+		if cfg.RedisClient == nil {
+			return nil, fmt.Errorf("session.backend is redis but redisClient is nil")
+		}
+		return authredis.NewSessionManager(cfg.Logger, cfg.RedisClient, cfg.Config.Session.KeyPrefix, ttl, rememberMeTTL), nil
+	case config.BackendPostgres:
+		return authpostgres.NewSessionManager(cfg.Logger, cfg.PostgresDB, ttl, rememberMeTTL), nil
+	case config.BackendMemory:
+		return authmemory.NewSessionManager(ttl, rememberMeTTL), nil
+	default:
+		return nil, fmt.Errorf("unsupported session.backend: %q", cfg.Config.Session.Backend)
+	}
+}
+
+// newBlacklistManager builds the blacklist store selected by blacklist.backend.
+// It returns nil when blacklist.enabled is false.
+func newBlacklistManager(cfg Config) (repository.BlacklistManager, error) {
+	if !cfg.Config.Blacklist.Enabled {
+		return nil, nil
+	}
+
+	manager, err := newBlacklistStore(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Announce revocations so downstream services can cache them in-process
+	if channel := cfg.Config.Blacklist.EventChannel; channel != "" {
+		if cfg.RedisClient == nil {
+			return nil, fmt.Errorf("blacklist.event_channel is set but redisClient is nil")
+		}
+		manager = authredis.NewPublishingBlacklistManager(cfg.Logger, manager, cfg.RedisClient, channel)
+	}
+
+	return manager, nil
+}
+
+func newBlacklistStore(cfg Config) (repository.BlacklistManager, error) {
+	switch cfg.Config.Blacklist.Backend {
+	case config.BackendRedis:
+		if cfg.RedisClient == nil {
+			return nil, fmt.Errorf("blacklist.backend is redis but redisClient is nil")
+		}
+		return authredis.NewBlacklistManager(cfg.RedisClient, cfg.Config.Blacklist.KeyPrefix), nil
+	case config.BackendPostgres:
+		return authpostgres.NewBlacklistManager(cfg.Logger, cfg.PostgresDB), nil
+	case config.BackendMemory:
+		return authmemory.NewBlacklistManager(), nil
+	default:
+		return nil, fmt.Errorf("unsupported blacklist.backend: %q", cfg.Config.Blacklist.Backend)
+	}
+}
+
+// newLoginCodeStore builds the login code store selected by login_code.backend.
+func newLoginCodeStore(cfg Config) (repository.LoginCodeStore, error) {
+	switch cfg.Config.LoginCode.Backend {
+	case config.BackendRedis:
+		if cfg.RedisClient == nil {
+			return nil, fmt.Errorf("login_code.backend is redis but redisClient is nil")
+		}
+		return authredis.NewLoginCodeStore(cfg.RedisClient, cfg.Config.LoginCode.KeyPrefix), nil
+	case config.BackendMemory:
+		return authmemory.NewLoginCodeStore(), nil
+	default:
+		return nil, fmt.Errorf("unsupported login_code.backend: %q", cfg.Config.LoginCode.Backend)
+	}
+}
@@ -3,12 +3,67 @@ package httpserver
 import (
 	"context"
 	"fmt"
+	"identity-srv/config"
+	accessrequesthttp "identity-srv/internal/accessrequest/delivery/http"
+	accessrequestrepository "identity-srv/internal/accessrequest/repository/postgre"
+	accessrequestusecase "identity-srv/internal/accessrequest/usecase"
+	accesstokenhttp "identity-srv/internal/accesstoken/delivery/http"
+	accesstokenrepository "identity-srv/internal/accesstoken/repository/postgre"
+	accesstokenusecase "identity-srv/internal/accesstoken/usecase"
+	audithttp "identity-srv/internal/audit/delivery/http"
+	auditrepository "identity-srv/internal/audit/repository/postgre"
+	auditusecase "identity-srv/internal/audit/usecase"
 	authhttp "identity-srv/internal/authentication/delivery/http"
 	authusecase "identity-srv/internal/authentication/usecase"
+	internalkeyrepo "identity-srv/internal/internalkey/repository"
+	internalkeyrepository "identity-srv/internal/internalkey/repository/postgre"
+	internalkeyusecase "identity-srv/internal/internalkey/usecase"
+	invitationhttp "identity-srv/internal/invitation/delivery/http"
+	invitationrepository "identity-srv/internal/invitation/repository/postgre"
+	invitationusecase "identity-srv/internal/invitation/usecase"
+	magiclinkrepository "identity-srv/internal/magiclink/repository/postgre"
+	magiclinkusecase "identity-srv/internal/magiclink/usecase"
+	mfahttp "identity-srv/internal/mfa/delivery/http"
+	mfarepository "identity-srv/internal/mfa/repository/postgre"
+	mfausecase "identity-srv/internal/mfa/usecase"
+	internalmw "identity-srv/internal/middleware"
 	"identity-srv/internal/model"
+	"identity-srv/internal/outbox"
+	outboxjob "identity-srv/internal/outbox/delivery/job"
+	outboxrepository "identity-srv/internal/outbox/repository/postgre"
+	outboxusecase "identity-srv/internal/outbox/usecase"
+	passkeyhttp "identity-srv/internal/passkey/delivery/http"
+	passkeymemory "identity-srv/internal/passkey/repository/memory"
+	passkeyrepository "identity-srv/internal/passkey/repository/postgre"
+	passkeyredis "identity-srv/internal/passkey/repository/redis"
+	passkeyusecase "identity-srv/internal/passkey/usecase"
+	"identity-srv/internal/ratelimit"
+	ratelimithttp "identity-srv/internal/ratelimit/delivery/http"
+	ratelimitmemory "identity-srv/internal/ratelimit/repository/memory"
+	ratelimitredis "identity-srv/internal/ratelimit/repository/redis"
+	ratelimitusecase "identity-srv/internal/ratelimit/usecase"
+	"identity-srv/internal/securityalert"
+	securityalertmemory "identity-srv/internal/securityalert/repository/memory"
+	securityalertredis "identity-srv/internal/securityalert/repository/redis"
+	securityalertusecase "identity-srv/internal/securityalert/usecase"
+	"identity-srv/internal/serviceaccount"
+	serviceaccounthttp "identity-srv/internal/serviceaccount/delivery/http"
+	serviceaccountrepository "identity-srv/internal/serviceaccount/repository/postgre"
+	serviceaccountusecase "identity-srv/internal/serviceaccount/usecase"
 	userrepository "identity-srv/internal/user/repository/postgre"
 	userusecase "identity-srv/internal/user/usecase"
+	"identity-srv/internal/webhook"
+	webhookhttp "identity-srv/internal/webhook/delivery/http"
+	webhookjob "identity-srv/internal/webhook/delivery/job"
+	webhookpublisher "identity-srv/internal/webhook/delivery/publisher"
+	webhookrepository "identity-srv/internal/webhook/repository/postgre"
+	webhookusecase "identity-srv/internal/webhook/usecase"
+	"identity-srv/pkg/alert"
+	"identity-srv/pkg/mailer"
+	"identity-srv/pkg/notifier"
 	"identity-srv/pkg/oauth"
+	"identity-srv/pkg/publisher"
+	"time"
 
 	"github.com/smap-hcmut/shared-libs/go/auth"
 	"github.com/smap-hcmut/shared-libs/go/middleware"
@@ -30,18 +85,190 @@ func (srv HTTPServer) mapHandlers() error {
 	srv.registerSystemRoutes()
 
 	// Initialize repositories
-	userRepo := userrepository.New(srv.l, srv.postgresDB)
+	userRepo := userrepository.New(srv.l, srv.postgresDB, srv.config.Outbox.Enabled)
+	accessTokenRepo := accesstokenrepository.New(srv.l, srv.postgresDB)
+	auditRepo := auditrepository.New(srv.l, srv.postgresDB)
 
 	// Initialize usecases
 	userUC := userusecase.New(srv.l, srv.encrypter, userRepo)
+	accessTokenUC := accesstokenusecase.New(srv.l, accessTokenRepo, srv.config.AccessToken)
+	auditUC := auditusecase.New(srv.l, auditRepo)
 
 	// Initialize authentication usecase - use scope manager from shared-libs
 	scopeManager := auth.NewManager(srv.config.JWT.SecretKey)
 	authUC := authusecase.New(srv.l, scopeManager, srv.encrypter, userUC)
 	authUC.SetSessionManager(srv.sessionManager)
 	authUC.SetBlacklistManager(srv.blacklistManager)
+	authUC.SetLoginCodeStore(srv.loginCodeStore, time.Duration(srv.config.LoginCode.TTL)*time.Second)
 	authUC.SetJWTManager(srv.jwtManager)
+	authUC.SetTokenTTL(srv.maxTokenTTL())
+	authUC.SetTokenSigner(srv.config.JWT.SecretKey, srv.config.JWT.Issuer)
+	authUC.SetImpersonationTTL(time.Duration(srv.config.Impersonation.TTL) * time.Second)
 	authUC.SetRoleMapper(srv.roleMapper)
+	authUC.SetAccessTokenUseCase(accessTokenUC)
+	authUC.SetAudit(auditUC)
+
+	// Security alerts are optional; they watch the same events as the audit log
+	if srv.config.SecurityAlert.Enabled {
+		sink, err := alert.New(srv.config.SecurityAlert.Sink, srv.l, srv.discord)
+		if err != nil {
+			return fmt.Errorf("failed to initialize alert sink: %w", err)
+		}
+		store := securityalertmemory.New()
+		if srv.config.SecurityAlert.Backend == config.BackendRedis {
+			store = securityalertredis.New(srv.redisClient, srv.config.SecurityAlert.KeyPrefix)
+		}
+		authUC.SetSecurityAlert(securityalertusecase.New(srv.l, store, sink, securityalert.Options{
+			DedupWindow:          time.Duration(srv.config.SecurityAlert.DedupWindow) * time.Second,
+			KnownDeviceTTL:       time.Duration(srv.config.SecurityAlert.KnownDeviceTTL) * time.Second,
+			FailedLoginThreshold: srv.config.SecurityAlert.FailedLoginThreshold,
+			FailedLoginWindow:    time.Duration(srv.config.SecurityAlert.FailedLoginWindow) * time.Second,
+			RevocationThreshold:  srv.config.SecurityAlert.RevocationThreshold,
+			RevocationWindow:     time.Duration(srv.config.SecurityAlert.RevocationWindow) * time.Second,
+		}))
+	}
+
+	// Rate limits are optional; the middleware limits per IP and per internal
+	// client, the authentication usecase per email and locks out failed callbacks
+	var rateLimitUC ratelimit.UseCase
+	if srv.config.RateLimit.Enabled {
+		store := ratelimitmemory.New()
+		if srv.config.RateLimit.Backend == config.BackendRedis {
+			store = ratelimitredis.New(srv.redisClient, srv.config.RateLimit.KeyPrefix)
+		}
+		rateLimitUC = ratelimitusecase.New(srv.l, store, srv.rateLimitOptions())
+		authUC.SetRateLimit(rateLimitUC)
+	}
+
+	// Domain events are optional; user changes write them to the outbox in the
+	// same transaction and the relay publishes them at least once
+	var webhookHandler webhookhttp.Handler
+	if srv.config.Outbox.Enabled {
+		p, err := srv.initPublisher()
+		if err != nil {
+			return fmt.Errorf("failed to initialize event publisher: %w", err)
+		}
+
+		// Webhooks receive the same relayed events as the broker
+		if srv.config.Webhook.Enabled {
+			webhookRepo := webhookrepository.New(srv.l, srv.postgresDB)
+			webhookUC := webhookusecase.New(srv.l, webhookRepo, srv.encrypter, webhook.Options{
+				MaxAttempts:  srv.config.Webhook.MaxAttempts,
+				RetryBackoff: time.Duration(srv.config.Webhook.RetryBackoff) * time.Second,
+				MaxBackoff:   time.Duration(srv.config.Webhook.MaxBackoff) * time.Second,
+				Timeout:      time.Duration(srv.config.Webhook.Timeout) * time.Second,
+				BatchSize:    srv.config.Webhook.BatchSize,
+				Retention:    time.Duration(srv.config.Webhook.Retention) * time.Second,
+
+				AllowPrivateTargets: srv.config.Webhook.AllowPrivateTargets,
+			})
+			p = publisher.NewMultiPublisher(p, webhookpublisher.New(webhookUC))
+			webhookHandler = webhookhttp.New(srv.l, webhookUC, srv.discord)
+
+			webhookWorker := webhookjob.New(srv.l, webhookUC, time.Duration(srv.config.Webhook.PollInterval)*time.Millisecond)
+			go webhookWorker.Run(context.Background())
+		}
+
+		outboxRepo := outboxrepository.New(srv.l, srv.postgresDB)
+		outboxUC := outboxusecase.New(srv.l, outboxRepo, p, outbox.Options{
+			BatchSize:    srv.config.Outbox.BatchSize,
+			RetryBackoff: time.Duration(srv.config.Outbox.RetryBackoff) * time.Second,
+			MaxBackoff:   time.Duration(srv.config.Outbox.MaxBackoff) * time.Second,
+			Retention:    time.Duration(srv.config.Outbox.Retention) * time.Second,
+		})
+		authUC.SetOutbox(outboxUC)
+
+		// Runs for the life of the process; events claimed when it stops are
+		// published again once their lease expires
+		worker := outboxjob.New(srv.l, outboxUC, time.Duration(srv.config.Outbox.PollInterval)*time.Millisecond)
+		go worker.Run(context.Background())
+	}
+
+	// TOTP MFA is optional; when enabled, enrolled users and the required roles
+	// get a step-up challenge after the OAuth callback
+	var mfaHandler mfahttp.Handler
+	if srv.config.MFA.Enabled {
+		mfaRepo := mfarepository.New(srv.l, srv.postgresDB)
+		mfaUC := mfausecase.New(srv.l, mfaRepo, srv.encrypter, srv.config.MFA)
+		authUC.SetMFA(mfaUC, srv.config.MFA.RequiredRoles, time.Duration(srv.config.MFA.ChallengeTTL)*time.Second)
+		mfaHandler = mfahttp.New(srv.l, mfaUC, srv.discord)
+	}
+
+	// Passkeys are optional; they serve as a second factor when MFA is enabled
+	// and allow passwordless login
+	var passkeyHandler passkeyhttp.Handler
+	if srv.config.Passkey.Enabled {
+		passkeyRepo := passkeyrepository.New(srv.l, srv.postgresDB)
+		ceremonies := passkeymemory.NewCeremonyStore()
+		if srv.config.Passkey.Backend == config.BackendRedis {
+			ceremonies = passkeyredis.NewCeremonyStore(srv.redisClient, srv.config.Passkey.KeyPrefix)
+		}
+		passkeyUC, err := passkeyusecase.New(srv.l, passkeyRepo, ceremonies, srv.config.Passkey, srv.config.JWT.SecretKey, srv.config.JWT.Issuer)
+		if err != nil {
+			return fmt.Errorf("failed to initialize passkeys: %w", err)
+		}
+		authUC.SetPasskey(passkeyUC, time.Duration(srv.config.Passkey.RegistrationMaxAge)*time.Second)
+		passkeyHandler = passkeyhttp.New(srv.l, passkeyUC, srv.discord)
+	}
+
+	// Outgoing email is needed by magic links and by the email invitation notifier
+	var m mailer.Mailer
+	if srv.config.MagicLink.Enabled || (srv.config.Invitation.Enabled && srv.config.Invitation.Notifier == "email") {
+		var err error
+		if m, err = srv.initMailer(); err != nil {
+			return fmt.Errorf("failed to initialize mailer: %w", err)
+		}
+	}
+
+	// Magic-link login is optional
+	if srv.config.MagicLink.Enabled {
+		magicLinkRepo := magiclinkrepository.New(srv.l, srv.postgresDB)
+		magicLinkUC := magiclinkusecase.New(srv.l, magicLinkRepo, m, srv.config.MagicLink, srv.config.JWT.SecretKey, srv.config.JWT.Issuer)
+		authUC.SetMagicLink(magicLinkUC, srv.config.MagicLink.AllowedDomains)
+	}
+
+	// Invitations are optional; invited addresses bypass the domain allowlist
+	var invitationHandler invitationhttp.Handler
+	if srv.config.Invitation.Enabled {
+		n, err := notifier.New(srv.config.Invitation.Notifier, srv.l, srv.discord, m)
+		if err != nil {
+			return fmt.Errorf("failed to initialize invitation notifier: %w", err)
+		}
+		invitationRepo := invitationrepository.New(srv.l, srv.postgresDB)
+		invitationUC := invitationusecase.New(srv.l, invitationRepo, n, srv.config.Invitation)
+		authUC.SetInvitation(invitationUC)
+		invitationHandler = invitationhttp.New(srv.l, invitationUC, srv.discord)
+	}
+
+	// Access requests are optional; admins are told about new ones on Discord
+	var accessRequestHandler accessrequesthttp.Handler
+	if srv.config.AccessRequest.Enabled {
+		accessRequestRepo := accessrequestrepository.New(srv.l, srv.postgresDB)
+		accessRequestUC := accessrequestusecase.New(srv.l, accessRequestRepo, srv.discord)
+		authUC.SetAccessRequest(accessRequestUC)
+		accessRequestHandler = accessrequesthttp.New(srv.l, accessRequestUC, srv.discord)
+	}
+
+	// Service accounts are optional; without them internal routes accept only the internal key
+	// and token exchange is unavailable
+	var serviceAccountUC serviceaccount.UseCase
+	if srv.config.ServiceAccount.Enabled {
+		serviceAccountRepo := serviceaccountrepository.New(srv.l, srv.postgresDB)
+		serviceAccountUC = serviceaccountusecase.New(srv.l, serviceAccountRepo, authUC, srv.config.ServiceAccount, srv.config.JWT.Issuer)
+	}
+
+	// Internal keys come from config, plus the internal_keys table when enabled
+	var internalKeyRepo internalkeyrepo.Repository
+	if srv.config.InternalConfig.DatabaseKeys {
+		internalKeyRepo = internalkeyrepository.New(srv.l, srv.postgresDB)
+	}
+	internalKeyUC, err := internalkeyusecase.New(srv.l, internalKeyRepo, srv.config.InternalConfig)
+	if err != nil {
+		return fmt.Errorf("failed to initialize internal keys: %w", err)
+	}
+	imw := internalmw.New(srv.l, internalKeyUC, serviceAccountUC, srv.config.ServiceAccount.Audience)
+	imw.SetRateLimit(rateLimitUC)
+	imw.SetSelfService(authUC, srv.cookieConfig.Name)
 
 	// Initialize OAuth provider
 	oauthProvider, err := srv.initOAuthProvider()
@@ -52,14 +279,49 @@ func (srv HTTPServer) mapHandlers() error {
 
 	authUC.SetRedirectValidator(srv.redirectValidator)
 
+	// Registered applications bring their own redirects, cookie domain, audience and roles
+	applications, err := authusecase.NewApplicationRegistry(srv.config.Applications)
+	if err != nil {
+		return fmt.Errorf("failed to initialize applications: %w", err)
+	}
+	authUC.SetApplications(applications)
+
 	// Initialize HTTP handlers with new dependencies
 	authHandler := authhttp.New(srv.l, authUC, srv.discord, srv.config)
+	accessTokenHandler := accesstokenhttp.New(srv.l, accessTokenUC, srv.discord)
+	auditHandler := audithttp.New(srv.l, auditUC, srv.discord)
 
 	// userHandler := userhttp.New(srv.l, userUC, srv.discord)
 
 	// Map routes with middleware
 	apiV1 := srv.gin.Group(model.APIV1Prefix)
-	authHandler.RegisterRoutes(apiV1.Group("/authentication"), mw)
+	authHandler.RegisterRoutes(apiV1.Group("/authentication"), mw, imw)
+	accessTokenHandler.RegisterRoutes(apiV1.Group("/authentication/tokens"), mw, imw)
+	auditHandler.RegisterRoutes(apiV1.Group("/audit-logs"), mw, imw)
+	if mfaHandler != nil {
+		mfaHandler.RegisterRoutes(apiV1.Group("/authentication/mfa"), mw, imw)
+	}
+	if passkeyHandler != nil {
+		passkeyHandler.RegisterRoutes(apiV1.Group("/authentication/passkeys"), mw, imw)
+	}
+	if invitationHandler != nil {
+		invitationHandler.RegisterRoutes(apiV1.Group("/authentication/invitations"), mw, imw)
+	}
+	if accessRequestHandler != nil {
+		accessRequestHandler.RegisterRoutes(apiV1.Group("/authentication/access-requests"), mw, imw)
+	}
+	if webhookHandler != nil {
+		webhookHandler.RegisterRoutes(apiV1.Group("/authentication/webhooks"), mw, imw)
+	}
+	if rateLimitUC != nil {
+		rateLimitHandler := ratelimithttp.New(srv.l, rateLimitUC, srv.discord)
+		rateLimitHandler.RegisterRoutes(apiV1.Group("/authentication/lockouts"), mw, imw)
+	}
+	if serviceAccountUC != nil {
+		serviceAccountHandler := serviceaccounthttp.New(srv.l, serviceAccountUC, srv.discord)
+		serviceAccountHandler.RegisterRoutes(apiV1.Group("/authentication/service-accounts"), mw, imw)
+		serviceAccountHandler.RegisterTokenRoutes(apiV1.Group("/oauth2"))
+	}
 
 	return nil
 }
@@ -74,6 +336,9 @@ func (srv HTTPServer) registerMiddlewares() {
 	// Tracing middleware for centralized logging (trace_id)
 	srv.gin.Use(middleware.Tracing())
 
+	// Client IP, user agent and trace_id for audit entries
+	srv.gin.Use(internalmw.RequestMeta())
+
 	// Log CORS mode for visibility
 	ctx := context.Background()
 	if srv.environment == string(model.EnvironmentProduction) {
@@ -125,3 +390,61 @@ func (srv HTTPServer) initOAuthProvider() (oauth.Provider, error) {
 
 	return provider, nil
 }
+
+func (srv HTTPServer) initMailer() (mailer.Mailer, error) {
+	return mailer.New(mailer.Config{
+		Driver: srv.config.Mailer.Driver,
+		From:   srv.config.Mailer.From,
+		SMTP: mailer.SMTPConfig{
+			Host:     srv.config.Mailer.SMTPHost,
+			Port:     srv.config.Mailer.SMTPPort,
+			Username: srv.config.Mailer.SMTPUsername,
+			Password: srv.config.Mailer.SMTPPassword,
+		},
+		Dir: srv.config.Mailer.Dir,
+	}, srv.l)
+}
+
+func (srv HTTPServer) initPublisher() (publisher.Publisher, error) {
+	return publisher.New(publisher.Config{
+		Driver: srv.config.Outbox.Publisher,
+		Kafka: publisher.KafkaConfig{
+			RESTURL: srv.config.Outbox.KafkaRESTURL,
+			Topic:   srv.config.Outbox.KafkaTopic,
+		},
+		Redis: publisher.RedisConfig{
+			Stream: srv.config.Outbox.RedisStream,
+			MaxLen: srv.config.Outbox.RedisMaxLen,
+		},
+	}, srv.l, srv.redisClient)
+}
+
+// rateLimitOptions converts rate_limit config, in seconds, to limiter options
+func (srv HTTPServer) rateLimitOptions() ratelimit.Options {
+	rule := func(r config.RateLimitRule) ratelimit.Rule {
+		return ratelimit.Rule{Limit: r.Limit, Window: time.Duration(r.Window) * time.Second}
+	}
+	routes := make(map[string]ratelimit.RouteRules, len(srv.config.RateLimit.Routes))
+	for route, rules := range srv.config.RateLimit.Routes {
+		routes[route] = ratelimit.RouteRules{
+			PerIP:     rule(rules.PerIP),
+			PerEmail:  rule(rules.PerEmail),
+			PerClient: rule(rules.PerClient),
+		}
+	}
+	return ratelimit.Options{
+		Routes:           routes,
+		LockoutThreshold: srv.config.RateLimit.LockoutThreshold,
+		LockoutWindow:    time.Duration(srv.config.RateLimit.LockoutWindow) * time.Second,
+		LockoutDuration:  time.Duration(srv.config.RateLimit.LockoutDuration) * time.Second,
+	}
+}
+
+// maxTokenTTL returns the longest lifetime an issued token can have
+func (srv HTTPServer) maxTokenTTL() time.Duration {
+	ttl := srv.config.JWT.TTL
+	if srv.config.Session.RememberMeTTL > ttl {
+		ttl = srv.config.Session.RememberMeTTL
+	}
+	return time.Duration(ttl) * time.Second
+}
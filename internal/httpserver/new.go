@@ -3,9 +3,9 @@ package httpserver
 import (
 	"database/sql"
 	"errors"
-	"time"
 
 	"identity-srv/config"
+	"identity-srv/internal/authentication/repository"
 	"identity-srv/internal/authentication/usecase"
 
 	"github.com/gin-gonic/gin"
@@ -37,8 +37,9 @@ type HTTPServer struct {
 	config            *config.Config
 	jwtManager        auth.Manager
 	redisClient       redis.IRedis
-	sessionManager    *usecase.SessionManager
-	blacklistManager  *usecase.BlacklistManager
+	sessionManager    repository.SessionManager
+	blacklistManager  repository.BlacklistManager
+	loginCodeStore    repository.LoginCodeStore
 	roleMapper        *usecase.RoleMapper
 	redirectValidator *usecase.RedirectValidator
 	cookieConfig      config.CookieConfig
@@ -65,7 +66,7 @@ type Config struct {
 	// Authentication & Security Configuration
 	Config            *config.Config
 	JWTManager        auth.Manager
-	RedisClient       redis.IRedis
+	RedisClient       redis.IRedis // nil when no backend uses Redis
 	RedirectValidator *usecase.RedirectValidator
 	CookieConfig      config.CookieConfig
 	Encrypter         encrypter.Encrypter
@@ -78,12 +79,23 @@ type Config struct {
 func New(logger log.Logger, cfg Config) (*HTTPServer, error) {
 	gin.SetMode(cfg.Mode)
 
-	// Initialize session manager
-	sessionTTL := time.Duration(cfg.Config.Session.TTL) * time.Second
-	sessionManager := usecase.NewSessionManager(cfg.RedisClient, sessionTTL, logger)
+	// Initialize session manager (backend selected by session.backend)
+	sessionManager, err := newSessionManager(cfg)
+	if err != nil {
+		return nil, err
+	}
 
-	// Initialize blacklist manager (using same Redis client as session)
-	blacklistManager := usecase.NewBlacklistManager(cfg.RedisClient)
+	// Initialize blacklist manager (backend selected by blacklist.backend, nil when disabled)
+	blacklistManager, err := newBlacklistManager(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize login code store (backend selected by login_code.backend)
+	loginCodeStore, err := newLoginCodeStore(cfg)
+	if err != nil {
+		return nil, err
+	}
 
 	// Initialize role mapper
 	roleMapper := usecase.NewRoleMapper(cfg.Config)
@@ -109,6 +121,7 @@ func New(logger log.Logger, cfg Config) (*HTTPServer, error) {
 		redisClient:       cfg.RedisClient,
 		sessionManager:    sessionManager,
 		blacklistManager:  blacklistManager,
+		loginCodeStore:    loginCodeStore,
 		roleMapper:        roleMapper,
 		redirectValidator: cfg.RedirectValidator,
 		cookieConfig:      cfg.CookieConfig,
@@ -160,15 +173,15 @@ func (srv HTTPServer) validate() error {
 	if srv.jwtManager == nil {
 		return errors.New("jwtManager is required")
 	}
-	if srv.redisClient == nil {
-		return errors.New("redisClient is required")
-	}
 	if srv.sessionManager == nil {
 		return errors.New("sessionManager is required")
 	}
-	if srv.blacklistManager == nil {
+	if srv.config.Blacklist.Enabled && srv.blacklistManager == nil {
 		return errors.New("blacklistManager is required")
 	}
+	if srv.loginCodeStore == nil {
+		return errors.New("loginCodeStore is required")
+	}
 	if srv.encrypter == nil {
 		return errors.New("encrypter is required")
 	}
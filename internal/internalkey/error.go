@@ -0,0 +1,9 @@
+package internalkey
+
+import "errors"
+
+var (
+	ErrInvalidKey     = errors.New("invalid internal key")
+	ErrKeyNotActive   = errors.New("internal key outside its validity window")
+	ErrInternalSystem = errors.New("internal system error")
+)
@@ -0,0 +1,13 @@
+package internalkey
+
+import (
+	"context"
+
+	"identity-srv/internal/model"
+)
+
+//go:generate mockery --name UseCase
+type UseCase interface {
+	// Verify resolves a presented X-Internal-Key to the named key it matches
+	Verify(ctx context.Context, key string) (model.InternalKey, error)
+}
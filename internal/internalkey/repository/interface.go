@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+
+	"identity-srv/internal/model"
+)
+
+//go:generate mockery --name Repository
+type Repository interface {
+	List(ctx context.Context) ([]model.InternalKey, error)
+}
@@ -0,0 +1,27 @@
+package postgres
+
+import (
+	"context"
+
+	"identity-srv/internal/model"
+	"identity-srv/internal/sqlboiler"
+
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// List returns all internal keys; validity windows are checked by the caller
+func (r *implRepository) List(ctx context.Context) ([]model.InternalKey, error) {
+	keys, err := sqlboiler.InternalKeys(
+		qm.OrderBy(sqlboiler.InternalKeyColumns.Name),
+	).All(ctx, r.db)
+	if err != nil {
+		r.l.Errorf(ctx, "internalkey.repository.postgres.List: %v", err)
+		return nil, err
+	}
+
+	result := make([]model.InternalKey, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, *model.NewInternalKeyFromDB(key))
+	}
+	return result, nil
+}
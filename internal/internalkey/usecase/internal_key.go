@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+
+	"identity-srv/internal/internalkey"
+	"identity-srv/internal/model"
+)
+
+// Verify resolves a presented key to the named key it matches.
+// A known key outside its window returns the key together with ErrKeyNotActive,
+// so the caller can still log which key was used.
+func (u *usecase) Verify(ctx context.Context, key string) (model.InternalKey, error) {
+	if key == "" {
+		return model.InternalKey{}, internalkey.ErrInvalidKey
+	}
+
+	hash := []byte(hashKey(key))
+	for _, k := range u.keys(ctx) {
+		if subtle.ConstantTimeCompare(hash, []byte(k.KeyHash)) != 1 {
+			continue
+		}
+		if !k.IsValidAt(u.clock()) {
+			return k, internalkey.ErrKeyNotActive
+		}
+		return k, nil
+	}
+	return model.InternalKey{}, internalkey.ErrInvalidKey
+}
+
+// keys returns config keys plus database keys, reloading the latter once the
+// refresh interval has passed. A failed reload keeps the previous database keys.
+func (u *usecase) keys(ctx context.Context) []model.InternalKey {
+	if u.repo == nil {
+		return u.configKeys
+	}
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	now := u.clock()
+	if now.Sub(u.loadedAt) >= u.refreshInterval {
+		dbKeys, err := u.repo.List(ctx)
+		if err != nil {
+			u.l.Warnf(ctx, "internalkey.usecase.keys.List: %v", err)
+		} else {
+			u.dbKeys = dbKeys
+		}
+		// Also back off after a failure, so an unavailable database is not hit per request
+		u.loadedAt = now
+	}
+
+	keys := make([]model.InternalKey, 0, len(u.configKeys)+len(u.dbKeys))
+	keys = append(keys, u.configKeys...)
+	return append(keys, u.dbKeys...)
+}
+
+// hashKey returns the hex SHA-256 of a key, matching internal_keys.key_hash
+func hashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"identity-srv/config"
+	"identity-srv/internal/internalkey"
+	"identity-srv/internal/internalkey/repository"
+	"identity-srv/internal/model"
+
+	"github.com/smap-hcmut/shared-libs/go/log"
+)
+
+type usecase struct {
+	l               log.Logger
+	repo            repository.Repository // nil when database keys are disabled
+	clock           func() time.Time
+	configKeys      []model.InternalKey
+	refreshInterval time.Duration
+
+	mu       sync.Mutex
+	dbKeys   []model.InternalKey
+	loadedAt time.Time
+}
+
+// New builds the key ring from config. repo may be nil to accept config keys only.
+func New(l log.Logger, repo repository.Repository, cfg config.InternalConfig) (internalkey.UseCase, error) {
+	configKeys, err := buildConfigKeys(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &usecase{
+		l:               l,
+		repo:            repo,
+		clock:           time.Now,
+		configKeys:      configKeys,
+		refreshInterval: time.Duration(cfg.RefreshInterval) * time.Second,
+	}, nil
+}
+
+// buildConfigKeys hashes the legacy key and the named keys from config
+func buildConfigKeys(cfg config.InternalConfig) ([]model.InternalKey, error) {
+	keys := make([]model.InternalKey, 0, len(cfg.Keys)+1)
+	if cfg.InternalKey != "" {
+		keys = append(keys, model.InternalKey{
+			Name:    config.DefaultInternalKeyName,
+			KeyHash: hashKey(cfg.InternalKey),
+			Source:  model.InternalKeySourceConfig,
+		})
+	}
+
+	for _, k := range cfg.Keys {
+		notBefore, notAfter, err := k.Window()
+		if err != nil {
+			return nil, fmt.Errorf("internal key %s: %w", k.Name, err)
+		}
+
+		key := model.InternalKey{
+			Name:    k.Name,
+			KeyHash: hashKey(k.Key),
+			Source:  model.InternalKeySourceConfig,
+		}
+		if !notBefore.IsZero() {
+			key.NotBefore = &notBefore
+		}
+		if !notAfter.IsZero() {
+			key.NotAfter = &notAfter
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
@@ -0,0 +1,46 @@
+package http
+
+import (
+	"errors"
+	"identity-srv/internal/invitation"
+
+	pkgErrors "github.com/smap-hcmut/shared-libs/go/errors"
+)
+
+// --- HTTP error constants ---
+
+var (
+	errWrongBody          = pkgErrors.NewHTTPError(25001, "Wrong body")
+	errInvitationNotFound = pkgErrors.NewHTTPError(25002, "Invitation not found")
+	errInvitationExists   = pkgErrors.NewHTTPError(25003, "Address already invited")
+	errInvalidEmail       = pkgErrors.NewHTTPError(25004, "Invalid email")
+	errInvalidRole        = pkgErrors.NewHTTPError(25005, "Invalid role")
+	errInvalidStatus      = pkgErrors.NewHTTPError(25006, "Invalid invitation status")
+	errMissingID          = pkgErrors.NewHTTPError(25007, "Invitation ID is required")
+	errScopeNotFound      = pkgErrors.NewHTTPError(25008, "Scope not found")
+	errInternalSystem     = pkgErrors.NewHTTPError(25009, "Internal system error")
+)
+
+// mapError maps UseCase domain errors to HTTP errors
+func (h handler) mapError(err error) error {
+	switch {
+	case errors.Is(err, invitation.ErrInvitationNotFound):
+		return errInvitationNotFound
+	case errors.Is(err, invitation.ErrInvitationExists):
+		return errInvitationExists
+	case errors.Is(err, invitation.ErrInvalidEmail):
+		return errInvalidEmail
+	case errors.Is(err, invitation.ErrInvalidRole):
+		return errInvalidRole
+	case errors.Is(err, invitation.ErrInvalidStatus):
+		return errInvalidStatus
+	case errors.Is(err, invitation.ErrInternalSystem):
+		return errInternalSystem
+	default:
+		return err
+	}
+}
+
+var NotFound = []error{
+	errInvitationNotFound,
+}
@@ -0,0 +1,111 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/smap-hcmut/shared-libs/go/response"
+)
+
+// Create
+// @Summary Invite User
+// @Description Invite an address with a role before its first login, and notify it through invitation.notifier. The invited address may log in even when its domain is not allowed; its first login accepts the invitation. access_control.user_roles still takes precedence over the invited role. Requires ADMIN role.
+// @Tags Invitations
+// @Accept json
+// @Produce json
+// @Param body body createReq true "Address and role"
+// @Success 200 {object} response.Resp{data=createResp} "Created invitation"
+// @Failure 400 {object} response.Resp "Invalid email or role, or address already invited"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/invitations [POST]
+// @Security CookieAuth
+func (h handler) Create(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	input, sc, err := h.processCreateRequest(c)
+	if err != nil {
+		response.Error(c, err, h.discord)
+		return
+	}
+
+	// 2. Call UseCase
+	output, err := h.uc.Create(ctx, sc, input)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.Create: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	response.OK(c, h.newCreateResp(output))
+}
+
+// List
+// @Summary List Invitations
+// @Description List invitations, newest first. Requires ADMIN role.
+// @Tags Invitations
+// @Accept json
+// @Produce json
+// @Param email query string false "Invited address"
+// @Param status query string false "pending, accepted, expired or revoked"
+// @Success 200 {object} response.Resp{data=listResp} "Invitations"
+// @Failure 400 {object} response.Resp "Invalid filter"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/invitations [GET]
+// @Security CookieAuth
+func (h handler) List(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	input := h.processListRequest(c)
+
+	// 2. Call UseCase
+	invitations, err := h.uc.List(ctx, input)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.List: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	response.OK(c, h.newListResp(invitations))
+}
+
+// Revoke
+// @Summary Revoke Invitation
+// @Description Withdraw an invitation. A pending one can no longer be accepted; for an accepted one the user loses the invited role and the domain exception at their next login. Requires ADMIN role.
+// @Tags Invitations
+// @Accept json
+// @Produce json
+// @Param id path string true "Invitation ID"
+// @Success 200 {object} response.Resp "Invitation revoked"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 404 {object} response.Resp "Not Found"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/invitations/{id} [DELETE]
+// @Security CookieAuth
+func (h handler) Revoke(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	id, sc, err := h.processIDRequest(c)
+	if err != nil {
+		response.Error(c, err, h.discord)
+		return
+	}
+
+	// 2. Call UseCase
+	if err := h.uc.Revoke(ctx, sc, id); err != nil {
+		h.l.Errorf(ctx, "uc.Revoke: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	response.OK(c, nil)
+}
@@ -0,0 +1,78 @@
+package http
+
+import (
+	"identity-srv/internal/invitation"
+	"identity-srv/internal/model"
+	"strings"
+	"time"
+)
+
+// --- Request DTOs ---
+
+type createReq struct {
+	Email string `json:"email" binding:"required"`
+	Role  string `json:"role" binding:"required"` // ADMIN, ANALYST or VIEWER
+}
+
+func (r createReq) toInput() invitation.CreateInput {
+	return invitation.CreateInput{
+		Email: r.Email,
+		Role:  strings.ToUpper(strings.TrimSpace(r.Role)),
+	}
+}
+
+// --- Response DTOs ---
+
+type invitationResp struct {
+	ID         string     `json:"id"`
+	Email      string     `json:"email"`
+	Role       string     `json:"role"`
+	Status     string     `json:"status"` // pending, accepted, expired, revoked
+	InvitedBy  *string    `json:"invited_by,omitempty"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	AcceptedAt *time.Time `json:"accepted_at,omitempty"`
+	AcceptedBy *string    `json:"accepted_by,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+type createResp struct {
+	Notified bool `json:"notified"` // false when the notification failed; relay the invitation another way
+	invitationResp
+}
+
+type listResp struct {
+	Invitations []invitationResp `json:"invitations"`
+}
+
+// --- Response Mappers ---
+
+func (h handler) newInvitationResp(o model.Invitation) invitationResp {
+	return invitationResp{
+		ID:         o.ID,
+		Email:      o.Email,
+		Role:       o.Role,
+		Status:     o.Status(time.Now()),
+		InvitedBy:  o.InvitedBy,
+		ExpiresAt:  o.ExpiresAt,
+		AcceptedAt: o.AcceptedAt,
+		AcceptedBy: o.AcceptedBy,
+		RevokedAt:  o.RevokedAt,
+		CreatedAt:  o.CreatedAt,
+	}
+}
+
+func (h handler) newCreateResp(o invitation.CreateOutput) createResp {
+	return createResp{
+		Notified:       o.Notified,
+		invitationResp: h.newInvitationResp(o.Invitation),
+	}
+}
+
+func (h handler) newListResp(o []model.Invitation) listResp {
+	invitations := make([]invitationResp, 0, len(o))
+	for _, inv := range o {
+		invitations = append(invitations, h.newInvitationResp(inv))
+	}
+	return listResp{Invitations: invitations}
+}
@@ -0,0 +1,61 @@
+package http
+
+import (
+	"identity-srv/internal/invitation"
+	"identity-srv/internal/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/smap-hcmut/shared-libs/go/auth"
+)
+
+// --- Scope extraction ---
+
+func (h handler) getScope(c *gin.Context) (model.Scope, bool) {
+	payload, ok := auth.GetPayloadFromContext(c.Request.Context())
+	if !ok || payload.UserID == "" {
+		return model.Scope{}, false
+	}
+	return model.Scope{
+		UserID:    payload.UserID,
+		Username:  payload.Username,
+		Role:      payload.Role,
+		JTI:       payload.Id,
+		ExpiresAt: payload.ExpiresAt,
+	}, true
+}
+
+// --- Process request functions ---
+
+func (h handler) processCreateRequest(c *gin.Context) (invitation.CreateInput, model.Scope, error) {
+	sc, ok := h.getScope(c)
+	if !ok {
+		return invitation.CreateInput{}, model.Scope{}, errScopeNotFound
+	}
+
+	var req createReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		return invitation.CreateInput{}, model.Scope{}, errWrongBody
+	}
+
+	return req.toInput(), sc, nil
+}
+
+func (h handler) processListRequest(c *gin.Context) invitation.ListInput {
+	return invitation.ListInput{
+		Email:  c.Query("email"),
+		Status: c.Query("status"),
+	}
+}
+
+func (h handler) processIDRequest(c *gin.Context) (string, model.Scope, error) {
+	sc, ok := h.getScope(c)
+	if !ok {
+		return "", model.Scope{}, errScopeNotFound
+	}
+
+	id := c.Param("id")
+	if id == "" {
+		return "", model.Scope{}, errMissingID
+	}
+	return id, sc, nil
+}
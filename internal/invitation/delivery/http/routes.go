@@ -0,0 +1,16 @@
+package http
+
+import (
+	internalmw "identity-srv/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/smap-hcmut/shared-libs/go/middleware"
+)
+
+func (h handler) RegisterRoutes(r *gin.RouterGroup, mw *middleware.Middleware, imw *internalmw.Middleware) {
+	// Admin management (require ADMIN role)
+	r.Use(mw.Auth(), mw.AdminOnly(), imw.Admin())
+	r.POST("", h.Create)
+	r.GET("", h.List)
+	r.DELETE("/:id", h.Revoke)
+}
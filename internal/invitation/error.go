@@ -0,0 +1,12 @@
+package invitation
+
+import "errors"
+
+var (
+	ErrInvitationNotFound = errors.New("invitation not found")
+	ErrInvitationExists   = errors.New("address already invited")
+	ErrInvalidEmail       = errors.New("invalid email")
+	ErrInvalidRole        = errors.New("invalid role")
+	ErrInvalidStatus      = errors.New("invalid invitation status")
+	ErrInternalSystem     = errors.New("internal system error")
+)
@@ -0,0 +1,19 @@
+package invitation
+
+import (
+	"context"
+
+	"identity-srv/internal/model"
+)
+
+//go:generate mockery --name UseCase
+type UseCase interface {
+	// Admin management
+	Create(ctx context.Context, sc model.Scope, ip CreateInput) (CreateOutput, error)
+	List(ctx context.Context, ip ListInput) ([]model.Invitation, error)
+	Revoke(ctx context.Context, sc model.Scope, id string) error
+
+	// Login (used by the authentication usecase)
+	Find(ctx context.Context, email string) (model.Invitation, error)
+	Accept(ctx context.Context, ip AcceptInput) (model.Invitation, error)
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"identity-srv/internal/model"
+)
+
+//go:generate mockery --name Repository
+type Repository interface {
+	Create(ctx context.Context, opts CreateOptions) (model.Invitation, error)
+	List(ctx context.Context, opts ListOptions) ([]model.Invitation, error)
+	Detail(ctx context.Context, id string) (model.Invitation, error)
+	// FindActive returns the latest invitation of the address that grants access
+	FindActive(ctx context.Context, opts FindActiveOptions) (model.Invitation, error)
+	// Accept marks a pending invitation accepted; false when it no longer is pending
+	Accept(ctx context.Context, opts AcceptOptions) (bool, error)
+	// Revoke withdraws an invitation; false when it was already revoked
+	Revoke(ctx context.Context, opts RevokeOptions) (bool, error)
+}
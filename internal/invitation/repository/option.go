@@ -0,0 +1,32 @@
+package repository
+
+import "time"
+
+type CreateOptions struct {
+	Email     string
+	Role      string
+	InvitedBy string
+	ExpiresAt time.Time
+}
+
+type ListOptions struct {
+	Email  string
+	Status string // model.InvitationStatus*, empty for all
+	Now    time.Time
+}
+
+type FindActiveOptions struct {
+	Email string
+	Now   time.Time
+}
+
+type AcceptOptions struct {
+	ID     string
+	UserID string
+	Now    time.Time
+}
+
+type RevokeOptions struct {
+	ID  string
+	Now time.Time
+}
@@ -0,0 +1,61 @@
+package postgres
+
+import (
+	"identity-srv/internal/invitation/repository"
+	"identity-srv/internal/model"
+	"identity-srv/internal/sqlboiler"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/smap-hcmut/shared-libs/go/postgres"
+)
+
+func (r *implRepository) buildInvitation(opts repository.CreateOptions) *sqlboiler.Invitation {
+	now := r.clock()
+	invitation := &sqlboiler.Invitation{
+		ID:        postgres.NewUUID(),
+		Email:     opts.Email,
+		Role:      opts.Role,
+		ExpiresAt: opts.ExpiresAt,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if opts.InvitedBy != "" {
+		invitation.InvitedBy = null.StringFrom(opts.InvitedBy)
+	}
+	return invitation
+}
+
+// buildListQuery translates the filters into query mods; the status is derived
+// from the timestamps as in model.Invitation.Status
+func (r *implRepository) buildListQuery(opts repository.ListOptions) []qm.QueryMod {
+	mods := []qm.QueryMod{
+		qm.OrderBy(sqlboiler.InvitationColumns.CreatedAt + " DESC"),
+	}
+	if opts.Email != "" {
+		mods = append(mods, sqlboiler.InvitationWhere.Email.EQ(opts.Email))
+	}
+
+	switch opts.Status {
+	case model.InvitationStatusPending:
+		mods = append(mods,
+			sqlboiler.InvitationWhere.RevokedAt.IsNull(),
+			sqlboiler.InvitationWhere.AcceptedAt.IsNull(),
+			sqlboiler.InvitationWhere.ExpiresAt.GT(opts.Now),
+		)
+	case model.InvitationStatusAccepted:
+		mods = append(mods,
+			sqlboiler.InvitationWhere.RevokedAt.IsNull(),
+			sqlboiler.InvitationWhere.AcceptedAt.IsNotNull(),
+		)
+	case model.InvitationStatusExpired:
+		mods = append(mods,
+			sqlboiler.InvitationWhere.RevokedAt.IsNull(),
+			sqlboiler.InvitationWhere.AcceptedAt.IsNull(),
+			sqlboiler.InvitationWhere.ExpiresAt.LTE(opts.Now),
+		)
+	case model.InvitationStatusRevoked:
+		mods = append(mods, sqlboiler.InvitationWhere.RevokedAt.IsNotNull())
+	}
+	return mods
+}
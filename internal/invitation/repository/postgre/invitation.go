@@ -0,0 +1,107 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"identity-srv/internal/invitation/repository"
+	"identity-srv/internal/model"
+	"identity-srv/internal/sqlboiler"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// Create inserts a new invitation
+func (r *implRepository) Create(ctx context.Context, opts repository.CreateOptions) (model.Invitation, error) {
+	invitation := r.buildInvitation(opts)
+	if err := invitation.Insert(ctx, r.db, boil.Infer()); err != nil {
+		r.l.Errorf(ctx, "invitation.repository.postgres.Create: %v", err)
+		return model.Invitation{}, err
+	}
+	return *model.NewInvitationFromDB(invitation), nil
+}
+
+// List returns the invitations matching the filters, newest first
+func (r *implRepository) List(ctx context.Context, opts repository.ListOptions) ([]model.Invitation, error) {
+	invitations, err := sqlboiler.Invitations(r.buildListQuery(opts)...).All(ctx, r.db)
+	if err != nil {
+		r.l.Errorf(ctx, "invitation.repository.postgres.List: %v", err)
+		return nil, err
+	}
+
+	result := make([]model.Invitation, 0, len(invitations))
+	for _, invitation := range invitations {
+		result = append(result, *model.NewInvitationFromDB(invitation))
+	}
+	return result, nil
+}
+
+// Detail finds an invitation by ID
+func (r *implRepository) Detail(ctx context.Context, id string) (model.Invitation, error) {
+	return r.detail(ctx, "Detail", sqlboiler.InvitationWhere.ID.EQ(id))
+}
+
+// FindActive returns the latest invitation of the address that is accepted, or
+// pending and not expired
+func (r *implRepository) FindActive(ctx context.Context, opts repository.FindActiveOptions) (model.Invitation, error) {
+	return r.detail(ctx, "FindActive",
+		sqlboiler.InvitationWhere.Email.EQ(opts.Email),
+		sqlboiler.InvitationWhere.RevokedAt.IsNull(),
+		qm.Expr(
+			sqlboiler.InvitationWhere.AcceptedAt.IsNotNull(),
+			qm.Or2(sqlboiler.InvitationWhere.ExpiresAt.GT(opts.Now)),
+		),
+		qm.OrderBy(sqlboiler.InvitationColumns.CreatedAt+" DESC"),
+	)
+}
+
+func (r *implRepository) detail(ctx context.Context, method string, mods ...qm.QueryMod) (model.Invitation, error) {
+	invitation, err := sqlboiler.Invitations(mods...).One(ctx, r.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.Invitation{}, repository.ErrNotFound
+		}
+		r.l.Errorf(ctx, "invitation.repository.postgres.%s: %v", method, err)
+		return model.Invitation{}, err
+	}
+	return *model.NewInvitationFromDB(invitation), nil
+}
+
+// Accept marks a pending invitation accepted. The conditions make concurrent
+// logins accept it once.
+func (r *implRepository) Accept(ctx context.Context, opts repository.AcceptOptions) (bool, error) {
+	rows, err := sqlboiler.Invitations(
+		sqlboiler.InvitationWhere.ID.EQ(opts.ID),
+		sqlboiler.InvitationWhere.AcceptedAt.IsNull(),
+		sqlboiler.InvitationWhere.RevokedAt.IsNull(),
+		sqlboiler.InvitationWhere.ExpiresAt.GT(opts.Now),
+	).UpdateAll(ctx, r.db, sqlboiler.M{
+		sqlboiler.InvitationColumns.AcceptedAt: null.TimeFrom(opts.Now),
+		sqlboiler.InvitationColumns.AcceptedBy: null.StringFrom(opts.UserID),
+		sqlboiler.InvitationColumns.UpdatedAt:  opts.Now,
+	})
+	if err != nil {
+		r.l.Errorf(ctx, "invitation.repository.postgres.Accept: %v", err)
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+// Revoke withdraws an invitation, pending or accepted
+func (r *implRepository) Revoke(ctx context.Context, opts repository.RevokeOptions) (bool, error) {
+	rows, err := sqlboiler.Invitations(
+		sqlboiler.InvitationWhere.ID.EQ(opts.ID),
+		sqlboiler.InvitationWhere.RevokedAt.IsNull(),
+	).UpdateAll(ctx, r.db, sqlboiler.M{
+		sqlboiler.InvitationColumns.RevokedAt: null.TimeFrom(opts.Now),
+		sqlboiler.InvitationColumns.UpdatedAt: opts.Now,
+	})
+	if err != nil {
+		r.l.Errorf(ctx, "invitation.repository.postgres.Revoke: %v", err)
+		return false, err
+	}
+	return rows > 0, nil
+}
@@ -0,0 +1,27 @@
+package invitation
+
+import "identity-srv/internal/model"
+
+// CreateInput contains the address to invite and the role it gets
+type CreateInput struct {
+	Email string
+	Role  string // ADMIN, ANALYST or VIEWER
+}
+
+// CreateOutput contains the created invitation and whether it was delivered
+type CreateOutput struct {
+	Invitation model.Invitation
+	Notified   bool // false when the notifier failed; the invitation is still valid
+}
+
+// ListInput filters the invitations of an admin listing
+type ListInput struct {
+	Email  string // exact address, optional
+	Status string // model.InvitationStatus*, optional
+}
+
+// AcceptInput identifies the login that accepts an invitation
+type AcceptInput struct {
+	Email  string
+	UserID string
+}
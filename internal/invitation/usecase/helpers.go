@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	"identity-srv/internal/invitation"
+	"identity-srv/internal/model"
+	"identity-srv/pkg/notifier"
+)
+
+const invitationSubject = "You are invited to SMAP"
+
+// normalizeEmail accepts a bare address and returns it lowercased
+func normalizeEmail(email string) (string, error) {
+	addr, err := mail.ParseAddress(strings.TrimSpace(email))
+	if err != nil || addr.Name != "" {
+		return "", invitation.ErrInvalidEmail
+	}
+	return strings.ToLower(addr.Address), nil
+}
+
+func validateRole(role string) error {
+	switch role {
+	case model.RoleAdmin, model.RoleAnalyst, model.RoleViewer:
+		return nil
+	default:
+		return invitation.ErrInvalidRole
+	}
+}
+
+func validateStatus(status string) error {
+	switch status {
+	case "", model.InvitationStatusPending, model.InvitationStatusAccepted,
+		model.InvitationStatusExpired, model.InvitationStatusRevoked:
+		return nil
+	default:
+		return invitation.ErrInvalidStatus
+	}
+}
+
+// newInvitationNotification renders the message sent to the invited address
+func (u *usecase) newInvitationNotification(inv model.Invitation, inviter string) notifier.Notification {
+	by := ""
+	if inviter != "" {
+		by = " by " + inviter
+	}
+	return notifier.Notification{
+		To:      inv.Email,
+		Subject: invitationSubject,
+		Text: fmt.Sprintf("You have been invited%s to SMAP as %s.\n\nLog in with %s at %s before %s.\n",
+			by, inv.Role, inv.Email, u.loginURL, inv.ExpiresAt.UTC().Format(time.RFC1123)),
+	}
+}
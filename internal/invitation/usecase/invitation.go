@@ -0,0 +1,143 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"identity-srv/internal/invitation"
+	"identity-srv/internal/invitation/repository"
+	"identity-srv/internal/model"
+)
+
+// Create invites an address with a role and notifies it. A failed notification
+// does not undo the invitation; the admin can relay it another way.
+func (u *usecase) Create(ctx context.Context, sc model.Scope, ip invitation.CreateInput) (invitation.CreateOutput, error) {
+	email, err := normalizeEmail(ip.Email)
+	if err != nil {
+		return invitation.CreateOutput{}, err
+	}
+	if err := validateRole(ip.Role); err != nil {
+		return invitation.CreateOutput{}, err
+	}
+
+	now := u.clock()
+	_, err = u.repo.FindActive(ctx, repository.FindActiveOptions{Email: email, Now: now})
+	if err == nil {
+		return invitation.CreateOutput{}, invitation.ErrInvitationExists
+	}
+	if !errors.Is(err, repository.ErrNotFound) {
+		u.l.Errorf(ctx, "invitation.usecase.Create.FindActive: %v", err)
+		return invitation.CreateOutput{}, fmt.Errorf("%w: %v", invitation.ErrInternalSystem, err)
+	}
+
+	created, err := u.repo.Create(ctx, repository.CreateOptions{
+		Email:     email,
+		Role:      ip.Role,
+		InvitedBy: sc.UserID,
+		ExpiresAt: now.Add(u.ttl),
+	})
+	if err != nil {
+		u.l.Errorf(ctx, "invitation.usecase.Create.Create: %v", err)
+		return invitation.CreateOutput{}, fmt.Errorf("%w: %v", invitation.ErrInternalSystem, err)
+	}
+	u.l.Infof(ctx, "Invitation created: Email=%s Role=%s By=%s", email, ip.Role, sc.UserID)
+
+	notified := true
+	if err := u.notifier.Notify(ctx, u.newInvitationNotification(created, sc.Username)); err != nil {
+		u.l.Errorf(ctx, "invitation.usecase.Create.Notify: %v", err)
+		notified = false
+	}
+
+	return invitation.CreateOutput{
+		Invitation: created,
+		Notified:   notified,
+	}, nil
+}
+
+// List returns invitations, newest first
+func (u *usecase) List(ctx context.Context, ip invitation.ListInput) ([]model.Invitation, error) {
+	if err := validateStatus(ip.Status); err != nil {
+		return nil, err
+	}
+	opts := repository.ListOptions{Status: ip.Status, Now: u.clock()}
+	if ip.Email != "" {
+		email, err := normalizeEmail(ip.Email)
+		if err != nil {
+			return nil, err
+		}
+		opts.Email = email
+	}
+
+	invitations, err := u.repo.List(ctx, opts)
+	if err != nil {
+		u.l.Errorf(ctx, "invitation.usecase.List.List: %v", err)
+		return nil, fmt.Errorf("%w: %v", invitation.ErrInternalSystem, err)
+	}
+	return invitations, nil
+}
+
+// Revoke withdraws an invitation. Revoking an accepted invitation takes back
+// the invited role and the domain exception at the user's next login.
+func (u *usecase) Revoke(ctx context.Context, sc model.Scope, id string) error {
+	revoked, err := u.repo.Revoke(ctx, repository.RevokeOptions{ID: id, Now: u.clock()})
+	if err != nil {
+		u.l.Errorf(ctx, "invitation.usecase.Revoke.Revoke: %v", err)
+		return fmt.Errorf("%w: %v", invitation.ErrInternalSystem, err)
+	}
+	if !revoked {
+		if _, err := u.repo.Detail(ctx, id); err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return invitation.ErrInvitationNotFound
+			}
+			u.l.Errorf(ctx, "invitation.usecase.Revoke.Detail: %v", err)
+			return fmt.Errorf("%w: %v", invitation.ErrInternalSystem, err)
+		}
+		return nil // already revoked
+	}
+
+	u.l.Infof(ctx, "Invitation revoked: ID=%s By=%s", id, sc.UserID)
+	return nil
+}
+
+// Find returns the invitation that lets the address log in: accepted, or
+// pending and not expired
+func (u *usecase) Find(ctx context.Context, email string) (model.Invitation, error) {
+	inv, err := u.repo.FindActive(ctx, repository.FindActiveOptions{Email: email, Now: u.clock()})
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return model.Invitation{}, invitation.ErrInvitationNotFound
+		}
+		u.l.Errorf(ctx, "invitation.usecase.Find.FindActive: %v", err)
+		return model.Invitation{}, fmt.Errorf("%w: %v", invitation.ErrInternalSystem, err)
+	}
+	return inv, nil
+}
+
+// Accept consumes the pending invitation of the address on its first login.
+// An invitation accepted earlier is returned as is.
+func (u *usecase) Accept(ctx context.Context, ip invitation.AcceptInput) (model.Invitation, error) {
+	inv, err := u.Find(ctx, ip.Email)
+	if err != nil {
+		return model.Invitation{}, err
+	}
+	if inv.AcceptedAt != nil {
+		return inv, nil
+	}
+
+	now := u.clock()
+	accepted, err := u.repo.Accept(ctx, repository.AcceptOptions{ID: inv.ID, UserID: ip.UserID, Now: now})
+	if err != nil {
+		u.l.Errorf(ctx, "invitation.usecase.Accept.Accept: %v", err)
+		return model.Invitation{}, fmt.Errorf("%w: %v", invitation.ErrInternalSystem, err)
+	}
+	if !accepted {
+		// Accepted by a concurrent login, or revoked meanwhile
+		return u.Find(ctx, ip.Email)
+	}
+
+	inv.AcceptedAt = &now
+	inv.AcceptedBy = &ip.UserID
+	u.l.Infof(ctx, "Invitation accepted: Email=%s Role=%s UserID=%s", inv.Email, inv.Role, ip.UserID)
+	return inv, nil
+}
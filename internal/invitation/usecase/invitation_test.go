@@ -0,0 +1,247 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"identity-srv/internal/invitation"
+	"identity-srv/internal/invitation/repository"
+	"identity-srv/internal/model"
+
+	"github.com/smap-hcmut/shared-libs/go/log"
+)
+
+type testLogger struct{ log.Logger }
+
+func (testLogger) Infof(context.Context, string, ...any)  {}
+func (testLogger) Errorf(context.Context, string, ...any) {}
+
+// fakeRepo keeps invitations in memory with the semantics of the postgres
+// repository. race makes the next Accept lose to a concurrent login.
+type fakeRepo struct {
+	repository.Repository
+	mu          sync.Mutex
+	invitations map[string]*model.Invitation
+	race        string
+}
+
+func (r *fakeRepo) Detail(_ context.Context, id string) (model.Invitation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	inv, ok := r.invitations[id]
+	if !ok {
+		return model.Invitation{}, repository.ErrNotFound
+	}
+	return *inv, nil
+}
+
+func (r *fakeRepo) FindActive(_ context.Context, opts repository.FindActiveOptions) (model.Invitation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var found *model.Invitation
+	for _, inv := range r.invitations {
+		if inv.Email != opts.Email || !inv.GrantsAccess(opts.Now) {
+			continue
+		}
+		if found == nil || inv.CreatedAt.After(found.CreatedAt) {
+			found = inv
+		}
+	}
+	if found == nil {
+		return model.Invitation{}, repository.ErrNotFound
+	}
+	return *found, nil
+}
+
+func (r *fakeRepo) Accept(_ context.Context, opts repository.AcceptOptions) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	inv, ok := r.invitations[opts.ID]
+	if !ok {
+		return false, nil
+	}
+	if r.race != "" {
+		other := r.race
+		r.race = ""
+		inv.AcceptedAt = &opts.Now
+		inv.AcceptedBy = &other
+	}
+	if inv.Status(opts.Now) != model.InvitationStatusPending {
+		return false, nil
+	}
+	inv.AcceptedAt = &opts.Now
+	inv.AcceptedBy = &opts.UserID
+	return true, nil
+}
+
+func (r *fakeRepo) Revoke(_ context.Context, opts repository.RevokeOptions) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	inv, ok := r.invitations[opts.ID]
+	if !ok || inv.RevokedAt != nil {
+		return false, nil
+	}
+	inv.RevokedAt = &opts.Now
+	return true, nil
+}
+
+var testNow = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func newTestUsecase(invitations ...model.Invitation) (*usecase, *fakeRepo) {
+	repo := &fakeRepo{invitations: map[string]*model.Invitation{}}
+	for i := range invitations {
+		inv := invitations[i]
+		repo.invitations[inv.ID] = &inv
+	}
+	return &usecase{
+		l:     testLogger{},
+		repo:  repo,
+		clock: func() time.Time { return testNow },
+		ttl:   7 * 24 * time.Hour,
+	}, repo
+}
+
+func pendingInvitation(id, email string) model.Invitation {
+	return model.Invitation{
+		ID:        id,
+		Email:     email,
+		Role:      "ANALYST",
+		ExpiresAt: testNow.Add(time.Hour),
+		CreatedAt: testNow.Add(-time.Hour),
+	}
+}
+
+func TestFind(t *testing.T) {
+	accepted := pendingInvitation("accepted", "accepted@example.com")
+	acceptedAt := testNow.Add(-2 * time.Hour)
+	acceptedBy := "user-1"
+	accepted.AcceptedAt, accepted.AcceptedBy = &acceptedAt, &acceptedBy
+	accepted.ExpiresAt = testNow.Add(-time.Hour) // acceptance outlives expiry
+
+	expired := pendingInvitation("expired", "expired@example.com")
+	expired.ExpiresAt = testNow
+
+	revoked := pendingInvitation("revoked", "revoked@example.com")
+	revokedAt := testNow.Add(-time.Minute)
+	revoked.RevokedAt = &revokedAt
+
+	uc, _ := newTestUsecase(pendingInvitation("pending", "pending@example.com"), accepted, expired, revoked)
+
+	tests := []struct {
+		email   string
+		wantID  string
+		wantErr error
+	}{
+		{email: "pending@example.com", wantID: "pending"},
+		{email: "accepted@example.com", wantID: "accepted"},
+		{email: "expired@example.com", wantErr: invitation.ErrInvitationNotFound},
+		{email: "revoked@example.com", wantErr: invitation.ErrInvitationNotFound},
+		{email: "unknown@example.com", wantErr: invitation.ErrInvitationNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.email, func(t *testing.T) {
+			inv, err := uc.Find(context.Background(), tt.email)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Find() error = %v, want %v", err, tt.wantErr)
+			}
+			if inv.ID != tt.wantID {
+				t.Errorf("Find() = %q, want %q", inv.ID, tt.wantID)
+			}
+		})
+	}
+}
+
+func TestAccept(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("first login accepts", func(t *testing.T) {
+		uc, repo := newTestUsecase(pendingInvitation("inv", "guest@example.com"))
+		inv, err := uc.Accept(ctx, invitation.AcceptInput{Email: "guest@example.com", UserID: "user-1"})
+		if err != nil {
+			t.Fatalf("Accept() error = %v", err)
+		}
+		if inv.AcceptedBy == nil || *inv.AcceptedBy != "user-1" || inv.AcceptedAt == nil || !inv.AcceptedAt.Equal(testNow) {
+			t.Errorf("Accept() = %+v, want accepted by user-1 at %v", inv, testNow)
+		}
+		if stored := repo.invitations["inv"]; stored.AcceptedBy == nil || *stored.AcceptedBy != "user-1" {
+			t.Errorf("stored invitation not accepted: %+v", stored)
+		}
+	})
+
+	t.Run("later login keeps the first acceptance", func(t *testing.T) {
+		uc, _ := newTestUsecase(pendingInvitation("inv", "guest@example.com"))
+		if _, err := uc.Accept(ctx, invitation.AcceptInput{Email: "guest@example.com", UserID: "user-1"}); err != nil {
+			t.Fatalf("Accept() error = %v", err)
+		}
+		inv, err := uc.Accept(ctx, invitation.AcceptInput{Email: "guest@example.com", UserID: "user-2"})
+		if err != nil {
+			t.Fatalf("second Accept() error = %v", err)
+		}
+		if *inv.AcceptedBy != "user-1" {
+			t.Errorf("second Accept() AcceptedBy = %q, want user-1", *inv.AcceptedBy)
+		}
+	})
+
+	t.Run("concurrent login accepts first", func(t *testing.T) {
+		uc, repo := newTestUsecase(pendingInvitation("inv", "guest@example.com"))
+		repo.race = "user-other"
+		inv, err := uc.Accept(ctx, invitation.AcceptInput{Email: "guest@example.com", UserID: "user-1"})
+		if err != nil {
+			t.Fatalf("Accept() error = %v", err)
+		}
+		if inv.ID != "inv" || inv.AcceptedBy == nil || *inv.AcceptedBy != "user-other" {
+			t.Errorf("Accept() = %+v, want the invitation accepted by user-other", inv)
+		}
+	})
+
+	t.Run("expired invitation", func(t *testing.T) {
+		expired := pendingInvitation("inv", "guest@example.com")
+		expired.ExpiresAt = testNow.Add(-time.Second)
+		uc, _ := newTestUsecase(expired)
+		_, err := uc.Accept(ctx, invitation.AcceptInput{Email: "guest@example.com", UserID: "user-1"})
+		if !errors.Is(err, invitation.ErrInvitationNotFound) {
+			t.Errorf("Accept() error = %v, want %v", err, invitation.ErrInvitationNotFound)
+		}
+	})
+}
+
+func TestRevoke(t *testing.T) {
+	ctx := context.Background()
+	sc := model.Scope{UserID: "admin-1"}
+
+	t.Run("accepted invitation stops granting access", func(t *testing.T) {
+		uc, _ := newTestUsecase(pendingInvitation("inv", "guest@example.com"))
+		if _, err := uc.Accept(ctx, invitation.AcceptInput{Email: "guest@example.com", UserID: "user-1"}); err != nil {
+			t.Fatalf("Accept() error = %v", err)
+		}
+		if err := uc.Revoke(ctx, sc, "inv"); err != nil {
+			t.Fatalf("Revoke() error = %v", err)
+		}
+		if _, err := uc.Find(ctx, "guest@example.com"); !errors.Is(err, invitation.ErrInvitationNotFound) {
+			t.Errorf("Find() after Revoke error = %v, want %v", err, invitation.ErrInvitationNotFound)
+		}
+		if _, err := uc.Accept(ctx, invitation.AcceptInput{Email: "guest@example.com", UserID: "user-1"}); !errors.Is(err, invitation.ErrInvitationNotFound) {
+			t.Errorf("Accept() after Revoke error = %v, want %v", err, invitation.ErrInvitationNotFound)
+		}
+	})
+
+	t.Run("already revoked", func(t *testing.T) {
+		uc, _ := newTestUsecase(pendingInvitation("inv", "guest@example.com"))
+		if err := uc.Revoke(ctx, sc, "inv"); err != nil {
+			t.Fatalf("Revoke() error = %v", err)
+		}
+		if err := uc.Revoke(ctx, sc, "inv"); err != nil {
+			t.Errorf("second Revoke() error = %v, want nil", err)
+		}
+	})
+
+	t.Run("unknown invitation", func(t *testing.T) {
+		uc, _ := newTestUsecase()
+		if err := uc.Revoke(ctx, sc, "missing"); !errors.Is(err, invitation.ErrInvitationNotFound) {
+			t.Errorf("Revoke() error = %v, want %v", err, invitation.ErrInvitationNotFound)
+		}
+	})
+}
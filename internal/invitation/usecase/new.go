@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"time"
+
+	"identity-srv/config"
+	"identity-srv/internal/invitation"
+	"identity-srv/internal/invitation/repository"
+	"identity-srv/pkg/notifier"
+
+	"github.com/smap-hcmut/shared-libs/go/log"
+)
+
+type usecase struct {
+	l        log.Logger
+	repo     repository.Repository
+	notifier notifier.Notifier
+	clock    func() time.Time
+	ttl      time.Duration
+	loginURL string
+}
+
+// New creates the invitation usecase. n delivers the invitations.
+func New(l log.Logger, repo repository.Repository, n notifier.Notifier, cfg config.InvitationConfig) invitation.UseCase {
+	return &usecase{
+		l:        l,
+		repo:     repo,
+		notifier: n,
+		clock:    time.Now,
+		ttl:      time.Duration(cfg.TTL) * time.Second,
+		loginURL: cfg.LoginURL,
+	}
+}
@@ -0,0 +1,11 @@
+package magiclink
+
+import "errors"
+
+var (
+	ErrInvalidLink     = errors.New("invalid magic link")
+	ErrLinkExpired     = errors.New("magic link expired")
+	ErrLinkUsed        = errors.New("magic link already used")
+	ErrTooManyRequests = errors.New("too many magic links requested")
+	ErrInternalSystem  = errors.New("internal system error")
+)
@@ -0,0 +1,11 @@
+package magiclink
+
+import "context"
+
+//go:generate mockery --name UseCase
+type UseCase interface {
+	// Send emails a signed, single-use login link to the address
+	Send(ctx context.Context, ip SendInput) error
+	// Redeem verifies a link token and marks it used
+	Redeem(ctx context.Context, token string) (Link, error)
+}
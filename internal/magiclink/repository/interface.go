@@ -0,0 +1,12 @@
+package repository
+
+import "context"
+
+//go:generate mockery --name Repository
+type Repository interface {
+	// Create records a sent link and returns its ID
+	Create(ctx context.Context, opts CreateOptions) (string, error)
+	CountSince(ctx context.Context, opts CountSinceOptions) (int, error)
+	// Consume marks an unexpired link as used; false means it was used or has expired
+	Consume(ctx context.Context, id string) (bool, error)
+}
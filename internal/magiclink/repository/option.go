@@ -0,0 +1,16 @@
+package repository
+
+import "time"
+
+type CreateOptions struct {
+	Email     string
+	IPAddress string
+	UserAgent string
+	ExpiresAt time.Time
+}
+
+// CountSinceOptions counts the links sent to an address since a time
+type CountSinceOptions struct {
+	Email string
+	Since time.Time
+}
@@ -0,0 +1,19 @@
+package postgres
+
+import (
+	"identity-srv/internal/magiclink/repository"
+	"identity-srv/internal/sqlboiler"
+
+	"github.com/smap-hcmut/shared-libs/go/postgres"
+)
+
+func (r *implRepository) buildMagicLink(opts repository.CreateOptions) *sqlboiler.MagicLink {
+	return &sqlboiler.MagicLink{
+		ID:        postgres.NewUUID(),
+		Email:     opts.Email,
+		IPAddress: opts.IPAddress,
+		UserAgent: opts.UserAgent,
+		ExpiresAt: opts.ExpiresAt,
+		CreatedAt: r.clock(),
+	}
+}
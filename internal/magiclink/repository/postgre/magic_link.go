@@ -0,0 +1,52 @@
+package postgres
+
+import (
+	"context"
+
+	"identity-srv/internal/magiclink/repository"
+	"identity-srv/internal/sqlboiler"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+)
+
+// Create records a sent link
+func (r *implRepository) Create(ctx context.Context, opts repository.CreateOptions) (string, error) {
+	link := r.buildMagicLink(opts)
+	if err := link.Insert(ctx, r.db, boil.Infer()); err != nil {
+		r.l.Errorf(ctx, "magiclink.repository.postgres.Create: %v", err)
+		return "", err
+	}
+	return link.ID, nil
+}
+
+// CountSince counts the links sent to an address since a time
+func (r *implRepository) CountSince(ctx context.Context, opts repository.CountSinceOptions) (int, error) {
+	count, err := sqlboiler.MagicLinks(
+		sqlboiler.MagicLinkWhere.Email.EQ(opts.Email),
+		sqlboiler.MagicLinkWhere.CreatedAt.GTE(opts.Since),
+	).Count(ctx, r.db)
+	if err != nil {
+		r.l.Errorf(ctx, "magiclink.repository.postgres.CountSince: %v", err)
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// Consume marks an unexpired link as used. The conditional update makes
+// concurrent redemptions of the same link race safely: only one succeeds.
+func (r *implRepository) Consume(ctx context.Context, id string) (bool, error) {
+	now := r.clock()
+	rows, err := sqlboiler.MagicLinks(
+		sqlboiler.MagicLinkWhere.ID.EQ(id),
+		sqlboiler.MagicLinkWhere.UsedAt.IsNull(),
+		sqlboiler.MagicLinkWhere.ExpiresAt.GT(now),
+	).UpdateAll(ctx, r.db, sqlboiler.M{
+		sqlboiler.MagicLinkColumns.UsedAt: null.TimeFrom(now),
+	})
+	if err != nil {
+		r.l.Errorf(ctx, "magiclink.repository.postgres.Consume: %v", err)
+		return false, err
+	}
+	return rows > 0, nil
+}
@@ -0,0 +1,19 @@
+package magiclink
+
+// SendInput contains the address to send a link to and the login it completes
+type SendInput struct {
+	Email       string
+	RedirectURL string
+	RememberMe  bool
+	ClientID    string // application the login is for, empty for none
+	IPAddress   string
+	UserAgent   string
+}
+
+// Link is a redeemed magic link
+type Link struct {
+	Email       string
+	RedirectURL string
+	RememberMe  bool
+	ClientID    string
+}
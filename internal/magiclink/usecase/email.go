@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"time"
+
+	"identity-srv/pkg/mailer"
+)
+
+const linkSubject = "Your login link"
+
+var linkHTML = template.Must(template.New("magic_link").Parse(`<p>Use the link below to log in. It expires in {{.Minutes}} minutes and works once.</p>
+<p><a href="{{.URL}}">Log in</a></p>
+<p>If you did not ask for this email, you can ignore it.</p>
+`))
+
+// newLinkMessage renders the email carrying a login link
+func newLinkMessage(to, linkURL string, ttl time.Duration) (mailer.Message, error) {
+	minutes := int(ttl / time.Minute)
+
+	var html bytes.Buffer
+	if err := linkHTML.Execute(&html, struct {
+		URL     string
+		Minutes int
+	}{linkURL, minutes}); err != nil {
+		return mailer.Message{}, fmt.Errorf("render magic link email: %w", err)
+	}
+
+	return mailer.Message{
+		To:      to,
+		Subject: linkSubject,
+		Text: fmt.Sprintf("Use the link below to log in. It expires in %d minutes and works once.\n\n%s\n\nIf you did not ask for this email, you can ignore it.\n",
+			minutes, linkURL),
+		HTML: html.String(),
+	}, nil
+}
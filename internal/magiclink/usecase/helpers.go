@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"identity-srv/internal/magiclink"
+
+	"github.com/golang-jwt/jwt"
+)
+
+// linkType is the "type" claim of magic-link tokens
+const linkType = "magic_link"
+
+// linkKey derives the HMAC key of link tokens from the JWT secret, so a link
+// is never accepted as an access token
+func (u *usecase) linkKey() []byte {
+	mac := hmac.New(sha256.New, u.signingKey)
+	mac.Write([]byte(linkType))
+	return mac.Sum(nil)
+}
+
+// signLink signs the token of the link recorded as id
+func (u *usecase) signLink(id string, ip magiclink.SendInput, now, expiresAt time.Time) (string, error) {
+	if len(u.signingKey) == 0 {
+		return "", fmt.Errorf("link signing key not configured")
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, linkClaims{
+		StandardClaims: jwt.StandardClaims{
+			Id:        id,
+			Subject:   ip.Email,
+			Issuer:    u.issuer,
+			IssuedAt:  now.Unix(),
+			ExpiresAt: expiresAt.Unix(),
+		},
+		Type:       linkType,
+		Redirect:   ip.RedirectURL,
+		RememberMe: ip.RememberMe,
+		ClientID:   ip.ClientID,
+	}).SignedString(u.linkKey())
+}
+
+// parseLink verifies a link token. An expired token returns ErrLinkExpired.
+func (u *usecase) parseLink(token string) (linkClaims, error) {
+	var claims linkClaims
+	_, err := jwt.ParseWithClaims(token, &claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return u.linkKey(), nil
+	})
+	if err != nil {
+		var vErr *jwt.ValidationError
+		if errors.As(err, &vErr) && vErr.Errors == jwt.ValidationErrorExpired {
+			return linkClaims{}, magiclink.ErrLinkExpired
+		}
+		return linkClaims{}, magiclink.ErrInvalidLink
+	}
+	if claims.Type != linkType || claims.Issuer != u.issuer || claims.Id == "" || claims.Subject == "" {
+		return linkClaims{}, magiclink.ErrInvalidLink
+	}
+	return claims, nil
+}
+
+// buildLinkURL appends the token to the page that redeems links
+func (u *usecase) buildLinkURL(token string) (string, error) {
+	parsed, err := url.Parse(u.linkURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid magic link url: %w", err)
+	}
+	q := parsed.Query()
+	q.Set("token", token)
+	parsed.RawQuery = q.Encode()
+	return parsed.String(), nil
+}
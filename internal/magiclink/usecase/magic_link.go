@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"identity-srv/internal/magiclink"
+	"identity-srv/internal/magiclink/repository"
+)
+
+// Send records a link, then emails it. The address must already be normalized
+// and allowed to log in; the caller applies the access rules.
+func (u *usecase) Send(ctx context.Context, ip magiclink.SendInput) error {
+	now := u.clock()
+	count, err := u.repo.CountSince(ctx, repository.CountSinceOptions{
+		Email: ip.Email,
+		Since: now.Add(-time.Hour),
+	})
+	if err != nil {
+		u.l.Errorf(ctx, "magiclink.usecase.Send.CountSince: %v", err)
+		return fmt.Errorf("%w: %v", magiclink.ErrInternalSystem, err)
+	}
+	if count >= u.maxPerHour {
+		u.l.Warnf(ctx, "magiclink.usecase.Send: %d links sent to %s in the last hour", count, ip.Email)
+		return magiclink.ErrTooManyRequests
+	}
+
+	expiresAt := now.Add(u.ttl)
+	id, err := u.repo.Create(ctx, repository.CreateOptions{
+		Email:     ip.Email,
+		IPAddress: ip.IPAddress,
+		UserAgent: ip.UserAgent,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		u.l.Errorf(ctx, "magiclink.usecase.Send.Create: %v", err)
+		return fmt.Errorf("%w: %v", magiclink.ErrInternalSystem, err)
+	}
+
+	token, err := u.signLink(id, ip, now, expiresAt)
+	if err != nil {
+		u.l.Errorf(ctx, "magiclink.usecase.Send.signLink: %v", err)
+		return fmt.Errorf("%w: %v", magiclink.ErrInternalSystem, err)
+	}
+	linkURL, err := u.buildLinkURL(token)
+	if err != nil {
+		u.l.Errorf(ctx, "magiclink.usecase.Send.buildLinkURL: %v", err)
+		return fmt.Errorf("%w: %v", magiclink.ErrInternalSystem, err)
+	}
+
+	msg, err := newLinkMessage(ip.Email, linkURL, u.ttl)
+	if err != nil {
+		u.l.Errorf(ctx, "magiclink.usecase.Send.newLinkMessage: %v", err)
+		return fmt.Errorf("%w: %v", magiclink.ErrInternalSystem, err)
+	}
+	if err := u.mailer.Send(ctx, msg); err != nil {
+		u.l.Errorf(ctx, "magiclink.usecase.Send.Send: %v", err)
+		return fmt.Errorf("%w: %v", magiclink.ErrInternalSystem, err)
+	}
+
+	u.l.Infof(ctx, "Magic link sent: Email=%s ID=%s ip=%s", ip.Email, id, ip.IPAddress)
+	return nil
+}
+
+// Redeem verifies the token, then consumes its link so it cannot be used again
+func (u *usecase) Redeem(ctx context.Context, token string) (magiclink.Link, error) {
+	claims, err := u.parseLink(token)
+	if err != nil {
+		return magiclink.Link{}, err
+	}
+
+	consumed, err := u.repo.Consume(ctx, claims.Id)
+	if err != nil {
+		u.l.Errorf(ctx, "magiclink.usecase.Redeem.Consume: %v", err)
+		return magiclink.Link{}, fmt.Errorf("%w: %v", magiclink.ErrInternalSystem, err)
+	}
+	if !consumed {
+		u.l.Warnf(ctx, "magiclink.usecase.Redeem: link %s for %s already used", claims.Id, claims.Subject)
+		return magiclink.Link{}, magiclink.ErrLinkUsed
+	}
+
+	return magiclink.Link{
+		Email:       claims.Subject,
+		RedirectURL: claims.Redirect,
+		RememberMe:  claims.RememberMe,
+		ClientID:    claims.ClientID,
+	}, nil
+}
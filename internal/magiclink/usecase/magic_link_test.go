@@ -0,0 +1,175 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"identity-srv/internal/magiclink"
+	"identity-srv/internal/magiclink/repository"
+	"identity-srv/pkg/mailer"
+
+	"github.com/smap-hcmut/shared-libs/go/log"
+)
+
+type testLogger struct{ log.Logger }
+
+func (testLogger) Infof(context.Context, string, ...any)  {}
+func (testLogger) Warnf(context.Context, string, ...any)  {}
+func (testLogger) Errorf(context.Context, string, ...any) {}
+
+// fakeRepo records links with the consume-once semantics of the postgres repository
+type fakeRepo struct {
+	repository.Repository
+
+	mu    sync.Mutex
+	links []fakeLink
+}
+
+type fakeLink struct {
+	expiresAt time.Time
+	used      bool
+}
+
+func (r *fakeRepo) Create(ctx context.Context, opts repository.CreateOptions) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.links = append(r.links, fakeLink{expiresAt: opts.ExpiresAt})
+	return strconv.Itoa(len(r.links)), nil
+}
+
+func (r *fakeRepo) CountSince(ctx context.Context, opts repository.CountSinceOptions) (int, error) {
+	return 0, nil
+}
+
+func (r *fakeRepo) Consume(ctx context.Context, id string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	i, err := strconv.Atoi(id)
+	if err != nil || i < 1 || i > len(r.links) {
+		return false, nil
+	}
+	link := &r.links[i-1]
+	if link.used || !link.expiresAt.After(time.Now()) {
+		return false, nil
+	}
+	link.used = true
+	return true, nil
+}
+
+// recordingMailer keeps the last message it was asked to send
+type recordingMailer struct {
+	last mailer.Message
+}
+
+func (m *recordingMailer) Send(ctx context.Context, msg mailer.Message) error {
+	m.last = msg
+	return nil
+}
+
+// sendLink sends a link with the clock at now and returns its token
+func sendLink(t *testing.T, now time.Time) (*usecase, string) {
+	t.Helper()
+	m := &recordingMailer{}
+	uc := &usecase{
+		l:          testLogger{},
+		repo:       &fakeRepo{},
+		mailer:     m,
+		clock:      func() time.Time { return now },
+		signingKey: []byte("0123456789abcdef0123456789abcdef"),
+		issuer:     "identity-srv",
+		linkURL:    "https://app.tantai.dev/auth/magic-link",
+		ttl:        15 * time.Minute,
+		maxPerHour: 5,
+	}
+	if err := uc.Send(context.Background(), magiclink.SendInput{
+		Email:       "a@tantai.dev",
+		RedirectURL: "/dashboard",
+		ClientID:    "smap-web",
+	}); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	for _, field := range strings.Fields(m.last.Text) {
+		if u, err := url.Parse(field); err == nil && strings.HasPrefix(field, uc.linkURL) {
+			return uc, u.Query().Get("token")
+		}
+	}
+	t.Fatalf("Send() message has no link: %q", m.last.Text)
+	return nil, ""
+}
+
+func TestRedeemOnce(t *testing.T) {
+	ctx := context.Background()
+	uc, token := sendLink(t, time.Now())
+
+	link, err := uc.Redeem(ctx, token)
+	if err != nil {
+		t.Fatalf("Redeem() error = %v", err)
+	}
+	if link.Email != "a@tantai.dev" || link.RedirectURL != "/dashboard" || link.ClientID != "smap-web" {
+		t.Fatalf("Redeem() = %+v, want the login the link was sent for", link)
+	}
+
+	if _, err := uc.Redeem(ctx, token); !errors.Is(err, magiclink.ErrLinkUsed) {
+		t.Fatalf("second Redeem() error = %v, want %v", err, magiclink.ErrLinkUsed)
+	}
+}
+
+func TestRedeemConcurrently(t *testing.T) {
+	uc, token := sendLink(t, time.Now())
+
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		redeemed  int
+		otherErrs []error
+	)
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := uc.Redeem(context.Background(), token)
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err == nil:
+				redeemed++
+			case !errors.Is(err, magiclink.ErrLinkUsed):
+				otherErrs = append(otherErrs, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if redeemed != 1 || len(otherErrs) != 0 {
+		t.Fatalf("Redeem() succeeded %d times with errors %v, want once", redeemed, otherErrs)
+	}
+}
+
+func TestRedeemRefused(t *testing.T) {
+	tests := []struct {
+		name   string
+		sentAt time.Time
+		mangle func(token string) string
+		want   error
+	}{
+		{name: "expired", sentAt: time.Now().Add(-time.Hour), mangle: func(token string) string { return token }, want: magiclink.ErrLinkExpired},
+		{name: "tampered", sentAt: time.Now(), mangle: func(token string) string { return token[:len(token)-2] + "xx" }, want: magiclink.ErrInvalidLink},
+		{name: "not a token", sentAt: time.Now(), mangle: func(string) string { return "garbage" }, want: magiclink.ErrInvalidLink},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, token := sendLink(t, tt.sentAt)
+			if _, err := uc.Redeem(context.Background(), tt.mangle(token)); !errors.Is(err, tt.want) {
+				t.Fatalf("Redeem() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
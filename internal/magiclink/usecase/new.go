@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"time"
+
+	"identity-srv/config"
+	"identity-srv/internal/magiclink"
+	"identity-srv/internal/magiclink/repository"
+	"identity-srv/pkg/mailer"
+
+	"github.com/smap-hcmut/shared-libs/go/log"
+)
+
+type usecase struct {
+	l          log.Logger
+	repo       repository.Repository
+	mailer     mailer.Mailer
+	clock      func() time.Time
+	signingKey []byte
+	issuer     string
+	linkURL    string
+	ttl        time.Duration
+	maxPerHour int
+}
+
+// New creates the magic-link usecase. Link tokens are signed with a key
+// derived from signingKey (the JWT secret).
+func New(l log.Logger, repo repository.Repository, m mailer.Mailer, cfg config.MagicLinkConfig, signingKey, issuer string) magiclink.UseCase {
+	return &usecase{
+		l:          l,
+		repo:       repo,
+		mailer:     m,
+		clock:      time.Now,
+		signingKey: []byte(signingKey),
+		issuer:     issuer,
+		linkURL:    cfg.URL,
+		ttl:        time.Duration(cfg.TTL) * time.Second,
+		maxPerHour: cfg.MaxPerHour,
+	}
+}
@@ -0,0 +1,13 @@
+package usecase
+
+import "github.com/golang-jwt/jwt"
+
+// linkClaims carry the login a magic link completes. The subject is the email
+// address and the ID is the row recording whether the link was used.
+type linkClaims struct {
+	jwt.StandardClaims
+	Type       string `json:"type"`
+	Redirect   string `json:"redirect,omitempty"`
+	RememberMe bool   `json:"remember_me,omitempty"`
+	ClientID   string `json:"client_id,omitempty"`
+}
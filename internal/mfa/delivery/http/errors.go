@@ -0,0 +1,42 @@
+package http
+
+import (
+	"errors"
+	"identity-srv/internal/mfa"
+
+	pkgErrors "github.com/smap-hcmut/shared-libs/go/errors"
+)
+
+// --- HTTP error constants ---
+
+var (
+	errWrongBody       = pkgErrors.NewHTTPError(23001, "Wrong body")
+	errNotEnrolled     = pkgErrors.NewHTTPError(23002, "MFA not enrolled")
+	errAlreadyEnrolled = pkgErrors.NewHTTPError(23003, "MFA already enrolled")
+	errWrongCode       = pkgErrors.NewHTTPError(23004, "Wrong OTP")
+	errTooManyAttempts = pkgErrors.NewHTTPError(23005, "Too many attempts")
+	errInternalSystem  = pkgErrors.NewHTTPError(23006, "Internal system error")
+	errScopeNotFound   = pkgErrors.NewHTTPError(23007, "Scope not found")
+)
+
+// mapError maps UseCase domain errors to HTTP errors
+func (h handler) mapError(err error) error {
+	switch {
+	case errors.Is(err, mfa.ErrNotEnrolled):
+		return errNotEnrolled
+	case errors.Is(err, mfa.ErrAlreadyEnrolled):
+		return errAlreadyEnrolled
+	case errors.Is(err, mfa.ErrWrongCode):
+		return errWrongCode
+	case errors.Is(err, mfa.ErrTooManyAttempts):
+		return errTooManyAttempts
+	case errors.Is(err, mfa.ErrInternalSystem):
+		return errInternalSystem
+	default:
+		return err
+	}
+}
+
+var NotFound = []error{
+	errNotEnrolled,
+}
@@ -0,0 +1,179 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/smap-hcmut/shared-libs/go/response"
+)
+
+// Status
+// @Summary MFA Status
+// @Description Whether the current user has a confirmed (or pending) TOTP factor and how many recovery codes are left.
+// @Tags MFA
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.Resp{data=statusResp} "MFA status"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/mfa [GET]
+// @Security CookieAuth
+func (h handler) Status(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	sc, err := h.processScopeRequest(c)
+	if err != nil {
+		response.Error(c, err, h.discord)
+		return
+	}
+
+	// 2. Call UseCase
+	output, err := h.uc.Status(ctx, sc)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.Status: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	response.OK(c, h.newStatusResp(output))
+}
+
+// Enroll
+// @Summary Start TOTP Enrolment
+// @Description Generate a TOTP secret and otpauth URI (render it as a QR code). The factor is enforced only after /authentication/mfa/confirm. Calling again replaces a pending secret.
+// @Tags MFA
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.Resp{data=enrollResp} "Secret and otpauth URI (shown once)"
+// @Failure 400 {object} response.Resp "Already enrolled"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/mfa/enroll [POST]
+// @Security CookieAuth
+func (h handler) Enroll(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	sc, err := h.processScopeRequest(c)
+	if err != nil {
+		response.Error(c, err, h.discord)
+		return
+	}
+
+	// 2. Call UseCase
+	output, err := h.uc.Enroll(ctx, sc)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.Enroll: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	response.OK(c, h.newEnrollResp(output))
+}
+
+// Confirm
+// @Summary Confirm TOTP Enrolment
+// @Description Confirm enrolment with a code from the authenticator app. Returns recovery codes, shown only once.
+// @Tags MFA
+// @Accept json
+// @Produce json
+// @Param body body codeReq true "TOTP code"
+// @Success 200 {object} response.Resp{data=recoveryCodesResp} "Recovery codes (shown once)"
+// @Failure 400 {object} response.Resp "Wrong OTP, too many attempts or not enrolled"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/mfa/confirm [POST]
+// @Security CookieAuth
+func (h handler) Confirm(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	code, sc, err := h.processCodeRequest(c)
+	if err != nil {
+		response.Error(c, err, h.discord)
+		return
+	}
+
+	// 2. Call UseCase
+	output, err := h.uc.Confirm(ctx, sc, code)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.Confirm: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	response.OK(c, h.newRecoveryCodesResp(output))
+}
+
+// RegenerateRecoveryCodes
+// @Summary Regenerate Recovery Codes
+// @Description Replace all recovery codes. Requires a TOTP or recovery code.
+// @Tags MFA
+// @Accept json
+// @Produce json
+// @Param body body codeReq true "TOTP or recovery code"
+// @Success 200 {object} response.Resp{data=recoveryCodesResp} "Recovery codes (shown once)"
+// @Failure 400 {object} response.Resp "Wrong OTP or too many attempts"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 404 {object} response.Resp "Not enrolled"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/mfa/recovery-codes [POST]
+// @Security CookieAuth
+func (h handler) RegenerateRecoveryCodes(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	code, sc, err := h.processCodeRequest(c)
+	if err != nil {
+		response.Error(c, err, h.discord)
+		return
+	}
+
+	// 2. Call UseCase
+	output, err := h.uc.RegenerateRecoveryCodes(ctx, sc, code)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.RegenerateRecoveryCodes: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	response.OK(c, h.newRecoveryCodesResp(output))
+}
+
+// Disable
+// @Summary Disable MFA
+// @Description Remove the TOTP factor and recovery codes. Requires a TOTP or recovery code. Users whose role requires MFA must enrol again at their next login.
+// @Tags MFA
+// @Accept json
+// @Produce json
+// @Param body body codeReq true "TOTP or recovery code"
+// @Success 200 {object} response.Resp "MFA disabled"
+// @Failure 400 {object} response.Resp "Wrong OTP or too many attempts"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 404 {object} response.Resp "Not enrolled"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/mfa/disable [POST]
+// @Security CookieAuth
+func (h handler) Disable(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	code, sc, err := h.processCodeRequest(c)
+	if err != nil {
+		response.Error(c, err, h.discord)
+		return
+	}
+
+	// 2. Call UseCase
+	if err := h.uc.Disable(ctx, sc, code); err != nil {
+		h.l.Errorf(ctx, "uc.Disable: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	response.OK(c, gin.H{"message": "MFA disabled"})
+}
@@ -0,0 +1,54 @@
+package http
+
+import (
+	"identity-srv/internal/mfa"
+	"time"
+)
+
+// --- Request DTOs ---
+
+type codeReq struct {
+	Code string `json:"code" binding:"required"` // 6-digit TOTP code or a recovery code
+}
+
+// --- Response DTOs ---
+
+type statusResp struct {
+	Enrolled          bool       `json:"enrolled"`
+	Pending           bool       `json:"pending"`
+	ConfirmedAt       *time.Time `json:"confirmed_at,omitempty"`
+	RecoveryCodesLeft int        `json:"recovery_codes_left"`
+}
+
+type enrollResp struct {
+	Secret     string `json:"secret"`      // base32, for manual entry
+	OTPAuthURI string `json:"otpauth_uri"` // render as a QR code
+}
+
+type recoveryCodesResp struct {
+	RecoveryCodes []string `json:"recovery_codes"` // shown once
+}
+
+// --- Response Mappers ---
+
+func (h handler) newStatusResp(o mfa.StatusOutput) statusResp {
+	return statusResp{
+		Enrolled:          o.Enrolled,
+		Pending:           o.Pending,
+		ConfirmedAt:       o.ConfirmedAt,
+		RecoveryCodesLeft: o.RecoveryCodesLeft,
+	}
+}
+
+func (h handler) newEnrollResp(o mfa.EnrollOutput) enrollResp {
+	return enrollResp{
+		Secret:     o.Secret,
+		OTPAuthURI: o.OTPAuthURI,
+	}
+}
+
+func (h handler) newRecoveryCodesResp(o mfa.RecoveryCodesOutput) recoveryCodesResp {
+	return recoveryCodesResp{
+		RecoveryCodes: o.RecoveryCodes,
+	}
+}
@@ -0,0 +1,48 @@
+package http
+
+import (
+	"identity-srv/internal/model"
+
+	"github.com/gin-gonic/gin"
+	"github.com/smap-hcmut/shared-libs/go/auth"
+)
+
+// --- Scope extraction ---
+
+func (h handler) getScope(c *gin.Context) (model.Scope, bool) {
+	payload, ok := auth.GetPayloadFromContext(c.Request.Context())
+	if !ok || payload.UserID == "" {
+		return model.Scope{}, false
+	}
+	return model.Scope{
+		UserID:    payload.UserID,
+		Username:  payload.Username,
+		Role:      payload.Role,
+		JTI:       payload.Id,
+		ExpiresAt: payload.ExpiresAt,
+	}, true
+}
+
+// --- Process request functions ---
+
+func (h handler) processScopeRequest(c *gin.Context) (model.Scope, error) {
+	sc, ok := h.getScope(c)
+	if !ok {
+		return model.Scope{}, errScopeNotFound
+	}
+	return sc, nil
+}
+
+// processCodeRequest reads the TOTP or recovery code that authorizes the action
+func (h handler) processCodeRequest(c *gin.Context) (string, model.Scope, error) {
+	sc, ok := h.getScope(c)
+	if !ok {
+		return "", model.Scope{}, errScopeNotFound
+	}
+
+	var req codeReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		return "", model.Scope{}, errWrongBody
+	}
+	return req.Code, sc, nil
+}
@@ -0,0 +1,17 @@
+package http
+
+import (
+	internalmw "identity-srv/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/smap-hcmut/shared-libs/go/middleware"
+)
+
+func (h handler) RegisterRoutes(r *gin.RouterGroup, mw *middleware.Middleware, imw *internalmw.Middleware) {
+	// Self-service management (require an unrevoked user token)
+	r.GET("", mw.Auth(), imw.SelfService(), h.Status)
+	r.POST("/enroll", mw.Auth(), imw.SelfService(), h.Enroll)
+	r.POST("/confirm", mw.Auth(), imw.SelfService(), h.Confirm)
+	r.POST("/recovery-codes", mw.Auth(), imw.SelfService(), h.RegenerateRecoveryCodes)
+	r.POST("/disable", mw.Auth(), imw.SelfService(), h.Disable)
+}
@@ -0,0 +1,11 @@
+package mfa
+
+import "errors"
+
+var (
+	ErrNotEnrolled     = errors.New("mfa not enrolled")
+	ErrAlreadyEnrolled = errors.New("mfa already enrolled")
+	ErrWrongCode       = errors.New("wrong mfa code")
+	ErrTooManyAttempts = errors.New("too many mfa attempts")
+	ErrInternalSystem  = errors.New("internal system error")
+)
@@ -0,0 +1,21 @@
+package mfa
+
+import (
+	"context"
+
+	"identity-srv/internal/model"
+)
+
+//go:generate mockery --name UseCase
+type UseCase interface {
+	// Self-service management (scoped to the caller)
+	Status(ctx context.Context, sc model.Scope) (StatusOutput, error)
+	Enroll(ctx context.Context, sc model.Scope) (EnrollOutput, error)
+	Confirm(ctx context.Context, sc model.Scope, code string) (RecoveryCodesOutput, error)
+	RegenerateRecoveryCodes(ctx context.Context, sc model.Scope, code string) (RecoveryCodesOutput, error)
+	Disable(ctx context.Context, sc model.Scope, code string) error
+
+	// Verification (used by the authentication step-up challenge)
+	IsEnrolled(ctx context.Context, userID string) (bool, error)
+	Verify(ctx context.Context, userID, code string) error
+}
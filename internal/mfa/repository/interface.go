@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+
+	"identity-srv/internal/model"
+)
+
+//go:generate mockery --name Repository
+type Repository interface {
+	Detail(ctx context.Context, userID string) (model.TOTPFactor, error)
+	Upsert(ctx context.Context, opts UpsertOptions) (model.TOTPFactor, error)
+	Confirm(ctx context.Context, opts ConfirmOptions) error
+	Delete(ctx context.Context, userID string) error
+
+	// Compare-and-set updates; false means the row changed concurrently
+	UpdateAttempts(ctx context.Context, opts UpdateAttemptsOptions) (bool, error)
+	ConsumeStep(ctx context.Context, opts ConsumeStepOptions) (bool, error)
+
+	// Recovery codes
+	ReplaceRecoveryCodes(ctx context.Context, opts ReplaceRecoveryCodesOptions) error
+	ConsumeRecoveryCode(ctx context.Context, opts ConsumeRecoveryCodeOptions) (bool, error)
+	CountRecoveryCodes(ctx context.Context, userID string) (int, error)
+}
@@ -0,0 +1,41 @@
+package repository
+
+import "time"
+
+// UpsertOptions starts a new enrolment, replacing any unconfirmed factor
+type UpsertOptions struct {
+	UserID          string
+	SecretEncrypted string
+}
+
+// ConfirmOptions completes enrolment and stores the first recovery codes
+type ConfirmOptions struct {
+	UserID     string
+	CodeHashes []string
+}
+
+// UpdateAttemptsOptions sets the failed attempt counter and lock.
+// When Expected is set the update only applies if the counter still has that value.
+type UpdateAttemptsOptions struct {
+	UserID         string
+	Expected       *int
+	FailedAttempts int
+	LockedUntil    *time.Time
+}
+
+// ConsumeStepOptions records an accepted TOTP time step.
+// It fails when the step is not newer than the last accepted one (replay).
+type ConsumeStepOptions struct {
+	UserID string
+	Step   int64
+}
+
+type ReplaceRecoveryCodesOptions struct {
+	UserID     string
+	CodeHashes []string
+}
+
+type ConsumeRecoveryCodeOptions struct {
+	UserID   string
+	CodeHash string
+}
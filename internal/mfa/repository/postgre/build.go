@@ -0,0 +1,41 @@
+package postgres
+
+import (
+	"identity-srv/internal/mfa/repository"
+	"identity-srv/internal/sqlboiler"
+
+	"github.com/aarondl/null/v8"
+	"github.com/smap-hcmut/shared-libs/go/postgres"
+)
+
+func (r *implRepository) buildFactor(opts repository.UpsertOptions) *sqlboiler.UserTotp {
+	now := r.clock()
+	return &sqlboiler.UserTotp{
+		UserID:          opts.UserID,
+		SecretEncrypted: opts.SecretEncrypted,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+}
+
+func (r *implRepository) buildRecoveryCodes(userID string, codeHashes []string) []*sqlboiler.MfaRecoveryCode {
+	now := r.clock()
+	codes := make([]*sqlboiler.MfaRecoveryCode, 0, len(codeHashes))
+	for _, hash := range codeHashes {
+		codes = append(codes, &sqlboiler.MfaRecoveryCode{
+			ID:        postgres.NewUUID(),
+			UserID:    userID,
+			CodeHash:  hash,
+			CreatedAt: now,
+		})
+	}
+	return codes
+}
+
+func (r *implRepository) buildAttemptsColumns(opts repository.UpdateAttemptsOptions) sqlboiler.M {
+	return sqlboiler.M{
+		sqlboiler.UserTotpColumns.FailedAttempts: opts.FailedAttempts,
+		sqlboiler.UserTotpColumns.LockedUntil:    null.TimeFromPtr(opts.LockedUntil),
+		sqlboiler.UserTotpColumns.UpdatedAt:      r.clock(),
+	}
+}
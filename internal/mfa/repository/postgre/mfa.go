@@ -0,0 +1,209 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"identity-srv/internal/mfa/repository"
+	"identity-srv/internal/model"
+	"identity-srv/internal/sqlboiler"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// Detail finds the TOTP factor of a user
+func (r *implRepository) Detail(ctx context.Context, userID string) (model.TOTPFactor, error) {
+	factor, err := sqlboiler.FindUserTotp(ctx, r.db, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.TOTPFactor{}, repository.ErrNotFound
+		}
+		r.l.Errorf(ctx, "mfa.repository.postgres.Detail: %v", err)
+		return model.TOTPFactor{}, err
+	}
+	return *model.NewTOTPFactorFromDB(factor), nil
+}
+
+// Upsert stores a new unconfirmed factor, replacing the previous one of the user
+func (r *implRepository) Upsert(ctx context.Context, opts repository.UpsertOptions) (model.TOTPFactor, error) {
+	factor := r.buildFactor(opts)
+	if err := factor.Upsert(ctx, r.db, true,
+		[]string{sqlboiler.UserTotpColumns.UserID},
+		boil.Blacklist(sqlboiler.UserTotpColumns.UserID, sqlboiler.UserTotpColumns.CreatedAt),
+		boil.Infer(),
+	); err != nil {
+		r.l.Errorf(ctx, "mfa.repository.postgres.Upsert: %v", err)
+		return model.TOTPFactor{}, err
+	}
+	return *model.NewTOTPFactorFromDB(factor), nil
+}
+
+// Confirm marks the factor as confirmed and stores its first recovery codes in one transaction
+func (r *implRepository) Confirm(ctx context.Context, opts repository.ConfirmOptions) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.l.Errorf(ctx, "mfa.repository.postgres.Confirm.BeginTx: %v", err)
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	now := r.clock()
+	rows, err := sqlboiler.UserTotps(
+		sqlboiler.UserTotpWhere.UserID.EQ(opts.UserID),
+		sqlboiler.UserTotpWhere.ConfirmedAt.IsNull(),
+	).UpdateAll(ctx, tx, sqlboiler.M{
+		sqlboiler.UserTotpColumns.ConfirmedAt: null.TimeFrom(now),
+		sqlboiler.UserTotpColumns.UpdatedAt:   now,
+	})
+	if err != nil {
+		r.l.Errorf(ctx, "mfa.repository.postgres.Confirm.UpdateAll: %v", err)
+		return err
+	}
+	if rows == 0 {
+		return repository.ErrNotFound
+	}
+
+	if err := r.replaceRecoveryCodes(ctx, tx, opts.UserID, opts.CodeHashes); err != nil {
+		r.l.Errorf(ctx, "mfa.repository.postgres.Confirm.replaceRecoveryCodes: %v", err)
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.l.Errorf(ctx, "mfa.repository.postgres.Confirm.Commit: %v", err)
+		return err
+	}
+	return nil
+}
+
+// Delete removes the factor and the recovery codes of a user
+func (r *implRepository) Delete(ctx context.Context, userID string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.l.Errorf(ctx, "mfa.repository.postgres.Delete.BeginTx: %v", err)
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := sqlboiler.MfaRecoveryCodes(
+		sqlboiler.MfaRecoveryCodeWhere.UserID.EQ(userID),
+	).DeleteAll(ctx, tx); err != nil {
+		r.l.Errorf(ctx, "mfa.repository.postgres.Delete.MfaRecoveryCodes: %v", err)
+		return err
+	}
+	rows, err := sqlboiler.UserTotps(
+		sqlboiler.UserTotpWhere.UserID.EQ(userID),
+	).DeleteAll(ctx, tx)
+	if err != nil {
+		r.l.Errorf(ctx, "mfa.repository.postgres.Delete.UserTotps: %v", err)
+		return err
+	}
+	if rows == 0 {
+		return repository.ErrNotFound
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.l.Errorf(ctx, "mfa.repository.postgres.Delete.Commit: %v", err)
+		return err
+	}
+	return nil
+}
+
+// UpdateAttempts sets the failed attempt counter and lock, optionally only if
+// the counter still has the expected value
+func (r *implRepository) UpdateAttempts(ctx context.Context, opts repository.UpdateAttemptsOptions) (bool, error) {
+	mods := []qm.QueryMod{sqlboiler.UserTotpWhere.UserID.EQ(opts.UserID)}
+	if opts.Expected != nil {
+		mods = append(mods, sqlboiler.UserTotpWhere.FailedAttempts.EQ(*opts.Expected))
+	}
+
+	rows, err := sqlboiler.UserTotps(mods...).UpdateAll(ctx, r.db, r.buildAttemptsColumns(opts))
+	if err != nil {
+		r.l.Errorf(ctx, "mfa.repository.postgres.UpdateAttempts: %v", err)
+		return false, err
+	}
+	return rows == 1, nil
+}
+
+// ConsumeStep records an accepted time step unless it was already used
+func (r *implRepository) ConsumeStep(ctx context.Context, opts repository.ConsumeStepOptions) (bool, error) {
+	rows, err := sqlboiler.UserTotps(
+		sqlboiler.UserTotpWhere.UserID.EQ(opts.UserID),
+		sqlboiler.UserTotpWhere.LastUsedStep.LT(opts.Step),
+	).UpdateAll(ctx, r.db, sqlboiler.M{
+		sqlboiler.UserTotpColumns.LastUsedStep: opts.Step,
+		sqlboiler.UserTotpColumns.UpdatedAt:    r.clock(),
+	})
+	if err != nil {
+		r.l.Errorf(ctx, "mfa.repository.postgres.ConsumeStep: %v", err)
+		return false, err
+	}
+	return rows == 1, nil
+}
+
+// ReplaceRecoveryCodes deletes the user's recovery codes and stores new ones
+func (r *implRepository) ReplaceRecoveryCodes(ctx context.Context, opts repository.ReplaceRecoveryCodesOptions) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.l.Errorf(ctx, "mfa.repository.postgres.ReplaceRecoveryCodes.BeginTx: %v", err)
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err := r.replaceRecoveryCodes(ctx, tx, opts.UserID, opts.CodeHashes); err != nil {
+		r.l.Errorf(ctx, "mfa.repository.postgres.ReplaceRecoveryCodes: %v", err)
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.l.Errorf(ctx, "mfa.repository.postgres.ReplaceRecoveryCodes.Commit: %v", err)
+		return err
+	}
+	return nil
+}
+
+// ConsumeRecoveryCode marks an unused recovery code as used
+func (r *implRepository) ConsumeRecoveryCode(ctx context.Context, opts repository.ConsumeRecoveryCodeOptions) (bool, error) {
+	rows, err := sqlboiler.MfaRecoveryCodes(
+		sqlboiler.MfaRecoveryCodeWhere.UserID.EQ(opts.UserID),
+		sqlboiler.MfaRecoveryCodeWhere.CodeHash.EQ(opts.CodeHash),
+		sqlboiler.MfaRecoveryCodeWhere.UsedAt.IsNull(),
+	).UpdateAll(ctx, r.db, sqlboiler.M{
+		sqlboiler.MfaRecoveryCodeColumns.UsedAt: null.TimeFrom(r.clock()),
+	})
+	if err != nil {
+		r.l.Errorf(ctx, "mfa.repository.postgres.ConsumeRecoveryCode: %v", err)
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+// CountRecoveryCodes returns the number of unused recovery codes of a user
+func (r *implRepository) CountRecoveryCodes(ctx context.Context, userID string) (int, error) {
+	count, err := sqlboiler.MfaRecoveryCodes(
+		sqlboiler.MfaRecoveryCodeWhere.UserID.EQ(userID),
+		sqlboiler.MfaRecoveryCodeWhere.UsedAt.IsNull(),
+	).Count(ctx, r.db)
+	if err != nil {
+		r.l.Errorf(ctx, "mfa.repository.postgres.CountRecoveryCodes: %v", err)
+		return 0, err
+	}
+	return int(count), nil
+}
+
+func (r *implRepository) replaceRecoveryCodes(ctx context.Context, exec boil.ContextExecutor, userID string, codeHashes []string) error {
+	if _, err := sqlboiler.MfaRecoveryCodes(
+		sqlboiler.MfaRecoveryCodeWhere.UserID.EQ(userID),
+	).DeleteAll(ctx, exec); err != nil {
+		return fmt.Errorf("DeleteAll: %w", err)
+	}
+	for _, code := range r.buildRecoveryCodes(userID, codeHashes) {
+		if err := code.Insert(ctx, exec, boil.Infer()); err != nil {
+			return fmt.Errorf("Insert: %w", err)
+		}
+	}
+	return nil
+}
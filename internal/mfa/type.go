@@ -0,0 +1,22 @@
+package mfa
+
+import "time"
+
+// StatusOutput describes the caller's second factor
+type StatusOutput struct {
+	Enrolled          bool // confirmed and enforced at login
+	Pending           bool // enrolment started but not confirmed
+	ConfirmedAt       *time.Time
+	RecoveryCodesLeft int
+}
+
+// EnrollOutput contains a new TOTP secret, shown once
+type EnrollOutput struct {
+	Secret     string // base32, for manual entry
+	OTPAuthURI string // otpauth://totp/... for QR codes
+}
+
+// RecoveryCodesOutput contains single-use recovery codes, shown once
+type RecoveryCodesOutput struct {
+	RecoveryCodes []string
+}
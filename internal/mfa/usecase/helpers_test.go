@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pquerna/otp/totp"
+)
+
+const testSecret = "JBSWY3DPEHPK3PXP"
+
+func testCode(t *testing.T, at time.Time) string {
+	t.Helper()
+	code, err := totp.GenerateCodeCustom(testSecret, at, totpOpts)
+	if err != nil {
+		t.Fatalf("GenerateCodeCustom: %v", err)
+	}
+	return code
+}
+
+func TestMatchTOTP(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 10, 0, time.UTC)
+	step := now.Unix() / totpPeriod
+
+	tests := []struct {
+		name     string
+		codeAt   time.Time
+		wantOK   bool
+		wantStep int64
+	}{
+		{name: "current step", codeAt: now, wantOK: true, wantStep: step},
+		{name: "previous step", codeAt: now.Add(-totpPeriod * time.Second), wantOK: true, wantStep: step - 1},
+		{name: "next step", codeAt: now.Add(totpPeriod * time.Second), wantOK: true, wantStep: step + 1},
+		{name: "two steps behind", codeAt: now.Add(-2 * totpPeriod * time.Second)},
+		{name: "two steps ahead", codeAt: now.Add(2 * totpPeriod * time.Second)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := matchTOTP(testSecret, testCode(t, tt.codeAt), now)
+			if ok != tt.wantOK || got != tt.wantStep {
+				t.Fatalf("matchTOTP() = (%d, %v), want (%d, %v)", got, ok, tt.wantStep, tt.wantOK)
+			}
+		})
+	}
+
+	if _, ok := matchTOTP(testSecret, "", now); ok {
+		t.Fatalf("matchTOTP accepted an empty code")
+	}
+}
+
+func TestRecoveryCodeNormalization(t *testing.T) {
+	const code = "abcd-efgh-ijkl-mnop"
+	want := hashRecoveryCode("abcdefghijklmnop")
+
+	tests := []struct {
+		name  string
+		input string
+		match bool
+	}{
+		{name: "as displayed", input: code, match: true},
+		{name: "upper case", input: "ABCD-EFGH-IJKL-MNOP", match: true},
+		{name: "spaces and padding", input: "  abcd efgh ijkl mnop ", match: true},
+		{name: "no separators", input: "abcdefghijklmnop", match: true},
+		{name: "other code", input: "abcd-efgh-ijkl-mnoq"},
+		{name: "truncated", input: "abcd-efgh-ijkl"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hashRecoveryCode(tt.input) == want; got != tt.match {
+				t.Fatalf("hashRecoveryCode(%q) match = %v, want %v", tt.input, got, tt.match)
+			}
+		})
+	}
+}
+
+func TestIsTOTPCode(t *testing.T) {
+	tests := map[string]bool{
+		"123456":           true,
+		"000000":           true,
+		"12345":            false,
+		"1234567":          false,
+		"12345a":           false,
+		"abcdefghijklmnop": false,
+		"":                 false,
+	}
+	for code, want := range tests {
+		if got := isTOTPCode(code); got != want {
+			t.Errorf("isTOTPCode(%q) = %v, want %v", code, got, want)
+		}
+	}
+}
+
+func TestGenerateRecoveryCodes(t *testing.T) {
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		t.Fatalf("generateRecoveryCodes: %v", err)
+	}
+	if len(codes) != recoveryCodeCount || len(hashes) != recoveryCodeCount {
+		t.Fatalf("got %d codes and %d hashes, want %d", len(codes), len(hashes), recoveryCodeCount)
+	}
+
+	seen := make(map[string]bool, len(codes))
+	for i, code := range codes {
+		if len(code) != 19 || code[4] != '-' || code[9] != '-' || code[14] != '-' {
+			t.Errorf("code %q is not formatted as xxxx-xxxx-xxxx-xxxx", code)
+		}
+		if hashRecoveryCode(code) != hashes[i] {
+			t.Errorf("hash of displayed code %q does not match the stored hash", code)
+		}
+		if seen[code] {
+			t.Errorf("duplicate code %q", code)
+		}
+		seen[code] = true
+	}
+}
@@ -0,0 +1,188 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"identity-srv/internal/mfa"
+	"identity-srv/internal/mfa/repository"
+	"identity-srv/internal/model"
+
+	"github.com/pquerna/otp/totp"
+)
+
+// Status returns whether the caller has a confirmed or pending factor
+func (u *usecase) Status(ctx context.Context, sc model.Scope) (mfa.StatusOutput, error) {
+	factor, err := u.repo.Detail(ctx, sc.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return mfa.StatusOutput{}, nil
+		}
+		u.l.Errorf(ctx, "mfa.usecase.Status.Detail: %v", err)
+		return mfa.StatusOutput{}, fmt.Errorf("%w: %v", mfa.ErrInternalSystem, err)
+	}
+	if !factor.IsConfirmed() {
+		return mfa.StatusOutput{Pending: true}, nil
+	}
+
+	left, err := u.repo.CountRecoveryCodes(ctx, sc.UserID)
+	if err != nil {
+		u.l.Errorf(ctx, "mfa.usecase.Status.CountRecoveryCodes: %v", err)
+		return mfa.StatusOutput{}, fmt.Errorf("%w: %v", mfa.ErrInternalSystem, err)
+	}
+
+	return mfa.StatusOutput{
+		Enrolled:          true,
+		ConfirmedAt:       factor.ConfirmedAt,
+		RecoveryCodesLeft: left,
+	}, nil
+}
+
+// Enroll generates a new TOTP secret for the caller. The factor is not
+// enforced until Confirm succeeds; enrolling again replaces a pending secret.
+func (u *usecase) Enroll(ctx context.Context, sc model.Scope) (mfa.EnrollOutput, error) {
+	factor, err := u.repo.Detail(ctx, sc.UserID)
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		u.l.Errorf(ctx, "mfa.usecase.Enroll.Detail: %v", err)
+		return mfa.EnrollOutput{}, fmt.Errorf("%w: %v", mfa.ErrInternalSystem, err)
+	}
+	if err == nil && factor.IsConfirmed() {
+		return mfa.EnrollOutput{}, mfa.ErrAlreadyEnrolled
+	}
+
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      u.issuer,
+		AccountName: sc.Username,
+		Period:      totpPeriod,
+		Digits:      totpOpts.Digits,
+		Algorithm:   totpOpts.Algorithm,
+	})
+	if err != nil {
+		u.l.Errorf(ctx, "mfa.usecase.Enroll.Generate: %v", err)
+		return mfa.EnrollOutput{}, fmt.Errorf("%w: %v", mfa.ErrInternalSystem, err)
+	}
+
+	encrypted, err := u.encrypt.Encrypt(key.Secret())
+	if err != nil {
+		u.l.Errorf(ctx, "mfa.usecase.Enroll.Encrypt: %v", err)
+		return mfa.EnrollOutput{}, fmt.Errorf("%w: %v", mfa.ErrInternalSystem, err)
+	}
+
+	if _, err := u.repo.Upsert(ctx, repository.UpsertOptions{
+		UserID:          sc.UserID,
+		SecretEncrypted: encrypted,
+	}); err != nil {
+		u.l.Errorf(ctx, "mfa.usecase.Enroll.Upsert: %v", err)
+		return mfa.EnrollOutput{}, fmt.Errorf("%w: %v", mfa.ErrInternalSystem, err)
+	}
+
+	u.l.Infof(ctx, "MFA enrolment started: UserID=%s", sc.UserID)
+	return mfa.EnrollOutput{
+		Secret:     key.Secret(),
+		OTPAuthURI: key.URL(),
+	}, nil
+}
+
+// Confirm completes enrolment with a code from the authenticator app and
+// returns the first set of recovery codes
+func (u *usecase) Confirm(ctx context.Context, sc model.Scope, code string) (mfa.RecoveryCodesOutput, error) {
+	factor, err := u.detail(ctx, sc.UserID)
+	if err != nil {
+		return mfa.RecoveryCodesOutput{}, err
+	}
+	if factor.IsConfirmed() {
+		return mfa.RecoveryCodesOutput{}, mfa.ErrAlreadyEnrolled
+	}
+
+	// Recovery codes do not exist before confirmation
+	if err := u.checkCode(ctx, factor, code, false); err != nil {
+		return mfa.RecoveryCodesOutput{}, err
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		u.l.Errorf(ctx, "mfa.usecase.Confirm.generateRecoveryCodes: %v", err)
+		return mfa.RecoveryCodesOutput{}, fmt.Errorf("%w: %v", mfa.ErrInternalSystem, err)
+	}
+	if err := u.repo.Confirm(ctx, repository.ConfirmOptions{
+		UserID:     sc.UserID,
+		CodeHashes: hashes,
+	}); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return mfa.RecoveryCodesOutput{}, mfa.ErrAlreadyEnrolled
+		}
+		u.l.Errorf(ctx, "mfa.usecase.Confirm.Confirm: %v", err)
+		return mfa.RecoveryCodesOutput{}, fmt.Errorf("%w: %v", mfa.ErrInternalSystem, err)
+	}
+
+	u.l.Infof(ctx, "MFA enrolled: UserID=%s", sc.UserID)
+	return mfa.RecoveryCodesOutput{RecoveryCodes: codes}, nil
+}
+
+// RegenerateRecoveryCodes replaces all recovery codes after verifying a code
+func (u *usecase) RegenerateRecoveryCodes(ctx context.Context, sc model.Scope, code string) (mfa.RecoveryCodesOutput, error) {
+	if err := u.Verify(ctx, sc.UserID, code); err != nil {
+		return mfa.RecoveryCodesOutput{}, err
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		u.l.Errorf(ctx, "mfa.usecase.RegenerateRecoveryCodes.generateRecoveryCodes: %v", err)
+		return mfa.RecoveryCodesOutput{}, fmt.Errorf("%w: %v", mfa.ErrInternalSystem, err)
+	}
+	if err := u.repo.ReplaceRecoveryCodes(ctx, repository.ReplaceRecoveryCodesOptions{
+		UserID:     sc.UserID,
+		CodeHashes: hashes,
+	}); err != nil {
+		u.l.Errorf(ctx, "mfa.usecase.RegenerateRecoveryCodes.ReplaceRecoveryCodes: %v", err)
+		return mfa.RecoveryCodesOutput{}, fmt.Errorf("%w: %v", mfa.ErrInternalSystem, err)
+	}
+
+	u.l.Infof(ctx, "MFA recovery codes regenerated: UserID=%s", sc.UserID)
+	return mfa.RecoveryCodesOutput{RecoveryCodes: codes}, nil
+}
+
+// Disable removes the caller's factor after verifying a code. Users whose role
+// requires MFA are asked to enrol again at their next login.
+func (u *usecase) Disable(ctx context.Context, sc model.Scope, code string) error {
+	if err := u.Verify(ctx, sc.UserID, code); err != nil {
+		return err
+	}
+
+	if err := u.repo.Delete(ctx, sc.UserID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return mfa.ErrNotEnrolled
+		}
+		u.l.Errorf(ctx, "mfa.usecase.Disable.Delete: %v", err)
+		return fmt.Errorf("%w: %v", mfa.ErrInternalSystem, err)
+	}
+
+	u.l.Warnf(ctx, "MFA disabled: UserID=%s", sc.UserID)
+	return nil
+}
+
+// IsEnrolled reports whether the user has a confirmed factor
+func (u *usecase) IsEnrolled(ctx context.Context, userID string) (bool, error) {
+	factor, err := u.repo.Detail(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return false, nil
+		}
+		u.l.Errorf(ctx, "mfa.usecase.IsEnrolled.Detail: %v", err)
+		return false, fmt.Errorf("%w: %v", mfa.ErrInternalSystem, err)
+	}
+	return factor.IsConfirmed(), nil
+}
+
+// Verify checks a TOTP or recovery code against the user's confirmed factor
+func (u *usecase) Verify(ctx context.Context, userID, code string) error {
+	factor, err := u.detail(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !factor.IsConfirmed() {
+		return mfa.ErrNotEnrolled
+	}
+	return u.checkCode(ctx, factor, code, true)
+}
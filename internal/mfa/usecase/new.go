@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"time"
+
+	"identity-srv/config"
+	"identity-srv/internal/mfa"
+	"identity-srv/internal/mfa/repository"
+
+	"github.com/smap-hcmut/shared-libs/go/encrypter"
+	"github.com/smap-hcmut/shared-libs/go/log"
+)
+
+type usecase struct {
+	l           log.Logger
+	repo        repository.Repository
+	encrypt     encrypter.Encrypter
+	clock       func() time.Time
+	issuer      string
+	maxAttempts int
+	lockout     time.Duration
+}
+
+func New(l log.Logger, repo repository.Repository, encrypt encrypter.Encrypter, cfg config.MFAConfig) mfa.UseCase {
+	return &usecase{
+		l:           l,
+		repo:        repo,
+		encrypt:     encrypt,
+		clock:       time.Now,
+		issuer:      cfg.Issuer,
+		maxAttempts: cfg.MaxAttempts,
+		lockout:     time.Duration(cfg.LockoutDuration) * time.Second,
+	}
+}
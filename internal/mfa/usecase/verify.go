@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"identity-srv/internal/mfa"
+	"identity-srv/internal/mfa/repository"
+	"identity-srv/internal/model"
+)
+
+// detail loads a factor, mapping a missing one to ErrNotEnrolled
+func (u *usecase) detail(ctx context.Context, userID string) (model.TOTPFactor, error) {
+	factor, err := u.repo.Detail(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return model.TOTPFactor{}, mfa.ErrNotEnrolled
+		}
+		u.l.Errorf(ctx, "mfa.usecase.detail.Detail: %v", err)
+		return model.TOTPFactor{}, fmt.Errorf("%w: %v", mfa.ErrInternalSystem, err)
+	}
+	return factor, nil
+}
+
+// checkCode counts an attempt, then verifies a TOTP code or, when allowed, a
+// recovery code. A success resets the attempt counter.
+func (u *usecase) checkCode(ctx context.Context, factor model.TOTPFactor, code string, allowRecovery bool) error {
+	if err := u.reserveAttempt(ctx, factor); err != nil {
+		return err
+	}
+
+	ok, err := u.matchCode(ctx, factor, normalizeCode(code), allowRecovery)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		u.l.Warnf(ctx, "mfa.usecase.checkCode: wrong code for user %s", factor.UserID)
+		return mfa.ErrWrongCode
+	}
+
+	// The code was accepted, a failed reset only leaves the counter higher
+	if _, err := u.repo.UpdateAttempts(ctx, repository.UpdateAttemptsOptions{
+		UserID: factor.UserID,
+	}); err != nil {
+		u.l.Warnf(ctx, "mfa.usecase.checkCode.UpdateAttempts: %v", err)
+	}
+	return nil
+}
+
+// matchCode verifies the code and consumes it, so it cannot be used twice
+func (u *usecase) matchCode(ctx context.Context, factor model.TOTPFactor, code string, allowRecovery bool) (bool, error) {
+	if isTOTPCode(code) {
+		secret, err := u.encrypt.Decrypt(factor.SecretEncrypted)
+		if err != nil {
+			u.l.Errorf(ctx, "mfa.usecase.matchCode.Decrypt: %v", err)
+			return false, fmt.Errorf("%w: %v", mfa.ErrInternalSystem, err)
+		}
+		step, ok := matchTOTP(secret, code, u.clock())
+		if !ok {
+			return false, nil
+		}
+		consumed, err := u.repo.ConsumeStep(ctx, repository.ConsumeStepOptions{
+			UserID: factor.UserID,
+			Step:   step,
+		})
+		if err != nil {
+			u.l.Errorf(ctx, "mfa.usecase.matchCode.ConsumeStep: %v", err)
+			return false, fmt.Errorf("%w: %v", mfa.ErrInternalSystem, err)
+		}
+		return consumed, nil
+	}
+
+	if !allowRecovery || code == "" {
+		return false, nil
+	}
+	consumed, err := u.repo.ConsumeRecoveryCode(ctx, repository.ConsumeRecoveryCodeOptions{
+		UserID:   factor.UserID,
+		CodeHash: hashRecoveryCode(code),
+	})
+	if err != nil {
+		u.l.Errorf(ctx, "mfa.usecase.matchCode.ConsumeRecoveryCode: %v", err)
+		return false, fmt.Errorf("%w: %v", mfa.ErrInternalSystem, err)
+	}
+	if consumed {
+		u.l.Warnf(ctx, "MFA recovery code used: UserID=%s", factor.UserID)
+	}
+	return consumed, nil
+}
+
+// reserveAttempt counts an attempt before the code is checked, so parallel
+// guesses cannot slip past the limit. The attempt that reaches the limit
+// locks the factor; a correct code on that attempt still clears the lock.
+func (u *usecase) reserveAttempt(ctx context.Context, factor model.TOTPFactor) error {
+	for range maxAttemptRetries {
+		now := u.clock()
+		if factor.IsLockedAt(now) {
+			return mfa.ErrTooManyAttempts
+		}
+
+		expected := factor.FailedAttempts
+		attempts := expected + 1
+		var lockedUntil *time.Time
+		if attempts >= u.maxAttempts {
+			until := now.Add(u.lockout)
+			lockedUntil = &until
+			attempts = 0
+		}
+
+		ok, err := u.repo.UpdateAttempts(ctx, repository.UpdateAttemptsOptions{
+			UserID:         factor.UserID,
+			Expected:       &expected,
+			FailedAttempts: attempts,
+			LockedUntil:    lockedUntil,
+		})
+		if err != nil {
+			u.l.Errorf(ctx, "mfa.usecase.reserveAttempt.UpdateAttempts: %v", err)
+			return fmt.Errorf("%w: %v", mfa.ErrInternalSystem, err)
+		}
+		if ok {
+			if lockedUntil != nil {
+				u.l.Warnf(ctx, "MFA locked after %d attempts: UserID=%s until=%s", u.maxAttempts, factor.UserID, lockedUntil.Format(time.RFC3339))
+			}
+			return nil
+		}
+
+		// Another attempt won the race, retry with the current counter
+		if factor, err = u.detail(ctx, factor.UserID); err != nil {
+			return err
+		}
+	}
+	return mfa.ErrTooManyAttempts
+}
@@ -0,0 +1,277 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"identity-srv/internal/mfa"
+	"identity-srv/internal/mfa/repository"
+	"identity-srv/internal/model"
+
+	"github.com/smap-hcmut/shared-libs/go/encrypter"
+	"github.com/smap-hcmut/shared-libs/go/log"
+)
+
+type testLogger struct{ log.Logger }
+
+func (testLogger) Infof(context.Context, string, ...any)  {}
+func (testLogger) Warnf(context.Context, string, ...any)  {}
+func (testLogger) Errorf(context.Context, string, ...any) {}
+
+// plainEncrypter stores secrets as they are
+type plainEncrypter struct{ encrypter.Encrypter }
+
+func (plainEncrypter) Decrypt(ciphertext string) (string, error) { return ciphertext, nil }
+
+// fakeRepo keeps one factor in memory with the compare-and-set semantics of
+// the postgres repository
+type fakeRepo struct {
+	repository.Repository
+
+	mu       sync.Mutex
+	factor   model.TOTPFactor
+	recovery map[string]bool
+	// races makes the next compare-and-set updates lose to a concurrent attempt
+	races int
+}
+
+func (r *fakeRepo) Detail(ctx context.Context, userID string) (model.TOTPFactor, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if userID != r.factor.UserID {
+		return model.TOTPFactor{}, repository.ErrNotFound
+	}
+	return r.factor, nil
+}
+
+func (r *fakeRepo) UpdateAttempts(ctx context.Context, opts repository.UpdateAttemptsOptions) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if opts.Expected != nil {
+		if r.races > 0 {
+			r.races--
+			r.factor.FailedAttempts++
+			return false, nil
+		}
+		if *opts.Expected != r.factor.FailedAttempts {
+			return false, nil
+		}
+	}
+	r.factor.FailedAttempts = opts.FailedAttempts
+	r.factor.LockedUntil = opts.LockedUntil
+	return true, nil
+}
+
+func (r *fakeRepo) ConsumeStep(ctx context.Context, opts repository.ConsumeStepOptions) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if opts.Step <= r.factor.LastUsedStep {
+		return false, nil
+	}
+	r.factor.LastUsedStep = opts.Step
+	return true, nil
+}
+
+func (r *fakeRepo) ConsumeRecoveryCode(ctx context.Context, opts repository.ConsumeRecoveryCodeOptions) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.recovery[opts.CodeHash] {
+		return false, nil
+	}
+	delete(r.recovery, opts.CodeHash)
+	return true, nil
+}
+
+var testNow = time.Date(2026, 1, 1, 12, 0, 10, 0, time.UTC)
+
+func newTestUsecase(repo *fakeRepo) *usecase {
+	return &usecase{
+		l:           testLogger{},
+		repo:        repo,
+		encrypt:     plainEncrypter{},
+		clock:       func() time.Time { return testNow },
+		maxAttempts: 3,
+		lockout:     15 * time.Minute,
+	}
+}
+
+func newTestRepo() *fakeRepo {
+	return &fakeRepo{
+		factor: model.TOTPFactor{UserID: "user-1", SecretEncrypted: testSecret},
+		recovery: map[string]bool{
+			hashRecoveryCode("abcd-efgh-ijkl-mnop"): true,
+		},
+	}
+}
+
+func TestCheckCode(t *testing.T) {
+	step := testNow.Unix() / totpPeriod
+	lockedUntil := testNow.Add(time.Minute)
+
+	tests := []struct {
+		name          string
+		setup         func(f *model.TOTPFactor)
+		code          func(t *testing.T) string
+		allowRecovery bool
+		want          error
+		wantAttempts  int
+		wantLocked    bool
+	}{
+		{
+			name: "current code",
+			code: func(t *testing.T) string { return testCode(t, testNow) },
+		},
+		{
+			name: "code from the previous step",
+			code: func(t *testing.T) string { return testCode(t, testNow.Add(-totpPeriod*time.Second)) },
+		},
+		{
+			name:         "replayed step",
+			setup:        func(f *model.TOTPFactor) { f.LastUsedStep = step },
+			code:         func(t *testing.T) string { return testCode(t, testNow) },
+			want:         mfa.ErrWrongCode,
+			wantAttempts: 1,
+		},
+		{
+			name:         "older step after a newer one",
+			setup:        func(f *model.TOTPFactor) { f.LastUsedStep = step },
+			code:         func(t *testing.T) string { return testCode(t, testNow.Add(-totpPeriod*time.Second)) },
+			want:         mfa.ErrWrongCode,
+			wantAttempts: 1,
+		},
+		{
+			name:         "wrong code",
+			code:         func(t *testing.T) string { return "000000" },
+			want:         mfa.ErrWrongCode,
+			wantAttempts: 1,
+		},
+		{
+			name:       "wrong code on the last attempt locks the factor",
+			setup:      func(f *model.TOTPFactor) { f.FailedAttempts = 2 },
+			code:       func(t *testing.T) string { return "000000" },
+			want:       mfa.ErrWrongCode,
+			wantLocked: true,
+		},
+		{
+			name:  "right code on the last attempt clears the counter",
+			setup: func(f *model.TOTPFactor) { f.FailedAttempts = 2 },
+			code:  func(t *testing.T) string { return testCode(t, testNow) },
+		},
+		{
+			name:       "locked factor refuses the right code",
+			setup:      func(f *model.TOTPFactor) { f.LockedUntil = &lockedUntil },
+			code:       func(t *testing.T) string { return testCode(t, testNow) },
+			want:       mfa.ErrTooManyAttempts,
+			wantLocked: true,
+		},
+		{
+			name:          "recovery code as typed",
+			code:          func(t *testing.T) string { return " ABCD-efgh ijkl-MNOP " },
+			allowRecovery: true,
+		},
+		{
+			name:         "recovery code where only TOTP is allowed",
+			code:         func(t *testing.T) string { return "abcd-efgh-ijkl-mnop" },
+			want:         mfa.ErrWrongCode,
+			wantAttempts: 1,
+		},
+		{
+			name:          "unknown recovery code",
+			code:          func(t *testing.T) string { return "abcd-efgh-ijkl-mnoq" },
+			allowRecovery: true,
+			want:          mfa.ErrWrongCode,
+			wantAttempts:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newTestRepo()
+			if tt.setup != nil {
+				tt.setup(&repo.factor)
+			}
+			uc := newTestUsecase(repo)
+
+			err := uc.checkCode(context.Background(), repo.factor, tt.code(t), tt.allowRecovery)
+			if !errors.Is(err, tt.want) || (tt.want == nil && err != nil) {
+				t.Fatalf("checkCode() = %v, want %v", err, tt.want)
+			}
+			if repo.factor.FailedAttempts != tt.wantAttempts {
+				t.Errorf("FailedAttempts = %d, want %d", repo.factor.FailedAttempts, tt.wantAttempts)
+			}
+			if locked := repo.factor.IsLockedAt(testNow); locked != tt.wantLocked {
+				t.Errorf("locked = %v, want %v", locked, tt.wantLocked)
+			}
+		})
+	}
+}
+
+func TestCheckCodeSingleUse(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("totp", func(t *testing.T) {
+		repo := newTestRepo()
+		uc := newTestUsecase(repo)
+		code := testCode(t, testNow)
+
+		if err := uc.checkCode(ctx, repo.factor, code, false); err != nil {
+			t.Fatalf("first use: %v", err)
+		}
+		if err := uc.checkCode(ctx, repo.factor, code, false); !errors.Is(err, mfa.ErrWrongCode) {
+			t.Fatalf("replay = %v, want %v", err, mfa.ErrWrongCode)
+		}
+	})
+
+	t.Run("recovery code", func(t *testing.T) {
+		repo := newTestRepo()
+		uc := newTestUsecase(repo)
+
+		if err := uc.checkCode(ctx, repo.factor, "abcd-efgh-ijkl-mnop", true); err != nil {
+			t.Fatalf("first use: %v", err)
+		}
+		if err := uc.checkCode(ctx, repo.factor, "abcdefghijklmnop", true); !errors.Is(err, mfa.ErrWrongCode) {
+			t.Fatalf("second use = %v, want %v", err, mfa.ErrWrongCode)
+		}
+	})
+}
+
+func TestReserveAttempt(t *testing.T) {
+	tests := []struct {
+		name         string
+		maxAttempts  int
+		attempts     int
+		races        int
+		want         error
+		wantAttempts int
+		wantLocked   bool
+	}{
+		{name: "first attempt", maxAttempts: 10, wantAttempts: 1},
+		{name: "lost race counts the concurrent attempt", maxAttempts: 10, races: 1, wantAttempts: 2},
+		{name: "lost race onto the limit locks", maxAttempts: 3, attempts: 1, races: 1, wantLocked: true},
+		{name: "retries exhausted", maxAttempts: 10, races: maxAttemptRetries, want: mfa.ErrTooManyAttempts, wantAttempts: maxAttemptRetries},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newTestRepo()
+			repo.factor.FailedAttempts = tt.attempts
+			repo.races = tt.races
+			uc := newTestUsecase(repo)
+			uc.maxAttempts = tt.maxAttempts
+
+			err := uc.reserveAttempt(context.Background(), repo.factor)
+			if !errors.Is(err, tt.want) || (tt.want == nil && err != nil) {
+				t.Fatalf("reserveAttempt() = %v, want %v", err, tt.want)
+			}
+			if repo.factor.FailedAttempts != tt.wantAttempts {
+				t.Errorf("FailedAttempts = %d, want %d", repo.factor.FailedAttempts, tt.wantAttempts)
+			}
+			if locked := repo.factor.IsLockedAt(testNow); locked != tt.wantLocked {
+				t.Errorf("locked = %v, want %v", locked, tt.wantLocked)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"identity-srv/internal/internalkey"
+	"identity-srv/internal/model"
+	"identity-srv/internal/serviceaccount"
+
+	"github.com/gin-gonic/gin"
+	"github.com/smap-hcmut/shared-libs/go/response"
+)
+
+const (
+	// InternalKeyHeader carries a named internal key
+	InternalKeyHeader = "X-Internal-Key"
+	// ServiceTokenHeader carries a service token. Routes that also act for an
+	// admin need it, since the admin's JWT takes the Authorization header.
+	ServiceTokenHeader = "X-Service-Token"
+)
+
+type (
+	internalKeyCtxKey   struct{}
+	serviceClaimsCtxKey struct{}
+)
+
+// InternalAuth accepts either a valid internal key (full access) or a service
+// token for this service's audience that holds scope, read from X-Service-Token
+// or, failing that, the Bearer header. The caller is logged and counted in
+// identity_internal_auth_total.
+func (m *Middleware) InternalAuth(scope string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if key := c.GetHeader(InternalKeyHeader); key != "" {
+			m.authenticateKey(c, key)
+			return
+		}
+		m.authenticateServiceToken(c, scope)
+	}
+}
+
+func (m *Middleware) authenticateKey(c *gin.Context, presented string) {
+	ctx := c.Request.Context()
+
+	key, err := m.internalKeys.Verify(ctx, presented)
+	if err != nil {
+		switch {
+		case errors.Is(err, internalkey.ErrKeyNotActive):
+			internalAuthTotal.WithLabelValues(authMethodInternalKey, key.Name, authResultNotActive).Inc()
+			m.l.Warnf(ctx, "middleware.InternalAuth: key %s used outside its validity window from %s %s", key.Name, c.ClientIP(), c.FullPath())
+		default:
+			internalAuthTotal.WithLabelValues(authMethodInternalKey, unknownCaller, authResultInvalid).Inc()
+			m.l.Warnf(ctx, "middleware.InternalAuth: invalid internal key from %s %s", c.ClientIP(), c.FullPath())
+		}
+		m.abortUnauthorized(c)
+		return
+	}
+
+	internalAuthTotal.WithLabelValues(authMethodInternalKey, key.Name, authResultOK).Inc()
+	m.l.Infof(ctx, "middleware.InternalAuth: key=%s source=%s %s %s", key.Name, key.Source, c.Request.Method, c.FullPath())
+
+	c.Request = c.Request.WithContext(context.WithValue(ctx, internalKeyCtxKey{}, key))
+	c.Next()
+}
+
+func (m *Middleware) authenticateServiceToken(c *gin.Context, scope string) {
+	ctx := c.Request.Context()
+
+	token := c.GetHeader(ServiceTokenHeader)
+	if token == "" {
+		token = bearerToken(c)
+	}
+	if token == "" || m.serviceAccountUC == nil {
+		m.abortUnauthorized(c)
+		return
+	}
+
+	claims, err := m.serviceAccountUC.ValidateToken(ctx, serviceaccount.ValidateTokenInput{
+		Token:    token,
+		Audience: m.audience,
+		Scope:    scope,
+	})
+	if err != nil {
+		if errors.Is(err, serviceaccount.ErrInsufficientScope) {
+			internalAuthTotal.WithLabelValues(authMethodServiceToken, unknownCaller, authResultForbidden).Inc()
+			c.AbortWithStatusJSON(http.StatusForbidden, response.Resp{
+				ErrorCode: http.StatusForbidden,
+				Message:   "Insufficient scope",
+			})
+			return
+		}
+		internalAuthTotal.WithLabelValues(authMethodServiceToken, unknownCaller, authResultInvalid).Inc()
+		m.l.Warnf(ctx, "middleware.InternalAuth: invalid service token from %s: %v", c.ClientIP(), err)
+		m.abortUnauthorized(c)
+		return
+	}
+
+	internalAuthTotal.WithLabelValues(authMethodServiceToken, claims.ClientID, authResultOK).Inc()
+	m.l.Infof(ctx, "middleware.InternalAuth: service=%s %s %s", claims.ClientID, c.Request.Method, c.FullPath())
+
+	c.Request = c.Request.WithContext(context.WithValue(ctx, serviceClaimsCtxKey{}, claims))
+	c.Next()
+}
+
+// GetInternalKeyFromContext returns the internal key the request was authenticated with
+func GetInternalKeyFromContext(ctx context.Context) (model.InternalKey, bool) {
+	key, ok := ctx.Value(internalKeyCtxKey{}).(model.InternalKey)
+	return key, ok
+}
+
+// GetServiceClaimsFromContext returns the calling service when the request
+// was authenticated with a service token
+func GetServiceClaimsFromContext(ctx context.Context) (serviceaccount.ServiceClaims, bool) {
+	claims, ok := ctx.Value(serviceClaimsCtxKey{}).(serviceaccount.ServiceClaims)
+	return claims, ok
+}
+
+func (m *Middleware) abortUnauthorized(c *gin.Context) {
+	response.Unauthorized(c)
+	c.Abort()
+}
+
+func bearerToken(c *gin.Context) string {
+	if header := c.GetHeader("Authorization"); strings.HasPrefix(header, "Bearer ") {
+		return strings.TrimPrefix(header, "Bearer ")
+	}
+	return ""
+}
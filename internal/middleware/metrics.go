@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Label values for internalAuthTotal
+const (
+	authMethodInternalKey  = "internal_key"
+	authMethodServiceToken = "service_token"
+
+	authResultOK        = "ok"
+	authResultInvalid   = "invalid"
+	authResultNotActive = "not_active"
+	authResultForbidden = "forbidden"
+
+	unknownCaller = "unknown"
+)
+
+// internalAuthTotal counts internal route authentications per caller. The
+// caller label is the key name or service client id, so a key that is about
+// to be retired can be watched until its traffic drops to zero.
+var internalAuthTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "identity",
+	Name:      "internal_auth_total",
+	Help:      "Internal route authentications by method, caller and result.",
+}, []string{"method", "caller", "result"})
@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"identity-srv/internal/authentication"
+	"identity-srv/internal/internalkey"
+	"identity-srv/internal/ratelimit"
+	"identity-srv/internal/serviceaccount"
+
+	"github.com/smap-hcmut/shared-libs/go/log"
+)
+
+// Middleware guards this service's internal routes. It accepts the shared
+// named X-Internal-Key or a service token issued by the client_credentials grant.
+// It also rate-limits the authentication routes and checks user tokens for
+// revocation on self-service and admin routes.
+type Middleware struct {
+	l                log.Logger
+	internalKeys     internalkey.UseCase
+	serviceAccountUC serviceaccount.UseCase // nil when service accounts are disabled
+	rateLimitUC      ratelimit.UseCase      // nil when rate limiting is disabled
+	authUC           authentication.UseCase // checks user tokens on self-service and admin routes
+	cookieName       string
+	audience         string
+}
+
+func New(l log.Logger, internalKeys internalkey.UseCase, serviceAccountUC serviceaccount.UseCase, audience string) *Middleware {
+	return &Middleware{
+		l:                l,
+		internalKeys:     internalKeys,
+		serviceAccountUC: serviceAccountUC,
+		audience:         audience,
+	}
+}
+
+// SetSelfService lets SelfService and Admin check user tokens, read from the
+// cookieName cookie or the Bearer header; without it both refuse every request
+func (m *Middleware) SetSelfService(uc authentication.UseCase, cookieName string) {
+	m.authUC = uc
+	m.cookieName = cookieName
+}
+
+// SetRateLimit enables RateLimit; nil lets every request through
+func (m *Middleware) SetRateLimit(uc ratelimit.UseCase) {
+	m.rateLimitUC = uc
+}
@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"identity-srv/internal/audit"
+
+	"github.com/gin-gonic/gin"
+	"github.com/smap-hcmut/shared-libs/go/tracing"
+)
+
+// RequestMeta puts the client IP, user agent and trace ID in the request
+// context for audit entries. It must run after middleware.Tracing.
+func RequestMeta() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		c.Request = c.Request.WithContext(audit.SetRequestMetaToContext(ctx, audit.RequestMeta{
+			IPAddress: c.ClientIP(),
+			UserAgent: c.Request.UserAgent(),
+			TraceID:   traceID(ctx),
+		}))
+		c.Next()
+	}
+}
+
+// traceID reads the trace ID middleware.Tracing put in ctx through the
+// propagator the OAuth clients use for outgoing calls
+func traceID(ctx context.Context) string {
+	probe := &http.Request{Header: http.Header{}}
+	tracing.NewHTTPPropagator(tracing.NewTraceContext()).InjectHTTP(ctx, probe)
+	if id := probe.Header.Get("X-Trace-Id"); id != "" {
+		return id
+	}
+	// W3C traceparent: version-traceid-parentid-flags
+	if parts := strings.Split(probe.Header.Get("traceparent"), "-"); len(parts) == 4 {
+		return parts[1]
+	}
+	return ""
+}
@@ -0,0 +1,66 @@
+package model
+
+import (
+	"identity-srv/internal/sqlboiler"
+	"time"
+)
+
+// Access request statuses
+const (
+	AccessRequestStatusPending  = "pending"
+	AccessRequestStatusApproved = "approved"
+	AccessRequestStatusDenied   = "denied"
+)
+
+// AccessRequest is filed when the domain allowlist refuses a login. Once an
+// admin approves it, the address may log in with the chosen role.
+type AccessRequest struct {
+	ID            string     `json:"id"`
+	Email         string     `json:"email"`
+	Name          string     `json:"name"`
+	Status        string     `json:"status"`
+	Role          *string    `json:"role,omitempty"`
+	Reason        string     `json:"reason,omitempty"`
+	IPAddress     string     `json:"ip_address"`
+	UserAgent     string     `json:"user_agent"`
+	Attempts      int        `json:"attempts"`
+	LastAttemptAt time.Time  `json:"last_attempt_at"`
+	DecidedBy     *string    `json:"decided_by,omitempty"`
+	DecidedAt     *time.Time `json:"decided_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+// NewAccessRequestFromDB converts a SQLBoiler AccessRequest to domain AccessRequest
+func NewAccessRequestFromDB(dbRequest *sqlboiler.AccessRequest) *AccessRequest {
+	if dbRequest == nil {
+		return nil
+	}
+
+	request := &AccessRequest{
+		ID:            dbRequest.ID,
+		Email:         dbRequest.Email,
+		Name:          dbRequest.Name,
+		Status:        dbRequest.Status,
+		Reason:        dbRequest.Reason,
+		IPAddress:     dbRequest.IPAddress,
+		UserAgent:     dbRequest.UserAgent,
+		Attempts:      dbRequest.Attempts,
+		LastAttemptAt: dbRequest.LastAttemptAt,
+		CreatedAt:     dbRequest.CreatedAt,
+		UpdatedAt:     dbRequest.UpdatedAt,
+	}
+
+	// Handle nullable fields
+	if dbRequest.Role.Valid {
+		request.Role = &dbRequest.Role.String
+	}
+	if dbRequest.DecidedBy.Valid {
+		request.DecidedBy = &dbRequest.DecidedBy.String
+	}
+	if dbRequest.DecidedAt.Valid {
+		request.DecidedAt = &dbRequest.DecidedAt.Time
+	}
+
+	return request
+}
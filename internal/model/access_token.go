@@ -0,0 +1,60 @@
+package model
+
+import (
+	"identity-srv/internal/sqlboiler"
+	"time"
+)
+
+// AccessTokenPrefix marks personal access tokens so they can be told apart from JWTs
+const AccessTokenPrefix = "smap_pat_"
+
+// AccessToken represents a personal access token.
+// Only the hash of the secret is stored; the plaintext is returned once on creation.
+type AccessToken struct {
+	ID          string     `json:"id"`
+	UserID      string     `json:"user_id"`
+	Name        string     `json:"name"`
+	TokenPrefix string     `json:"token_prefix"`
+	Scopes      []string   `json:"scopes"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt  *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt   *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// NewAccessTokenFromDB converts a SQLBoiler PersonalAccessToken to domain AccessToken
+func NewAccessTokenFromDB(dbToken *sqlboiler.PersonalAccessToken) *AccessToken {
+	if dbToken == nil {
+		return nil
+	}
+
+	token := &AccessToken{
+		ID:          dbToken.ID,
+		UserID:      dbToken.UserID,
+		Name:        dbToken.Name,
+		TokenPrefix: dbToken.TokenPrefix,
+		Scopes:      []string(dbToken.Scopes),
+		CreatedAt:   dbToken.CreatedAt,
+	}
+
+	// Handle nullable fields
+	if dbToken.ExpiresAt.Valid {
+		token.ExpiresAt = &dbToken.ExpiresAt.Time
+	}
+	if dbToken.LastUsedAt.Valid {
+		token.LastUsedAt = &dbToken.LastUsedAt.Time
+	}
+	if dbToken.RevokedAt.Valid {
+		token.RevokedAt = &dbToken.RevokedAt.Time
+	}
+
+	return token
+}
+
+// IsActive reports whether the token is neither revoked nor expired at now
+func (t *AccessToken) IsActive(now time.Time) bool {
+	if t.RevokedAt != nil {
+		return false
+	}
+	return t.ExpiresAt == nil || t.ExpiresAt.After(now)
+}
@@ -0,0 +1,77 @@
+package model
+
+import (
+	"encoding/json"
+	"identity-srv/internal/sqlboiler"
+	"time"
+)
+
+// Audit event types
+const (
+	AuditEventLogin         = "login"
+	AuditEventLogout        = "logout"
+	AuditEventLogoutAll     = "logout_all"
+	AuditEventTokenRevoke   = "token_revoke"
+	AuditEventRoleChange    = "role_change"
+	AuditEventImpersonation = "impersonation"
+	AuditEventDeactivation  = "deactivation"
+	AuditEventAccessDenied  = "access_denied" // refused by the domain allowlist or the blocklist
+)
+
+// Audit outcomes
+const (
+	AuditOutcomeSuccess = "success"
+	AuditOutcomeFailure = "failure"
+)
+
+// AuditLog is an append-only record of an authentication or admin event
+type AuditLog struct {
+	ID          string            `json:"id"`
+	EventType   string            `json:"event_type"`
+	Outcome     string            `json:"outcome"`
+	Reason      string            `json:"reason,omitempty"`
+	ActorID     *string           `json:"actor_id,omitempty"`
+	ActorEmail  string            `json:"actor_email,omitempty"`
+	TargetID    *string           `json:"target_id,omitempty"`
+	TargetEmail string            `json:"target_email,omitempty"`
+	IPAddress   string            `json:"ip_address"`
+	UserAgent   string            `json:"user_agent"`
+	TraceID     string            `json:"trace_id,omitempty"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+	CreatedAt   time.Time         `json:"created_at"`
+}
+
+// NewAuditLogFromDB converts a SQLBoiler AuditLog to domain AuditLog
+func NewAuditLogFromDB(dbLog *sqlboiler.AuditLog) *AuditLog {
+	if dbLog == nil {
+		return nil
+	}
+
+	entry := &AuditLog{
+		ID:          dbLog.ID,
+		EventType:   dbLog.EventType,
+		Outcome:     dbLog.Outcome,
+		Reason:      dbLog.Reason,
+		ActorEmail:  dbLog.ActorEmail,
+		TargetEmail: dbLog.TargetEmail,
+		IPAddress:   dbLog.IPAddress,
+		UserAgent:   dbLog.UserAgent,
+		TraceID:     dbLog.TraceID,
+		CreatedAt:   dbLog.CreatedAt,
+	}
+
+	// Handle nullable fields
+	if dbLog.ActorID.Valid {
+		entry.ActorID = &dbLog.ActorID.String
+	}
+	if dbLog.TargetID.Valid {
+		entry.TargetID = &dbLog.TargetID.String
+	}
+	if dbLog.Metadata.Valid {
+		// Written by this service from a map[string]string; a malformed value
+		// only drops the metadata
+		_ = json.Unmarshal(dbLog.Metadata.JSON, &entry.Metadata)
+	}
+
+	return entry
+}
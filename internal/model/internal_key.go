@@ -0,0 +1,53 @@
+package model
+
+import (
+	"identity-srv/internal/sqlboiler"
+	"time"
+)
+
+// Sources of internal keys
+const (
+	InternalKeySourceConfig   = "config"
+	InternalKeySourceDatabase = "database"
+)
+
+// InternalKey is a named key accepted in the X-Internal-Key header during its validity window.
+// Only the hash of the key is kept in memory and in Postgres.
+type InternalKey struct {
+	Name      string     `json:"name"`
+	KeyHash   string     `json:"-"`
+	NotBefore *time.Time `json:"not_before,omitempty"`
+	NotAfter  *time.Time `json:"not_after,omitempty"`
+	Source    string     `json:"source"`
+}
+
+// NewInternalKeyFromDB converts a SQLBoiler InternalKey to domain InternalKey
+func NewInternalKeyFromDB(dbKey *sqlboiler.InternalKey) *InternalKey {
+	if dbKey == nil {
+		return nil
+	}
+
+	key := &InternalKey{
+		Name:    dbKey.Name,
+		KeyHash: dbKey.KeyHash,
+		Source:  InternalKeySourceDatabase,
+	}
+
+	// Handle nullable fields
+	if dbKey.NotBefore.Valid {
+		key.NotBefore = &dbKey.NotBefore.Time
+	}
+	if dbKey.NotAfter.Valid {
+		key.NotAfter = &dbKey.NotAfter.Time
+	}
+
+	return key
+}
+
+// IsValidAt reports whether now falls inside the key's validity window
+func (k *InternalKey) IsValidAt(now time.Time) bool {
+	if k.NotBefore != nil && now.Before(*k.NotBefore) {
+		return false
+	}
+	return k.NotAfter == nil || now.Before(*k.NotAfter)
+}
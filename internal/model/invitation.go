@@ -0,0 +1,82 @@
+package model
+
+import (
+	"identity-srv/internal/sqlboiler"
+	"time"
+)
+
+// Invitation statuses, derived from the timestamps
+const (
+	InvitationStatusPending  = "pending"
+	InvitationStatusAccepted = "accepted"
+	InvitationStatusExpired  = "expired"
+	InvitationStatusRevoked  = "revoked"
+)
+
+// Invitation lets an address log in with a role chosen by an admin before its
+// first login, even when its domain is not in the allowlist
+type Invitation struct {
+	ID         string     `json:"id"`
+	Email      string     `json:"email"`
+	Role       string     `json:"role"`
+	InvitedBy  *string    `json:"invited_by,omitempty"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	AcceptedAt *time.Time `json:"accepted_at,omitempty"`
+	AcceptedBy *string    `json:"accepted_by,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+// NewInvitationFromDB converts a SQLBoiler Invitation to domain Invitation
+func NewInvitationFromDB(dbInvitation *sqlboiler.Invitation) *Invitation {
+	if dbInvitation == nil {
+		return nil
+	}
+
+	invitation := &Invitation{
+		ID:        dbInvitation.ID,
+		Email:     dbInvitation.Email,
+		Role:      dbInvitation.Role,
+		ExpiresAt: dbInvitation.ExpiresAt,
+		CreatedAt: dbInvitation.CreatedAt,
+		UpdatedAt: dbInvitation.UpdatedAt,
+	}
+
+	// Handle nullable fields
+	if dbInvitation.InvitedBy.Valid {
+		invitation.InvitedBy = &dbInvitation.InvitedBy.String
+	}
+	if dbInvitation.AcceptedAt.Valid {
+		invitation.AcceptedAt = &dbInvitation.AcceptedAt.Time
+	}
+	if dbInvitation.AcceptedBy.Valid {
+		invitation.AcceptedBy = &dbInvitation.AcceptedBy.String
+	}
+	if dbInvitation.RevokedAt.Valid {
+		invitation.RevokedAt = &dbInvitation.RevokedAt.Time
+	}
+
+	return invitation
+}
+
+// Status reports the state of the invitation at now
+func (i *Invitation) Status(now time.Time) string {
+	switch {
+	case i.RevokedAt != nil:
+		return InvitationStatusRevoked
+	case i.AcceptedAt != nil:
+		return InvitationStatusAccepted
+	case !i.ExpiresAt.After(now):
+		return InvitationStatusExpired
+	default:
+		return InvitationStatusPending
+	}
+}
+
+// GrantsAccess reports whether the invited address may log in at now: the
+// invitation is accepted, or pending and not expired
+func (i *Invitation) GrantsAccess(now time.Time) bool {
+	status := i.Status(now)
+	return status == InvitationStatusAccepted || status == InvitationStatusPending
+}
@@ -0,0 +1,57 @@
+package model
+
+import (
+	"identity-srv/internal/sqlboiler"
+	"time"
+)
+
+// TOTPFactor is a user's TOTP second factor (RFC 6238).
+// The secret is stored encrypted and never leaves the mfa module in plaintext
+// except once, at enrolment.
+type TOTPFactor struct {
+	UserID          string     `json:"user_id"`
+	SecretEncrypted string     `json:"-"`
+	ConfirmedAt     *time.Time `json:"confirmed_at,omitempty"`
+	LastUsedStep    int64      `json:"-"`
+	FailedAttempts  int        `json:"failed_attempts"`
+	LockedUntil     *time.Time `json:"locked_until,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
+
+// NewTOTPFactorFromDB converts a SQLBoiler UserTotp to domain TOTPFactor
+func NewTOTPFactorFromDB(dbFactor *sqlboiler.UserTotp) *TOTPFactor {
+	if dbFactor == nil {
+		return nil
+	}
+
+	factor := &TOTPFactor{
+		UserID:          dbFactor.UserID,
+		SecretEncrypted: dbFactor.SecretEncrypted,
+		LastUsedStep:    dbFactor.LastUsedStep,
+		FailedAttempts:  dbFactor.FailedAttempts,
+		CreatedAt:       dbFactor.CreatedAt,
+		UpdatedAt:       dbFactor.UpdatedAt,
+	}
+
+	// Handle nullable fields
+	if dbFactor.ConfirmedAt.Valid {
+		factor.ConfirmedAt = &dbFactor.ConfirmedAt.Time
+	}
+	if dbFactor.LockedUntil.Valid {
+		factor.LockedUntil = &dbFactor.LockedUntil.Time
+	}
+
+	return factor
+}
+
+// IsConfirmed reports whether enrolment was completed with a valid code.
+// Unconfirmed factors are never enforced at login.
+func (f *TOTPFactor) IsConfirmed() bool {
+	return f.ConfirmedAt != nil
+}
+
+// IsLockedAt reports whether verification is refused at the given time
+func (f *TOTPFactor) IsLockedAt(t time.Time) bool {
+	return f.LockedUntil != nil && t.Before(*f.LockedUntil)
+}
@@ -0,0 +1,69 @@
+package model
+
+import (
+	"identity-srv/internal/sqlboiler"
+	"time"
+)
+
+// Outbox event types published to other services
+const (
+	EventUserCreated     = "user.created"
+	EventUserRoleChanged = "user.role_changed"
+	EventUserDeactivated = "user.deactivated"
+	EventSessionRevoked  = "session.revoked"
+)
+
+// OutboxEvent is a domain event waiting in the outbox to be published
+type OutboxEvent struct {
+	ID            string     `json:"id"`
+	EventType     string     `json:"event_type"`
+	AggregateID   string     `json:"aggregate_id"`
+	Payload       []byte     `json:"payload"`
+	Attempts      int        `json:"attempts"`
+	NextAttemptAt time.Time  `json:"next_attempt_at"`
+	LastError     string     `json:"last_error,omitempty"`
+	PublishedAt   *time.Time `json:"published_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+// NewOutboxEventFromDB converts a SQLBoiler OutboxEvent to domain OutboxEvent
+func NewOutboxEventFromDB(dbEvent *sqlboiler.OutboxEvent) *OutboxEvent {
+	if dbEvent == nil {
+		return nil
+	}
+
+	event := &OutboxEvent{
+		ID:            dbEvent.ID,
+		EventType:     dbEvent.EventType,
+		AggregateID:   dbEvent.AggregateID,
+		Payload:       dbEvent.Payload,
+		Attempts:      dbEvent.Attempts,
+		NextAttemptAt: dbEvent.NextAttemptAt,
+		LastError:     dbEvent.LastError,
+		CreatedAt:     dbEvent.CreatedAt,
+	}
+
+	// Handle nullable fields
+	if dbEvent.PublishedAt.Valid {
+		event.PublishedAt = &dbEvent.PublishedAt.Time
+	}
+
+	return event
+}
+
+// UserEvent is the payload of user.created, user.role_changed and user.deactivated
+type UserEvent struct {
+	UserID  string `json:"user_id"`
+	Email   string `json:"email"`
+	Role    string `json:"role"`
+	OldRole string `json:"old_role,omitempty"` // user.role_changed only
+}
+
+// SessionRevokedEvent is the payload of session.revoked. JTI names the revoked
+// token; when it is empty every token of the user issued before RevokedBefore
+// was revoked.
+type SessionRevokedEvent struct {
+	UserID        string     `json:"user_id"`
+	JTI           string     `json:"jti,omitempty"`
+	RevokedBefore *time.Time `json:"revoked_before,omitempty"`
+}
@@ -0,0 +1,64 @@
+package model
+
+import (
+	"identity-srv/internal/sqlboiler"
+	"time"
+)
+
+// Passkey is a WebAuthn public-key credential registered by a user.
+// Credential ID and public key are kept base64url-encoded, as stored.
+type Passkey struct {
+	ID              string     `json:"id"`
+	UserID          string     `json:"user_id"`
+	Name            string     `json:"name"`
+	CredentialID    string     `json:"credential_id"`
+	PublicKey       string     `json:"-"`
+	AttestationType string     `json:"-"`
+	Transports      []string   `json:"transports"`
+	AAGUID          string     `json:"aaguid"`
+	Attachment      string     `json:"attachment,omitempty"`
+	Flags           int        `json:"-"`
+	SignCount       int64      `json:"-"`
+	LastUsedAt      *time.Time `json:"last_used_at,omitempty"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
+
+// NewPasskeyFromDB converts a SQLBoiler WebauthnCredential to domain Passkey
+func NewPasskeyFromDB(dbPasskey *sqlboiler.WebauthnCredential) *Passkey {
+	if dbPasskey == nil {
+		return nil
+	}
+
+	passkey := &Passkey{
+		ID:              dbPasskey.ID,
+		UserID:          dbPasskey.UserID,
+		Name:            dbPasskey.Name,
+		CredentialID:    dbPasskey.CredentialID,
+		PublicKey:       dbPasskey.PublicKey,
+		AttestationType: dbPasskey.AttestationType,
+		Transports:      []string(dbPasskey.Transports),
+		AAGUID:          dbPasskey.Aaguid,
+		Attachment:      dbPasskey.Attachment,
+		Flags:           dbPasskey.Flags,
+		SignCount:       dbPasskey.SignCount,
+		CreatedAt:       dbPasskey.CreatedAt,
+		UpdatedAt:       dbPasskey.UpdatedAt,
+	}
+
+	// Handle nullable fields
+	if dbPasskey.LastUsedAt.Valid {
+		passkey.LastUsedAt = &dbPasskey.LastUsedAt.Time
+	}
+
+	return passkey
+}
+
+// passkeyFlagBackupState is the BS bit of the WebAuthn authenticator data flags
+const passkeyFlagBackupState = 0x10
+
+// IsSynced reports whether the credential is backed up, e.g. to a platform
+// keychain, and so usable from more than one device
+func (p *Passkey) IsSynced() bool {
+	return p.Flags&passkeyFlagBackupState != 0
+}
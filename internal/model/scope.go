@@ -6,8 +6,9 @@ const (
 )
 
 type Scope struct {
-	UserID   string `json:"user_id"`
-	Username string `json:"username"`
-	Role     string `json:"role"` // ADMIN, ANALYST, or VIEWER
-	JTI      string `json:"jti"`
+	UserID    string `json:"user_id"`
+	Username  string `json:"username"`
+	Role      string `json:"role"` // ADMIN, ANALYST, or VIEWER
+	JTI       string `json:"jti"`
+	ExpiresAt int64  `json:"exp"` // Unix timestamp of the token expiry
 }
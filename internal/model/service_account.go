@@ -0,0 +1,67 @@
+package model
+
+import (
+	"identity-srv/internal/sqlboiler"
+	"slices"
+	"time"
+)
+
+// ServiceClientSecretPrefix marks service client secrets so leaked secrets are easy to spot
+const ServiceClientSecretPrefix = "smap_sk_"
+
+// ServiceAccount represents a service client allowed to use the client_credentials grant.
+// Only the hash of the client secret is stored; the plaintext is returned once on creation or rotation.
+type ServiceAccount struct {
+	ID           string     `json:"id"`
+	ClientID     string     `json:"client_id"`
+	Name         string     `json:"name"`
+	SecretPrefix string     `json:"secret_prefix"`
+	SecretHash   string     `json:"-"`
+	Scopes       []string   `json:"scopes"`
+	Audiences    []string   `json:"audiences"`
+	IsActive     bool       `json:"is_active"`
+	CreatedBy    *string    `json:"created_by,omitempty"`
+	LastUsedAt   *time.Time `json:"last_used_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+// NewServiceAccountFromDB converts a SQLBoiler ServiceAccount to domain ServiceAccount
+func NewServiceAccountFromDB(dbAccount *sqlboiler.ServiceAccount) *ServiceAccount {
+	if dbAccount == nil {
+		return nil
+	}
+
+	account := &ServiceAccount{
+		ID:           dbAccount.ID,
+		ClientID:     dbAccount.ClientID,
+		Name:         dbAccount.Name,
+		SecretPrefix: dbAccount.SecretPrefix,
+		SecretHash:   dbAccount.SecretHash,
+		Scopes:       []string(dbAccount.Scopes),
+		Audiences:    []string(dbAccount.Audiences),
+		IsActive:     dbAccount.IsActive,
+		CreatedAt:    dbAccount.CreatedAt,
+		UpdatedAt:    dbAccount.UpdatedAt,
+	}
+
+	// Handle nullable fields
+	if dbAccount.CreatedBy.Valid {
+		account.CreatedBy = &dbAccount.CreatedBy.String
+	}
+	if dbAccount.LastUsedAt.Valid {
+		account.LastUsedAt = &dbAccount.LastUsedAt.Time
+	}
+
+	return account
+}
+
+// HasScope reports whether the account may request the given scope
+func (a *ServiceAccount) HasScope(scope string) bool {
+	return slices.Contains(a.Scopes, scope)
+}
+
+// HasAudience reports whether the account may request tokens for the given audience
+func (a *ServiceAccount) HasAudience(audience string) bool {
+	return slices.Contains(a.Audiences, audience)
+}
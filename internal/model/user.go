@@ -25,8 +25,10 @@ type User struct {
 	RoleHash    *string    `json:"-"` // Encrypted role stored in database
 	IsActive    bool       `json:"is_active"`
 	LastLoginAt *time.Time `json:"last_login_at,omitempty"`
-	CreatedAt   time.Time  `json:"created_at"`
-	UpdatedAt   time.Time  `json:"updated_at"`
+	// TokensRevokedBefore rejects tokens with an earlier iat (logout everywhere)
+	TokensRevokedBefore *time.Time `json:"-"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           time.Time  `json:"updated_at"`
 }
 
 // NewUserFromDB converts a SQLBoiler User to domain User
@@ -58,6 +60,9 @@ func NewUserFromDB(dbUser *sqlboiler.User) *User {
 	if dbUser.LastLoginAt.Valid {
 		user.LastLoginAt = &dbUser.LastLoginAt.Time
 	}
+	if dbUser.TokensRevokedBefore.Valid {
+		user.TokensRevokedBefore = &dbUser.TokensRevokedBefore.Time
+	}
 
 	return user
 }
@@ -87,6 +92,9 @@ func (u *User) ToDBUser() *sqlboiler.User {
 	if u.LastLoginAt != nil {
 		dbUser.LastLoginAt = null.TimeFrom(*u.LastLoginAt)
 	}
+	if u.TokensRevokedBefore != nil {
+		dbUser.TokensRevokedBefore = null.TimeFrom(*u.TokensRevokedBefore)
+	}
 
 	return dbUser
 }
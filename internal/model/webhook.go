@@ -0,0 +1,112 @@
+package model
+
+import (
+	"identity-srv/internal/sqlboiler"
+	"slices"
+	"time"
+)
+
+// WebhookSecretPrefix marks webhook signing secrets so leaked secrets are easy to spot
+const WebhookSecretPrefix = "smap_whsec_"
+
+// Webhook delivery statuses
+const (
+	WebhookDeliveryPending   = "pending" // waiting for its first attempt or a retry
+	WebhookDeliveryDelivered = "delivered"
+	WebhookDeliveryDead      = "dead" // every attempt failed; redeliver by hand
+)
+
+// Webhook is an admin-managed subscription to identity domain events.
+// The signing secret is stored encrypted; the plaintext is returned once on
+// creation or rotation.
+type Webhook struct {
+	ID              string    `json:"id"`
+	URL             string    `json:"url"`
+	Description     string    `json:"description"`
+	EventTypes      []string  `json:"event_types"` // empty for all
+	SecretPrefix    string    `json:"secret_prefix"`
+	SecretEncrypted string    `json:"-"`
+	IsActive        bool      `json:"is_active"`
+	CreatedBy       *string   `json:"created_by,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// NewWebhookFromDB converts a SQLBoiler Webhook to domain Webhook
+func NewWebhookFromDB(dbWebhook *sqlboiler.Webhook) *Webhook {
+	if dbWebhook == nil {
+		return nil
+	}
+
+	webhook := &Webhook{
+		ID:              dbWebhook.ID,
+		URL:             dbWebhook.URL,
+		Description:     dbWebhook.Description,
+		EventTypes:      []string(dbWebhook.EventTypes),
+		SecretPrefix:    dbWebhook.SecretPrefix,
+		SecretEncrypted: dbWebhook.SecretEncrypted,
+		IsActive:        dbWebhook.IsActive,
+		CreatedAt:       dbWebhook.CreatedAt,
+		UpdatedAt:       dbWebhook.UpdatedAt,
+	}
+
+	// Handle nullable fields
+	if dbWebhook.CreatedBy.Valid {
+		webhook.CreatedBy = &dbWebhook.CreatedBy.String
+	}
+
+	return webhook
+}
+
+// Subscribes reports whether the webhook receives the given event type
+func (w *Webhook) Subscribes(eventType string) bool {
+	return len(w.EventTypes) == 0 || slices.Contains(w.EventTypes, eventType)
+}
+
+// WebhookDelivery is one event sent to one webhook, with its retry state
+type WebhookDelivery struct {
+	ID             string     `json:"id"`
+	WebhookID      string     `json:"webhook_id"`
+	EventID        string     `json:"event_id"`
+	EventType      string     `json:"event_type"`
+	Payload        []byte     `json:"payload"`
+	Status         string     `json:"status"`
+	Attempts       int        `json:"attempts"`
+	NextAttemptAt  time.Time  `json:"next_attempt_at"`
+	LastStatusCode *int       `json:"last_status_code,omitempty"`
+	LastError      string     `json:"last_error,omitempty"`
+	DeliveredAt    *time.Time `json:"delivered_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// NewWebhookDeliveryFromDB converts a SQLBoiler WebhookDelivery to domain WebhookDelivery
+func NewWebhookDeliveryFromDB(dbDelivery *sqlboiler.WebhookDelivery) *WebhookDelivery {
+	if dbDelivery == nil {
+		return nil
+	}
+
+	delivery := &WebhookDelivery{
+		ID:            dbDelivery.ID,
+		WebhookID:     dbDelivery.WebhookID,
+		EventID:       dbDelivery.EventID,
+		EventType:     dbDelivery.EventType,
+		Payload:       dbDelivery.Payload,
+		Status:        dbDelivery.Status,
+		Attempts:      dbDelivery.Attempts,
+		NextAttemptAt: dbDelivery.NextAttemptAt,
+		LastError:     dbDelivery.LastError,
+		CreatedAt:     dbDelivery.CreatedAt,
+		UpdatedAt:     dbDelivery.UpdatedAt,
+	}
+
+	// Handle nullable fields
+	if dbDelivery.LastStatusCode.Valid {
+		delivery.LastStatusCode = &dbDelivery.LastStatusCode.Int
+	}
+	if dbDelivery.DeliveredAt.Valid {
+		delivery.DeliveredAt = &dbDelivery.DeliveredAt.Time
+	}
+
+	return delivery
+}
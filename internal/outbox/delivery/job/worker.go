@@ -0,0 +1,60 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"identity-srv/internal/outbox"
+
+	"github.com/smap-hcmut/shared-libs/go/log"
+)
+
+// purgeInterval is how often published events past the retention are deleted
+const purgeInterval = time.Hour
+
+// Worker relays outbox events in the background
+type Worker struct {
+	l            log.Logger
+	uc           outbox.UseCase
+	pollInterval time.Duration
+}
+
+func New(l log.Logger, uc outbox.UseCase, pollInterval time.Duration) *Worker {
+	return &Worker{
+		l:            l,
+		uc:           uc,
+		pollInterval: pollInterval,
+	}
+}
+
+// Run relays due events every poll interval until ctx is done. While batches
+// keep publishing events the next one follows immediately, so a backlog drains
+// without waiting for the ticker.
+func (w *Worker) Run(ctx context.Context) {
+	poll := time.NewTicker(w.pollInterval)
+	defer poll.Stop()
+	purge := time.NewTicker(purgeInterval)
+	defer purge.Stop()
+
+	w.l.Infof(ctx, "Outbox relay started: poll_interval=%s", w.pollInterval)
+	for {
+		select {
+		case <-ctx.Done():
+			w.l.Infof(ctx, "Outbox relay stopped")
+			return
+		case <-purge.C:
+			_ = w.uc.Purge(ctx)
+		case <-poll.C:
+			w.drain(ctx)
+		}
+	}
+}
+
+func (w *Worker) drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		published, err := w.uc.Relay(ctx)
+		if err != nil || published == 0 {
+			return
+		}
+	}
+}
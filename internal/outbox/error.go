@@ -0,0 +1,8 @@
+package outbox
+
+import "errors"
+
+var (
+	ErrInvalidEventType = errors.New("invalid outbox event type")
+	ErrInternalSystem   = errors.New("internal system error")
+)
@@ -0,0 +1,18 @@
+package outbox
+
+import (
+	"context"
+)
+
+//go:generate mockery --name UseCase
+type UseCase interface {
+	// Enqueue adds an event whose change is not stored in Postgres (e.g. a
+	// single revoked session). User changes write their events in the same
+	// transaction, through the user repository.
+	Enqueue(ctx context.Context, ip EnqueueInput) error
+
+	// Relay publishes a batch of due events and returns how many were published
+	Relay(ctx context.Context) (int, error)
+	// Purge deletes the events published longer ago than the retention period
+	Purge(ctx context.Context) error
+}
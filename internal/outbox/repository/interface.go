@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"identity-srv/internal/model"
+)
+
+//go:generate mockery --name Repository
+type Repository interface {
+	Create(ctx context.Context, opts CreateOptions) error
+	// Claim leases up to Limit due events, oldest first, and at most one per
+	// aggregate: a later event of a user waits until the earlier one is published
+	Claim(ctx context.Context, opts ClaimOptions) ([]model.OutboxEvent, error)
+	MarkPublished(ctx context.Context, id string) error
+	MarkFailed(ctx context.Context, opts MarkFailedOptions) error
+	// DeletePublished removes events published before the given time
+	DeletePublished(ctx context.Context, before time.Time) (int64, error)
+}
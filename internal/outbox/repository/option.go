@@ -0,0 +1,21 @@
+package repository
+
+import "time"
+
+type CreateOptions struct {
+	EventType   string
+	AggregateID string
+	Payload     []byte
+}
+
+type ClaimOptions struct {
+	Limit      int
+	LeaseUntil time.Time
+}
+
+type MarkFailedOptions struct {
+	ID            string
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+}
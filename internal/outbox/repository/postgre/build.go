@@ -0,0 +1,41 @@
+package postgres
+
+import (
+	"identity-srv/internal/outbox/repository"
+	"identity-srv/internal/sqlboiler"
+
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/smap-hcmut/shared-libs/go/postgres"
+)
+
+// noEarlierPending keeps the events of an aggregate in order: an event is only
+// claimed once every earlier event of the same aggregate is published
+const noEarlierPending = `NOT EXISTS (
+	SELECT 1 FROM "identity"."outbox_events" earlier
+	WHERE earlier.aggregate_id = "identity"."outbox_events"."aggregate_id"
+	AND earlier.published_at IS NULL
+	AND earlier.created_at < "identity"."outbox_events"."created_at"
+)`
+
+func (r *implRepository) buildOutboxEvent(opts repository.CreateOptions) *sqlboiler.OutboxEvent {
+	now := r.clock()
+	return &sqlboiler.OutboxEvent{
+		ID:            postgres.NewUUID(),
+		EventType:     opts.EventType,
+		AggregateID:   opts.AggregateID,
+		Payload:       opts.Payload,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}
+}
+
+func (r *implRepository) buildClaimQuery(opts repository.ClaimOptions) []qm.QueryMod {
+	return []qm.QueryMod{
+		sqlboiler.OutboxEventWhere.PublishedAt.IsNull(),
+		sqlboiler.OutboxEventWhere.NextAttemptAt.LTE(r.clock()),
+		qm.Where(noEarlierPending),
+		qm.OrderBy(sqlboiler.OutboxEventColumns.CreatedAt),
+		qm.Limit(opts.Limit),
+		qm.For("UPDATE SKIP LOCKED"),
+	}
+}
@@ -0,0 +1,117 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"identity-srv/internal/model"
+	"identity-srv/internal/outbox/repository"
+	"identity-srv/internal/sqlboiler"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+)
+
+// Create adds an event to the outbox
+func (r *implRepository) Create(ctx context.Context, opts repository.CreateOptions) error {
+	if err := r.buildOutboxEvent(opts).Insert(ctx, r.db, boil.Infer()); err != nil {
+		r.l.Errorf(ctx, "outbox.repository.postgres.Create: %v", err)
+		return err
+	}
+	return nil
+}
+
+// Claim leases due events by moving their next_attempt_at to LeaseUntil.
+// SKIP LOCKED lets several replicas relay side by side.
+func (r *implRepository) Claim(ctx context.Context, opts repository.ClaimOptions) ([]model.OutboxEvent, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.l.Errorf(ctx, "outbox.repository.postgres.Claim.BeginTx: %v", err)
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	events, err := sqlboiler.OutboxEvents(r.buildClaimQuery(opts)...).All(ctx, tx)
+	if err != nil {
+		r.l.Errorf(ctx, "outbox.repository.postgres.Claim.All: %v", err)
+		return nil, err
+	}
+	if len(events) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, 0, len(events))
+	for _, event := range events {
+		ids = append(ids, event.ID)
+	}
+	if _, err := sqlboiler.OutboxEvents(
+		sqlboiler.OutboxEventWhere.ID.IN(ids),
+	).UpdateAll(ctx, tx, sqlboiler.M{
+		sqlboiler.OutboxEventColumns.NextAttemptAt: opts.LeaseUntil,
+	}); err != nil {
+		r.l.Errorf(ctx, "outbox.repository.postgres.Claim.UpdateAll: %v", err)
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.l.Errorf(ctx, "outbox.repository.postgres.Claim.Commit: %v", err)
+		return nil, err
+	}
+
+	result := make([]model.OutboxEvent, 0, len(events))
+	for _, event := range events {
+		result = append(result, *model.NewOutboxEventFromDB(event))
+	}
+	return result, nil
+}
+
+// MarkPublished records that the publisher acknowledged the event
+func (r *implRepository) MarkPublished(ctx context.Context, id string) error {
+	rows, err := sqlboiler.OutboxEvents(
+		sqlboiler.OutboxEventWhere.ID.EQ(id),
+	).UpdateAll(ctx, r.db, sqlboiler.M{
+		sqlboiler.OutboxEventColumns.PublishedAt: null.TimeFrom(r.clock()),
+		sqlboiler.OutboxEventColumns.LastError:   "",
+	})
+	if err != nil {
+		r.l.Errorf(ctx, "outbox.repository.postgres.MarkPublished: %v", err)
+		return err
+	}
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// MarkFailed schedules the next attempt of an event that could not be published
+func (r *implRepository) MarkFailed(ctx context.Context, opts repository.MarkFailedOptions) error {
+	rows, err := sqlboiler.OutboxEvents(
+		sqlboiler.OutboxEventWhere.ID.EQ(opts.ID),
+		sqlboiler.OutboxEventWhere.PublishedAt.IsNull(),
+	).UpdateAll(ctx, r.db, sqlboiler.M{
+		sqlboiler.OutboxEventColumns.Attempts:      opts.Attempts,
+		sqlboiler.OutboxEventColumns.NextAttemptAt: opts.NextAttemptAt,
+		sqlboiler.OutboxEventColumns.LastError:     opts.LastError,
+	})
+	if err != nil {
+		r.l.Errorf(ctx, "outbox.repository.postgres.MarkFailed: %v", err)
+		return err
+	}
+	if rows == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// DeletePublished removes events published before the given time
+func (r *implRepository) DeletePublished(ctx context.Context, before time.Time) (int64, error) {
+	rows, err := sqlboiler.OutboxEvents(
+		sqlboiler.OutboxEventWhere.PublishedAt.LT(null.TimeFrom(before)),
+	).DeleteAll(ctx, r.db)
+	if err != nil {
+		r.l.Errorf(ctx, "outbox.repository.postgres.DeletePublished: %v", err)
+		return 0, err
+	}
+	return rows, nil
+}
@@ -0,0 +1,22 @@
+package outbox
+
+import "time"
+
+// EnqueueInput is an event to publish. Payload is encoded as JSON.
+type EnqueueInput struct {
+	EventType   string
+	AggregateID string // user ID, the message key
+	Payload     any
+}
+
+// Options tunes the relay
+type Options struct {
+	BatchSize    int
+	RetryBackoff time.Duration // delay before the first retry, doubled after each failure
+	MaxBackoff   time.Duration
+	Retention    time.Duration // how long published events are kept
+}
+
+// ClaimLease is how long a claimed event is hidden from other relays. A relay
+// that dies mid-batch leaves its events to be published again once it expires.
+const ClaimLease = time.Minute
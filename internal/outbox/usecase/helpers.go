@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"time"
+
+	"identity-srv/internal/model"
+	"identity-srv/internal/outbox"
+)
+
+func validateEventType(eventType string) error {
+	switch eventType {
+	case model.EventUserCreated,
+		model.EventUserRoleChanged,
+		model.EventUserDeactivated,
+		model.EventSessionRevoked:
+		return nil
+	default:
+		return outbox.ErrInvalidEventType
+	}
+}
+
+// backoff returns the delay before the next attempt: retryBackoff doubled for
+// every earlier failure, capped at maxBackoff
+func (u *usecase) backoff(attempts int) time.Duration {
+	delay := u.retryBackoff
+	for i := 1; i < attempts && delay < u.maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > u.maxBackoff {
+		return u.maxBackoff
+	}
+	return delay
+}
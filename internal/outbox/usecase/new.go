@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"time"
+
+	"identity-srv/internal/outbox"
+	"identity-srv/internal/outbox/repository"
+	"identity-srv/pkg/publisher"
+
+	"github.com/smap-hcmut/shared-libs/go/log"
+)
+
+type usecase struct {
+	l            log.Logger
+	repo         repository.Repository
+	publisher    publisher.Publisher
+	clock        func() time.Time
+	batchSize    int
+	retryBackoff time.Duration
+	maxBackoff   time.Duration
+	retention    time.Duration
+}
+
+func New(l log.Logger, repo repository.Repository, p publisher.Publisher, opts outbox.Options) outbox.UseCase {
+	return &usecase{
+		l:            l,
+		repo:         repo,
+		publisher:    p,
+		clock:        time.Now,
+		batchSize:    opts.BatchSize,
+		retryBackoff: opts.RetryBackoff,
+		maxBackoff:   opts.MaxBackoff,
+		retention:    opts.Retention,
+	}
+}
@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"identity-srv/internal/outbox"
+	"identity-srv/internal/outbox/repository"
+	"identity-srv/pkg/publisher"
+)
+
+// Enqueue adds an event to the outbox
+func (u *usecase) Enqueue(ctx context.Context, ip outbox.EnqueueInput) error {
+	if err := validateEventType(ip.EventType); err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(ip.Payload)
+	if err != nil {
+		u.l.Errorf(ctx, "outbox.usecase.Enqueue.Marshal: %v", err)
+		return fmt.Errorf("%w: %v", outbox.ErrInternalSystem, err)
+	}
+
+	if err := u.repo.Create(ctx, repository.CreateOptions{
+		EventType:   ip.EventType,
+		AggregateID: ip.AggregateID,
+		Payload:     payload,
+	}); err != nil {
+		u.l.Errorf(ctx, "outbox.usecase.Enqueue.Create: %v", err)
+		return fmt.Errorf("%w: %v", outbox.ErrInternalSystem, err)
+	}
+	return nil
+}
+
+// Relay claims a batch of due events and publishes them. A failed event is
+// retried with exponential backoff; an event whose publish succeeded but could
+// not be marked is published again after its lease, so delivery is at least once.
+func (u *usecase) Relay(ctx context.Context) (int, error) {
+	events, err := u.repo.Claim(ctx, repository.ClaimOptions{
+		Limit:      u.batchSize,
+		LeaseUntil: u.clock().Add(outbox.ClaimLease),
+	})
+	if err != nil {
+		u.l.Errorf(ctx, "outbox.usecase.Relay.Claim: %v", err)
+		return 0, fmt.Errorf("%w: %v", outbox.ErrInternalSystem, err)
+	}
+
+	published := 0
+	for _, event := range events {
+		err := u.publisher.Publish(ctx, publisher.Message{
+			ID:         event.ID,
+			Type:       event.EventType,
+			Key:        event.AggregateID,
+			Payload:    event.Payload,
+			OccurredAt: event.CreatedAt,
+		})
+		if err != nil {
+			attempts := event.Attempts + 1
+			delay := u.backoff(attempts)
+			u.l.Warnf(ctx, "outbox.usecase.Relay.Publish: id=%s type=%s attempt=%d retry_in=%s: %v", event.ID, event.EventType, attempts, delay, err)
+			if err := u.repo.MarkFailed(ctx, repository.MarkFailedOptions{
+				ID:            event.ID,
+				Attempts:      attempts,
+				NextAttemptAt: u.clock().Add(delay),
+				LastError:     err.Error(),
+			}); err != nil {
+				u.l.Errorf(ctx, "outbox.usecase.Relay.MarkFailed: %v", err)
+			}
+			continue
+		}
+
+		if err := u.repo.MarkPublished(ctx, event.ID); err != nil {
+			u.l.Errorf(ctx, "outbox.usecase.Relay.MarkPublished: id=%s: %v", event.ID, err)
+			continue
+		}
+		published++
+	}
+
+	return published, nil
+}
+
+// Purge deletes the events published longer ago than the retention period
+func (u *usecase) Purge(ctx context.Context) error {
+	deleted, err := u.repo.DeletePublished(ctx, u.clock().Add(-u.retention))
+	if err != nil {
+		u.l.Errorf(ctx, "outbox.usecase.Purge.DeletePublished: %v", err)
+		return fmt.Errorf("%w: %v", outbox.ErrInternalSystem, err)
+	}
+	if deleted > 0 {
+		u.l.Infof(ctx, "Purged %d published outbox events", deleted)
+	}
+	return nil
+}
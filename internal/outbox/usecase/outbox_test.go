@@ -0,0 +1,188 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"identity-srv/internal/model"
+	"identity-srv/internal/outbox"
+	"identity-srv/internal/outbox/repository"
+	"identity-srv/pkg/publisher"
+
+	"github.com/smap-hcmut/shared-libs/go/log"
+)
+
+type testLogger struct{ log.Logger }
+
+func (testLogger) Infof(context.Context, string, ...any)  {}
+func (testLogger) Warnf(context.Context, string, ...any)  {}
+func (testLogger) Errorf(context.Context, string, ...any) {}
+
+// fakeRepo hands out the claimed events and records every call in order
+type fakeRepo struct {
+	repository.Repository
+	events  []model.OutboxEvent
+	claimed repository.ClaimOptions
+	created []repository.CreateOptions
+	failed  []repository.MarkFailedOptions
+	markErr error
+	calls   *[]string
+}
+
+func (r *fakeRepo) Create(_ context.Context, opts repository.CreateOptions) error {
+	r.created = append(r.created, opts)
+	return nil
+}
+
+func (r *fakeRepo) Claim(_ context.Context, opts repository.ClaimOptions) ([]model.OutboxEvent, error) {
+	r.claimed = opts
+	return r.events, nil
+}
+
+func (r *fakeRepo) MarkPublished(_ context.Context, id string) error {
+	*r.calls = append(*r.calls, "published "+id)
+	return r.markErr
+}
+
+func (r *fakeRepo) MarkFailed(_ context.Context, opts repository.MarkFailedOptions) error {
+	*r.calls = append(*r.calls, "failed "+opts.ID)
+	r.failed = append(r.failed, opts)
+	return nil
+}
+
+// fakePublisher refuses the messages whose ID is in refuse
+type fakePublisher struct {
+	refuse map[string]bool
+	calls  *[]string
+}
+
+func (p *fakePublisher) Publish(_ context.Context, msg publisher.Message) error {
+	*p.calls = append(*p.calls, "publish "+msg.ID)
+	if p.refuse[msg.ID] {
+		return errors.New("broker unavailable")
+	}
+	return nil
+}
+
+var testNow = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func newTestUsecase(events []model.OutboxEvent, refuse ...string) (*usecase, *fakeRepo, *[]string) {
+	calls := &[]string{}
+	repo := &fakeRepo{events: events, calls: calls}
+	p := &fakePublisher{refuse: map[string]bool{}, calls: calls}
+	for _, id := range refuse {
+		p.refuse[id] = true
+	}
+	return &usecase{
+		l:            testLogger{},
+		repo:         repo,
+		publisher:    p,
+		clock:        func() time.Time { return testNow },
+		batchSize:    10,
+		retryBackoff: time.Second,
+		maxBackoff:   10 * time.Second,
+	}, repo, calls
+}
+
+func TestBackoff(t *testing.T) {
+	uc, _, _ := newTestUsecase(nil)
+	want := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second}
+	for i, w := range want {
+		if got := uc.backoff(i + 1); got != w {
+			t.Errorf("backoff(%d) = %s, want %s", i+1, got, w)
+		}
+	}
+	if got := uc.backoff(1000); got != 10*time.Second {
+		t.Errorf("backoff(1000) = %s, want the cap 10s", got)
+	}
+}
+
+func TestRelay(t *testing.T) {
+	events := []model.OutboxEvent{
+		{ID: "e1", EventType: model.EventUserCreated, AggregateID: "u1"},
+		{ID: "e2", EventType: model.EventSessionRevoked, AggregateID: "u2", Attempts: 2},
+		{ID: "e3", EventType: model.EventUserDeactivated, AggregateID: "u3"},
+	}
+	uc, repo, calls := newTestUsecase(events, "e2")
+
+	published, err := uc.Relay(context.Background())
+	if err != nil {
+		t.Fatalf("Relay() error = %v", err)
+	}
+	if published != 2 {
+		t.Errorf("Relay() = %d, want 2", published)
+	}
+	if want := (repository.ClaimOptions{Limit: 10, LeaseUntil: testNow.Add(outbox.ClaimLease)}); repo.claimed != want {
+		t.Errorf("Claim() options = %+v, want %+v", repo.claimed, want)
+	}
+
+	// Each event is marked only after its publish returned, and a refused
+	// one is never marked published
+	wantCalls := []string{"publish e1", "published e1", "publish e2", "failed e2", "publish e3", "published e3"}
+	if !reflect.DeepEqual(*calls, wantCalls) {
+		t.Errorf("calls = %v, want %v", *calls, wantCalls)
+	}
+
+	if len(repo.failed) != 1 {
+		t.Fatalf("MarkFailed() called %d times, want 1", len(repo.failed))
+	}
+	failed := repo.failed[0]
+	if failed.Attempts != 3 {
+		t.Errorf("MarkFailed() attempts = %d, want 3", failed.Attempts)
+	}
+	if want := testNow.Add(4 * time.Second); !failed.NextAttemptAt.Equal(want) {
+		t.Errorf("MarkFailed() next attempt = %s, want %s", failed.NextAttemptAt, want)
+	}
+	if failed.LastError != "broker unavailable" {
+		t.Errorf("MarkFailed() last error = %q", failed.LastError)
+	}
+}
+
+func TestRelayMarkPublishedFails(t *testing.T) {
+	uc, repo, calls := newTestUsecase([]model.OutboxEvent{{ID: "e1", EventType: model.EventUserCreated, AggregateID: "u1"}})
+	repo.markErr = errors.New("connection reset")
+
+	published, err := uc.Relay(context.Background())
+	if err != nil {
+		t.Fatalf("Relay() error = %v", err)
+	}
+	if published != 0 {
+		t.Errorf("Relay() = %d, want 0", published)
+	}
+	// Not marked failed either: the lease expires and the event is published again
+	if want := []string{"publish e1", "published e1"}; !reflect.DeepEqual(*calls, want) {
+		t.Errorf("calls = %v, want %v", *calls, want)
+	}
+}
+
+func TestEnqueue(t *testing.T) {
+	uc, repo, _ := newTestUsecase(nil)
+	ctx := context.Background()
+
+	err := uc.Enqueue(ctx, outbox.EnqueueInput{
+		EventType:   model.EventSessionRevoked,
+		AggregateID: "u1",
+		Payload:     model.SessionRevokedEvent{UserID: "u1", JTI: "jti-1"},
+	})
+	if err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+	want := []repository.CreateOptions{{
+		EventType:   model.EventSessionRevoked,
+		AggregateID: "u1",
+		Payload:     []byte(`{"user_id":"u1","jti":"jti-1"}`),
+	}}
+	if !reflect.DeepEqual(repo.created, want) {
+		t.Errorf("Create() options = %+v, want %+v", repo.created, want)
+	}
+
+	if err := uc.Enqueue(ctx, outbox.EnqueueInput{EventType: "user.deleted", AggregateID: "u1"}); !errors.Is(err, outbox.ErrInvalidEventType) {
+		t.Errorf("Enqueue() error = %v, want %v", err, outbox.ErrInvalidEventType)
+	}
+	if len(repo.created) != 1 {
+		t.Errorf("Create() called %d times, want 1", len(repo.created))
+	}
+}
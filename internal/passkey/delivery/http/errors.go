@@ -0,0 +1,49 @@
+package http
+
+import (
+	"errors"
+	"identity-srv/internal/passkey"
+
+	pkgErrors "github.com/smap-hcmut/shared-libs/go/errors"
+)
+
+// --- HTTP error constants ---
+
+var (
+	errWrongBody          = pkgErrors.NewHTTPError(24001, "Wrong body")
+	errPasskeyNotFound    = pkgErrors.NewHTTPError(24002, "Passkey not found")
+	errInvalidName        = pkgErrors.NewHTTPError(24003, "Invalid passkey name")
+	errTooManyPasskeys    = pkgErrors.NewHTTPError(24004, "Too many passkeys")
+	errAlreadyRegistered  = pkgErrors.NewHTTPError(24005, "Passkey already registered")
+	errInvalidCeremony    = pkgErrors.NewHTTPError(24006, "Invalid or expired passkey ceremony")
+	errVerificationFailed = pkgErrors.NewHTTPError(24007, "Passkey verification failed")
+	errMissingID          = pkgErrors.NewHTTPError(24008, "Passkey ID is required")
+	errInternalSystem     = pkgErrors.NewHTTPError(24009, "Internal system error")
+	errScopeNotFound      = pkgErrors.NewHTTPError(24010, "Scope not found")
+)
+
+// mapError maps UseCase domain errors to HTTP errors
+func (h handler) mapError(err error) error {
+	switch {
+	case errors.Is(err, passkey.ErrPasskeyNotFound):
+		return errPasskeyNotFound
+	case errors.Is(err, passkey.ErrInvalidName):
+		return errInvalidName
+	case errors.Is(err, passkey.ErrTooManyPasskeys):
+		return errTooManyPasskeys
+	case errors.Is(err, passkey.ErrAlreadyRegistered):
+		return errAlreadyRegistered
+	case errors.Is(err, passkey.ErrInvalidCeremony), errors.Is(err, passkey.ErrCeremonyExpired):
+		return errInvalidCeremony
+	case errors.Is(err, passkey.ErrVerificationFailed):
+		return errVerificationFailed
+	case errors.Is(err, passkey.ErrInternalSystem):
+		return errInternalSystem
+	default:
+		return err
+	}
+}
+
+var NotFound = []error{
+	errPasskeyNotFound,
+}
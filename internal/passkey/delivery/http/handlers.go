@@ -0,0 +1,180 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/smap-hcmut/shared-libs/go/response"
+)
+
+// List
+// @Summary List Passkeys
+// @Description List the current user's passkeys (WebAuthn credentials).
+// @Tags Passkeys
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.Resp{data=listResp} "Passkeys"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/passkeys [GET]
+// @Security CookieAuth
+func (h handler) List(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	sc, err := h.processScopeRequest(c)
+	if err != nil {
+		response.Error(c, err, h.discord)
+		return
+	}
+
+	// 2. Call UseCase
+	passkeys, err := h.uc.List(ctx, sc)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.List: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	response.OK(c, h.newListResp(passkeys))
+}
+
+// BeginRegistration
+// @Summary Start Passkey Registration
+// @Description Returns options for navigator.credentials.create() and a signed ceremony to send back to /authentication/passkeys/register/finish. Requires a recent login (passkey.registration_max_age), with MFA when the user has a second factor.
+// @Tags Passkeys
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.Resp{data=ceremonyResp} "Creation options"
+// @Failure 400 {object} response.Resp "Too many passkeys"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/passkeys/register/begin [POST]
+// @Security CookieAuth
+func (h handler) BeginRegistration(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	sc, err := h.processScopeRequest(c)
+	if err != nil {
+		response.Error(c, err, h.discord)
+		return
+	}
+
+	// 2. Call UseCase
+	output, err := h.uc.BeginRegistration(ctx, sc)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.BeginRegistration: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	response.OK(c, h.newCeremonyResp(output))
+}
+
+// FinishRegistration
+// @Summary Finish Passkey Registration
+// @Description Verify the browser's attestation and store the new passkey. Requires a recent login, like register/begin.
+// @Tags Passkeys
+// @Accept json
+// @Produce json
+// @Param body body finishRegistrationReq true "Ceremony, name and credential"
+// @Success 200 {object} response.Resp{data=passkeyResp} "Registered passkey"
+// @Failure 400 {object} response.Resp "Invalid ceremony, verification failed or already registered"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/passkeys/register/finish [POST]
+// @Security CookieAuth
+func (h handler) FinishRegistration(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	input, sc, err := h.processFinishRegistrationRequest(c)
+	if err != nil {
+		response.Error(c, err, h.discord)
+		return
+	}
+
+	// 2. Call UseCase
+	created, err := h.uc.FinishRegistration(ctx, sc, input)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.FinishRegistration: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	response.OK(c, h.newPasskeyResp(created))
+}
+
+// Rename
+// @Summary Rename Passkey
+// @Description Change the label of one of the current user's passkeys.
+// @Tags Passkeys
+// @Accept json
+// @Produce json
+// @Param id path string true "Passkey ID"
+// @Param body body renameReq true "New name"
+// @Success 200 {object} response.Resp{data=passkeyResp} "Renamed passkey"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 404 {object} response.Resp "Not Found"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/passkeys/{id} [PATCH]
+// @Security CookieAuth
+func (h handler) Rename(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	input, sc, err := h.processRenameRequest(c)
+	if err != nil {
+		response.Error(c, err, h.discord)
+		return
+	}
+
+	// 2. Call UseCase
+	renamed, err := h.uc.Rename(ctx, sc, input)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.Rename: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	response.OK(c, h.newPasskeyResp(renamed))
+}
+
+// Delete
+// @Summary Delete Passkey
+// @Description Remove one of the current user's passkeys. If it was the last second factor and the role requires MFA, the user must enrol again at the next login.
+// @Tags Passkeys
+// @Accept json
+// @Produce json
+// @Param id path string true "Passkey ID"
+// @Success 200 {object} response.Resp "Passkey deleted"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 404 {object} response.Resp "Not Found"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/passkeys/{id} [DELETE]
+// @Security CookieAuth
+func (h handler) Delete(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	id, sc, err := h.processDeleteRequest(c)
+	if err != nil {
+		response.Error(c, err, h.discord)
+		return
+	}
+
+	// 2. Call UseCase
+	if err := h.uc.Delete(ctx, sc, id); err != nil {
+		h.l.Errorf(ctx, "uc.Delete: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	response.OK(c, nil)
+}
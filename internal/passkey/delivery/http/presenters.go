@@ -0,0 +1,87 @@
+package http
+
+import (
+	"encoding/json"
+	"identity-srv/internal/model"
+	"identity-srv/internal/passkey"
+	"time"
+)
+
+// --- Request DTOs ---
+
+type finishRegistrationReq struct {
+	Ceremony   string          `json:"ceremony" binding:"required"`
+	Name       string          `json:"name"`
+	Credential json.RawMessage `json:"credential" binding:"required" swaggertype:"object"` // PublicKeyCredential from navigator.credentials.create()
+}
+
+func (r finishRegistrationReq) toInput() passkey.FinishRegistrationInput {
+	return passkey.FinishRegistrationInput{
+		Ceremony:   r.Ceremony,
+		Name:       r.Name,
+		Credential: r.Credential,
+	}
+}
+
+type renameReq struct {
+	Name string `json:"name" binding:"required"`
+}
+
+func (r renameReq) toInput(id string) passkey.RenameInput {
+	return passkey.RenameInput{
+		ID:   id,
+		Name: r.Name,
+	}
+}
+
+// --- Response DTOs ---
+
+type passkeyResp struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	AAGUID     string     `json:"aaguid"`
+	Transports []string   `json:"transports"`
+	Attachment string     `json:"attachment,omitempty"`
+	Synced     bool       `json:"synced"` // backed up to a platform keychain, usable from other devices
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+type listResp struct {
+	Passkeys []passkeyResp `json:"passkeys"`
+}
+
+type ceremonyResp struct {
+	Ceremony string          `json:"ceremony"`                     // send back with the browser's response
+	Options  json.RawMessage `json:"options" swaggertype:"object"` // pass to navigator.credentials.create()
+}
+
+// --- Response Mappers ---
+
+func (h handler) newPasskeyResp(o model.Passkey) passkeyResp {
+	return passkeyResp{
+		ID:         o.ID,
+		Name:       o.Name,
+		AAGUID:     o.AAGUID,
+		Transports: o.Transports,
+		Attachment: o.Attachment,
+		Synced:     o.IsSynced(),
+		LastUsedAt: o.LastUsedAt,
+		CreatedAt:  o.CreatedAt,
+	}
+}
+
+func (h handler) newListResp(o []model.Passkey) listResp {
+	passkeys := make([]passkeyResp, 0, len(o))
+	for _, p := range o {
+		passkeys = append(passkeys, h.newPasskeyResp(p))
+	}
+	return listResp{Passkeys: passkeys}
+}
+
+func (h handler) newCeremonyResp(o passkey.CeremonyOutput) ceremonyResp {
+	return ceremonyResp{
+		Ceremony: o.Ceremony,
+		Options:  o.Options,
+	}
+}
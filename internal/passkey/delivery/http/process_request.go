@@ -0,0 +1,79 @@
+package http
+
+import (
+	"identity-srv/internal/model"
+	"identity-srv/internal/passkey"
+
+	"github.com/gin-gonic/gin"
+	"github.com/smap-hcmut/shared-libs/go/auth"
+)
+
+// --- Scope extraction ---
+
+func (h handler) getScope(c *gin.Context) (model.Scope, bool) {
+	payload, ok := auth.GetPayloadFromContext(c.Request.Context())
+	if !ok || payload.UserID == "" {
+		return model.Scope{}, false
+	}
+	return model.Scope{
+		UserID:    payload.UserID,
+		Username:  payload.Username,
+		Role:      payload.Role,
+		JTI:       payload.Id,
+		ExpiresAt: payload.ExpiresAt,
+	}, true
+}
+
+// --- Process request functions ---
+
+func (h handler) processScopeRequest(c *gin.Context) (model.Scope, error) {
+	sc, ok := h.getScope(c)
+	if !ok {
+		return model.Scope{}, errScopeNotFound
+	}
+	return sc, nil
+}
+
+func (h handler) processFinishRegistrationRequest(c *gin.Context) (passkey.FinishRegistrationInput, model.Scope, error) {
+	sc, ok := h.getScope(c)
+	if !ok {
+		return passkey.FinishRegistrationInput{}, model.Scope{}, errScopeNotFound
+	}
+
+	var req finishRegistrationReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		return passkey.FinishRegistrationInput{}, model.Scope{}, errWrongBody
+	}
+	return req.toInput(), sc, nil
+}
+
+func (h handler) processRenameRequest(c *gin.Context) (passkey.RenameInput, model.Scope, error) {
+	sc, ok := h.getScope(c)
+	if !ok {
+		return passkey.RenameInput{}, model.Scope{}, errScopeNotFound
+	}
+
+	id := c.Param("id")
+	if id == "" {
+		return passkey.RenameInput{}, model.Scope{}, errMissingID
+	}
+
+	var req renameReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		return passkey.RenameInput{}, model.Scope{}, errWrongBody
+	}
+	return req.toInput(id), sc, nil
+}
+
+func (h handler) processDeleteRequest(c *gin.Context) (string, model.Scope, error) {
+	sc, ok := h.getScope(c)
+	if !ok {
+		return "", model.Scope{}, errScopeNotFound
+	}
+
+	id := c.Param("id")
+	if id == "" {
+		return "", model.Scope{}, errMissingID
+	}
+	return id, sc, nil
+}
@@ -0,0 +1,21 @@
+package http
+
+import (
+	internalmw "identity-srv/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/smap-hcmut/shared-libs/go/middleware"
+)
+
+func (h handler) RegisterRoutes(r *gin.RouterGroup, mw *middleware.Middleware, imw *internalmw.Middleware) {
+	// Self-service management (require an unrevoked user token)
+	r.Use(mw.Auth())
+	r.GET("", imw.SelfService(), h.List)
+	r.PATCH("/:id", imw.SelfService(), h.Rename)
+	r.DELETE("/:id", imw.SelfService(), h.Delete)
+
+	// Registration adds a way to log in, so it needs a recent login, with MFA
+	// when the user has a second factor
+	r.POST("/register/begin", imw.RecentLogin(), h.BeginRegistration)
+	r.POST("/register/finish", imw.RecentLogin(), h.FinishRegistration)
+}
@@ -0,0 +1,15 @@
+package passkey
+
+import "errors"
+
+var (
+	ErrPasskeyNotFound    = errors.New("passkey not found")
+	ErrInvalidName        = errors.New("invalid passkey name")
+	ErrTooManyPasskeys    = errors.New("too many passkeys")
+	ErrAlreadyRegistered  = errors.New("passkey already registered")
+	ErrNoPasskeys         = errors.New("no passkeys registered")
+	ErrInvalidCeremony    = errors.New("invalid passkey ceremony")
+	ErrCeremonyExpired    = errors.New("passkey ceremony expired")
+	ErrVerificationFailed = errors.New("passkey verification failed")
+	ErrInternalSystem     = errors.New("internal system error")
+)
@@ -0,0 +1,22 @@
+package passkey
+
+import (
+	"context"
+
+	"identity-srv/internal/model"
+)
+
+//go:generate mockery --name UseCase
+type UseCase interface {
+	// Self-service management (scoped to the caller)
+	List(ctx context.Context, sc model.Scope) ([]model.Passkey, error)
+	BeginRegistration(ctx context.Context, sc model.Scope) (CeremonyOutput, error)
+	FinishRegistration(ctx context.Context, sc model.Scope, ip FinishRegistrationInput) (model.Passkey, error)
+	Rename(ctx context.Context, sc model.Scope, ip RenameInput) (model.Passkey, error)
+	Delete(ctx context.Context, sc model.Scope, id string) error
+
+	// Assertion ceremonies (used by the authentication MFA challenge and passwordless login)
+	HasPasskey(ctx context.Context, userID string) (bool, error)
+	BeginLogin(ctx context.Context, userID string) (CeremonyOutput, error)
+	FinishLogin(ctx context.Context, ip FinishLoginInput) (string, error)
+}
@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"identity-srv/internal/model"
+)
+
+//go:generate mockery --name Repository
+type Repository interface {
+	Create(ctx context.Context, opts CreateOptions) (model.Passkey, error)
+	List(ctx context.Context, userID string) ([]model.Passkey, error)
+	Count(ctx context.Context, userID string) (int64, error)
+	DetailByCredentialID(ctx context.Context, credentialID string) (model.Passkey, error)
+	Rename(ctx context.Context, opts RenameOptions) (model.Passkey, error)
+	Delete(ctx context.Context, opts DeleteOptions) error
+	UpdateUsage(ctx context.Context, opts UpdateUsageOptions) error
+}
+
+// CeremonyStore holds the IDs of ceremonies that were begun and not finished
+// yet, so a ceremony token is accepted once. The backend (redis, memory) is
+// selected by passkey.backend.
+type CeremonyStore interface {
+	SaveCeremony(ctx context.Context, id string, ttl time.Duration) error
+	// TakeCeremony deletes the ceremony and reports whether it was pending.
+	// It returns false when the ceremony is unknown, expired or already used.
+	TakeCeremony(ctx context.Context, id string) (bool, error)
+}
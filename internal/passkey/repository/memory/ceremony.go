@@ -0,0 +1,37 @@
+package memory
+
+import (
+	"context"
+	"time"
+)
+
+// SaveCeremony marks the ceremony pending until ttl
+func (s *implCeremonyStore) SaveCeremony(ctx context.Context, id string, ttl time.Duration) error {
+	now := s.clock()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.purgeExpiredLocked(now)
+	s.ceremonies[id] = now.Add(ttl)
+	return nil
+}
+
+// TakeCeremony deletes the ceremony and reports whether it was pending
+func (s *implCeremonyStore) TakeCeremony(ctx context.Context, id string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	expiresAt, ok := s.ceremonies[id]
+	delete(s.ceremonies, id)
+	return ok && expiresAt.After(s.clock()), nil
+}
+
+// purgeExpiredLocked drops expired ceremonies; the caller must hold the lock
+func (s *implCeremonyStore) purgeExpiredLocked(now time.Time) {
+	for id, expiresAt := range s.ceremonies {
+		if !expiresAt.After(now) {
+			delete(s.ceremonies, id)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package memory
+
+import (
+	"sync"
+	"time"
+
+	"identity-srv/internal/passkey/repository"
+)
+
+// The memory store keeps pending ceremonies in process. It is meant for local
+// development; with several replicas a ceremony must finish on the replica
+// that began it.
+
+type implCeremonyStore struct {
+	mu         sync.Mutex
+	ceremonies map[string]time.Time // id -> expires at
+	clock      func() time.Time
+}
+
+var _ repository.CeremonyStore = &implCeremonyStore{}
+
+// NewCeremonyStore creates an in-memory ceremony store
+func NewCeremonyStore() repository.CeremonyStore {
+	return &implCeremonyStore{
+		ceremonies: make(map[string]time.Time),
+		clock:      time.Now,
+	}
+}
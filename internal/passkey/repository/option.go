@@ -0,0 +1,32 @@
+package repository
+
+type CreateOptions struct {
+	UserID          string
+	Name            string
+	CredentialID    string // base64url
+	PublicKey       string // base64url
+	AttestationType string
+	Transports      []string
+	AAGUID          string
+	Attachment      string
+	Flags           int
+	SignCount       int64
+}
+
+type RenameOptions struct {
+	ID     string
+	UserID string
+	Name   string
+}
+
+type DeleteOptions struct {
+	ID     string
+	UserID string
+}
+
+// UpdateUsageOptions records a successful assertion
+type UpdateUsageOptions struct {
+	ID        string
+	Flags     int
+	SignCount int64
+}
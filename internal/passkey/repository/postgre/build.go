@@ -0,0 +1,32 @@
+package postgres
+
+import (
+	"identity-srv/internal/passkey/repository"
+	"identity-srv/internal/sqlboiler"
+
+	"github.com/aarondl/sqlboiler/v4/types"
+	"github.com/smap-hcmut/shared-libs/go/postgres"
+)
+
+func (r *implRepository) buildPasskey(opts repository.CreateOptions) *sqlboiler.WebauthnCredential {
+	now := r.clock()
+	passkey := &sqlboiler.WebauthnCredential{
+		ID:              postgres.NewUUID(),
+		UserID:          opts.UserID,
+		Name:            opts.Name,
+		CredentialID:    opts.CredentialID,
+		PublicKey:       opts.PublicKey,
+		AttestationType: opts.AttestationType,
+		Transports:      types.StringArray(opts.Transports),
+		Aaguid:          opts.AAGUID,
+		Attachment:      opts.Attachment,
+		Flags:           opts.Flags,
+		SignCount:       opts.SignCount,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+	if passkey.Transports == nil {
+		passkey.Transports = types.StringArray{}
+	}
+	return passkey
+}
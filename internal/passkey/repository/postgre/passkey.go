@@ -0,0 +1,130 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"identity-srv/internal/model"
+	"identity-srv/internal/passkey/repository"
+	"identity-srv/internal/sqlboiler"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// Create inserts a new credential
+func (r *implRepository) Create(ctx context.Context, opts repository.CreateOptions) (model.Passkey, error) {
+	passkey := r.buildPasskey(opts)
+	if err := passkey.Insert(ctx, r.db, boil.Infer()); err != nil {
+		r.l.Errorf(ctx, "passkey.repository.postgres.Create: %v", err)
+		return model.Passkey{}, err
+	}
+	return *model.NewPasskeyFromDB(passkey), nil
+}
+
+// List returns a user's credentials, oldest first
+func (r *implRepository) List(ctx context.Context, userID string) ([]model.Passkey, error) {
+	passkeys, err := sqlboiler.WebauthnCredentials(
+		sqlboiler.WebauthnCredentialWhere.UserID.EQ(userID),
+		qm.OrderBy(sqlboiler.WebauthnCredentialColumns.CreatedAt),
+	).All(ctx, r.db)
+	if err != nil {
+		r.l.Errorf(ctx, "passkey.repository.postgres.List: %v", err)
+		return nil, err
+	}
+
+	result := make([]model.Passkey, 0, len(passkeys))
+	for _, passkey := range passkeys {
+		result = append(result, *model.NewPasskeyFromDB(passkey))
+	}
+	return result, nil
+}
+
+// Count returns the number of a user's credentials
+func (r *implRepository) Count(ctx context.Context, userID string) (int64, error) {
+	count, err := sqlboiler.WebauthnCredentials(
+		sqlboiler.WebauthnCredentialWhere.UserID.EQ(userID),
+	).Count(ctx, r.db)
+	if err != nil {
+		r.l.Errorf(ctx, "passkey.repository.postgres.Count: %v", err)
+		return 0, err
+	}
+	return count, nil
+}
+
+// DetailByCredentialID finds a credential by its base64url credential ID
+func (r *implRepository) DetailByCredentialID(ctx context.Context, credentialID string) (model.Passkey, error) {
+	passkey, err := sqlboiler.WebauthnCredentials(
+		sqlboiler.WebauthnCredentialWhere.CredentialID.EQ(credentialID),
+	).One(ctx, r.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.Passkey{}, repository.ErrNotFound
+		}
+		r.l.Errorf(ctx, "passkey.repository.postgres.DetailByCredentialID: %v", err)
+		return model.Passkey{}, err
+	}
+	return *model.NewPasskeyFromDB(passkey), nil
+}
+
+// Rename changes the label of a user's credential
+func (r *implRepository) Rename(ctx context.Context, opts repository.RenameOptions) (model.Passkey, error) {
+	passkey, err := sqlboiler.WebauthnCredentials(
+		sqlboiler.WebauthnCredentialWhere.ID.EQ(opts.ID),
+		sqlboiler.WebauthnCredentialWhere.UserID.EQ(opts.UserID),
+	).One(ctx, r.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.Passkey{}, repository.ErrNotFound
+		}
+		r.l.Errorf(ctx, "passkey.repository.postgres.Rename.One: %v", err)
+		return model.Passkey{}, err
+	}
+
+	passkey.Name = opts.Name
+	passkey.UpdatedAt = r.clock()
+	if _, err := passkey.Update(ctx, r.db, boil.Whitelist(
+		sqlboiler.WebauthnCredentialColumns.Name,
+		sqlboiler.WebauthnCredentialColumns.UpdatedAt,
+	)); err != nil {
+		r.l.Errorf(ctx, "passkey.repository.postgres.Rename.Update: %v", err)
+		return model.Passkey{}, err
+	}
+	return *model.NewPasskeyFromDB(passkey), nil
+}
+
+// Delete removes a user's credential
+func (r *implRepository) Delete(ctx context.Context, opts repository.DeleteOptions) error {
+	rows, err := sqlboiler.WebauthnCredentials(
+		sqlboiler.WebauthnCredentialWhere.ID.EQ(opts.ID),
+		sqlboiler.WebauthnCredentialWhere.UserID.EQ(opts.UserID),
+	).DeleteAll(ctx, r.db)
+	if err != nil {
+		r.l.Errorf(ctx, "passkey.repository.postgres.Delete: %v", err)
+		return err
+	}
+	if rows == 0 {
+		return repository.ErrNotFound
+	}
+	return nil
+}
+
+// UpdateUsage stores the signature counter and flags of an accepted assertion
+func (r *implRepository) UpdateUsage(ctx context.Context, opts repository.UpdateUsageOptions) error {
+	now := r.clock()
+	_, err := sqlboiler.WebauthnCredentials(
+		sqlboiler.WebauthnCredentialWhere.ID.EQ(opts.ID),
+	).UpdateAll(ctx, r.db, sqlboiler.M{
+		sqlboiler.WebauthnCredentialColumns.SignCount:  opts.SignCount,
+		sqlboiler.WebauthnCredentialColumns.Flags:      opts.Flags,
+		sqlboiler.WebauthnCredentialColumns.LastUsedAt: null.TimeFrom(now),
+		sqlboiler.WebauthnCredentialColumns.UpdatedAt:  now,
+	})
+	if err != nil {
+		r.l.Errorf(ctx, "passkey.repository.postgres.UpdateUsage: %v", err)
+		return err
+	}
+	return nil
+}
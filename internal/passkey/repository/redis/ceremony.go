@@ -0,0 +1,35 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// SaveCeremony marks the ceremony pending until ttl
+func (s *implCeremonyStore) SaveCeremony(ctx context.Context, id string, ttl time.Duration) error {
+	if err := s.redis.Set(ctx, s.key(id), "1", ttl); err != nil {
+		return fmt.Errorf("save ceremony: %w", err)
+	}
+	return nil
+}
+
+// TakeCeremony reads and deletes the ceremony in one GETDEL, so two
+// concurrent finishes cannot both use it
+func (s *implCeremonyStore) TakeCeremony(ctx context.Context, id string) (bool, error) {
+	_, err := s.redis.GetClient().GetDel(ctx, s.key(id)).Result()
+	if errors.Is(err, goredis.Nil) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("take ceremony: %w", err)
+	}
+	return true, nil
+}
+
+func (s *implCeremonyStore) key(id string) string {
+	return s.keyPrefix + id
+}
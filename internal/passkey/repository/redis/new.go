@@ -0,0 +1,23 @@
+package redis
+
+import (
+	"identity-srv/internal/passkey/repository"
+
+	pkgRedis "github.com/smap-hcmut/shared-libs/go/redis"
+)
+
+type implCeremonyStore struct {
+	redis     pkgRedis.IRedis
+	keyPrefix string
+}
+
+var _ repository.CeremonyStore = &implCeremonyStore{}
+
+// NewCeremonyStore creates a Redis-backed ceremony store, shared by all replicas.
+// Ceremonies are stored as {keyPrefix}{id}.
+func NewCeremonyStore(redisClient pkgRedis.IRedis, keyPrefix string) repository.CeremonyStore {
+	return &implCeremonyStore{
+		redis:     redisClient,
+		keyPrefix: keyPrefix,
+	}
+}
@@ -0,0 +1,32 @@
+package passkey
+
+import "encoding/json"
+
+// CeremonyOutput starts a registration or assertion ceremony.
+// Options are passed to navigator.credentials.create()/get(); Ceremony is a
+// signed copy of the server-side state that must be sent back with the result.
+type CeremonyOutput struct {
+	Ceremony string
+	Options  json.RawMessage
+}
+
+// FinishRegistrationInput contains the browser's attestation response
+type FinishRegistrationInput struct {
+	Ceremony   string
+	Name       string          // label shown in the passkey list, defaults to "Passkey"
+	Credential json.RawMessage // PublicKeyCredential serialized as JSON
+}
+
+type RenameInput struct {
+	ID   string
+	Name string
+}
+
+// FinishLoginInput contains the browser's assertion response.
+// UserID is empty for a passwordless (discoverable) login, where the
+// credential identifies the user.
+type FinishLoginInput struct {
+	Ceremony   string
+	UserID     string
+	Credential json.RawMessage
+}
@@ -0,0 +1,198 @@
+package usecase
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"identity-srv/internal/model"
+	"identity-srv/internal/passkey"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/golang-jwt/jwt"
+)
+
+const (
+	ceremonyType     = "webauthn_ceremony" // "type" claim of ceremony tokens
+	kindRegistration = "registration"
+	kindLogin        = "login"
+	defaultName      = "Passkey"
+	maxNameLength    = 100
+)
+
+// encodeID encodes credential IDs and public keys for storage
+var encodeID = base64.RawURLEncoding.EncodeToString
+
+// ceremonyKey derives the HMAC key of ceremony tokens from the JWT secret,
+// so a ceremony is never accepted as an access token
+func (u *usecase) ceremonyKey() []byte {
+	mac := hmac.New(sha256.New, u.signingKey)
+	mac.Write([]byte(ceremonyType))
+	return mac.Sum(nil)
+}
+
+// signCeremony wraps the session data of a ceremony bound to userID ("" for a
+// discoverable login) and records it as pending
+func (u *usecase) signCeremony(ctx context.Context, kind, userID string, session *webauthn.SessionData) (string, error) {
+	if len(u.signingKey) == 0 {
+		return "", fmt.Errorf("ceremony signing key not configured")
+	}
+
+	jti := make([]byte, 16)
+	if _, err := rand.Read(jti); err != nil {
+		return "", fmt.Errorf("rand.Read: %w", err)
+	}
+
+	now := u.clock()
+	claims := ceremonyClaims{
+		StandardClaims: jwt.StandardClaims{
+			Id:        hex.EncodeToString(jti),
+			Subject:   userID,
+			Issuer:    u.issuer,
+			IssuedAt:  now.Unix(),
+			ExpiresAt: now.Add(u.ceremonyTTL).Unix(),
+		},
+		Type:    ceremonyType,
+		Kind:    kind,
+		Session: *session,
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(u.ceremonyKey())
+	if err != nil {
+		return "", err
+	}
+	if err := u.ceremonies.SaveCeremony(ctx, claims.Id, u.ceremonyTTL); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// parseCeremony verifies a ceremony token of the given kind bound to userID
+// and consumes it: a replayed ceremony is invalid, even when the first use failed
+func (u *usecase) parseCeremony(ctx context.Context, ceremony, kind, userID string) (webauthn.SessionData, error) {
+	var claims ceremonyClaims
+	_, err := jwt.ParseWithClaims(ceremony, &claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return u.ceremonyKey(), nil
+	})
+	if err != nil {
+		var vErr *jwt.ValidationError
+		if errors.As(err, &vErr) && vErr.Errors == jwt.ValidationErrorExpired {
+			return webauthn.SessionData{}, passkey.ErrCeremonyExpired
+		}
+		return webauthn.SessionData{}, passkey.ErrInvalidCeremony
+	}
+	if claims.Type != ceremonyType || claims.Kind != kind || claims.Issuer != u.issuer || claims.Subject != userID {
+		return webauthn.SessionData{}, passkey.ErrInvalidCeremony
+	}
+
+	pending, err := u.ceremonies.TakeCeremony(ctx, claims.Id)
+	if err != nil {
+		u.l.Errorf(ctx, "passkey.usecase.parseCeremony.TakeCeremony: %v", err)
+		return webauthn.SessionData{}, fmt.Errorf("%w: %v", passkey.ErrInternalSystem, err)
+	}
+	if !pending {
+		return webauthn.SessionData{}, passkey.ErrInvalidCeremony
+	}
+	return claims.Session, nil
+}
+
+// loadUser builds the webauthn.User of a user from their stored passkeys
+func (u *usecase) loadUser(ctx context.Context, userID, name string) (*webauthnUser, error) {
+	passkeys, err := u.repo.List(ctx, userID)
+	if err != nil {
+		u.l.Errorf(ctx, "passkey.usecase.loadUser.List: %v", err)
+		return nil, fmt.Errorf("%w: %v", passkey.ErrInternalSystem, err)
+	}
+
+	user := &webauthnUser{
+		id:          userID,
+		name:        name,
+		passkeys:    passkeys,
+		credentials: make([]webauthn.Credential, 0, len(passkeys)),
+	}
+	for _, p := range passkeys {
+		credential, err := toCredential(p)
+		if err != nil {
+			// A corrupt row must not lock the user out of their other passkeys
+			u.l.Errorf(ctx, "passkey.usecase.loadUser.toCredential: ID=%s: %v", p.ID, err)
+			continue
+		}
+		user.credentials = append(user.credentials, credential)
+	}
+	return user, nil
+}
+
+// toCredential restores the library's credential record from a stored passkey
+func toCredential(p model.Passkey) (webauthn.Credential, error) {
+	id, err := base64.RawURLEncoding.DecodeString(p.CredentialID)
+	if err != nil {
+		return webauthn.Credential{}, fmt.Errorf("decode credential_id: %w", err)
+	}
+	publicKey, err := base64.RawURLEncoding.DecodeString(p.PublicKey)
+	if err != nil {
+		return webauthn.Credential{}, fmt.Errorf("decode public_key: %w", err)
+	}
+	aaguid, err := hex.DecodeString(strings.ReplaceAll(p.AAGUID, "-", ""))
+	if err != nil {
+		return webauthn.Credential{}, fmt.Errorf("decode aaguid: %w", err)
+	}
+
+	transports := make([]protocol.AuthenticatorTransport, 0, len(p.Transports))
+	for _, t := range p.Transports {
+		transports = append(transports, protocol.AuthenticatorTransport(t))
+	}
+
+	return webauthn.Credential{
+		ID:              id,
+		PublicKey:       publicKey,
+		AttestationType: p.AttestationType,
+		Transport:       transports,
+		Flags:           webauthn.NewCredentialFlags(protocol.AuthenticatorFlags(p.Flags)),
+		Authenticator: webauthn.Authenticator{
+			AAGUID:     aaguid,
+			SignCount:  uint32(p.SignCount),
+			Attachment: protocol.AuthenticatorAttachment(p.Attachment),
+		},
+	}, nil
+}
+
+// formatAAGUID renders an authenticator model ID in UUID form
+func formatAAGUID(aaguid []byte) string {
+	if len(aaguid) != 16 {
+		return hex.EncodeToString(aaguid)
+	}
+	h := hex.EncodeToString(aaguid)
+	return h[0:8] + "-" + h[8:12] + "-" + h[12:16] + "-" + h[16:20] + "-" + h[20:32]
+}
+
+// normalizeName trims a passkey label, defaulting an empty one
+func normalizeName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return defaultName, nil
+	}
+	if len(name) > maxNameLength {
+		return "", passkey.ErrInvalidName
+	}
+	return name, nil
+}
+
+// findPasskey returns the stored passkey of a credential record
+func findPasskey(user *webauthnUser, credentialID []byte) (model.Passkey, bool) {
+	id := encodeID(credentialID)
+	for _, p := range user.passkeys {
+		if p.CredentialID == id {
+			return p, true
+		}
+	}
+	return model.Passkey{}, false
+}
@@ -0,0 +1,130 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"identity-srv/internal/passkey"
+	"identity-srv/internal/passkey/repository"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+)
+
+// HasPasskey reports whether the user has at least one passkey
+func (u *usecase) HasPasskey(ctx context.Context, userID string) (bool, error) {
+	count, err := u.repo.Count(ctx, userID)
+	if err != nil {
+		u.l.Errorf(ctx, "passkey.usecase.HasPasskey.Count: %v", err)
+		return false, fmt.Errorf("%w: %v", passkey.ErrInternalSystem, err)
+	}
+	return count > 0, nil
+}
+
+// BeginLogin starts an assertion ceremony. With a userID the browser may only
+// use that user's passkeys (second factor); without one any discoverable
+// passkey is accepted and user verification is required (passwordless login).
+func (u *usecase) BeginLogin(ctx context.Context, userID string) (passkey.CeremonyOutput, error) {
+	if userID == "" {
+		assertion, session, err := u.webauthn.BeginDiscoverableLogin(
+			webauthn.WithUserVerification(protocol.VerificationRequired),
+		)
+		if err != nil {
+			u.l.Errorf(ctx, "passkey.usecase.BeginLogin.BeginDiscoverableLogin: %v", err)
+			return passkey.CeremonyOutput{}, fmt.Errorf("%w: %v", passkey.ErrInternalSystem, err)
+		}
+		return u.newCeremonyOutput(ctx, kindLogin, "", assertion, session)
+	}
+
+	user, err := u.loadUser(ctx, userID, "")
+	if err != nil {
+		return passkey.CeremonyOutput{}, err
+	}
+	if len(user.credentials) == 0 {
+		return passkey.CeremonyOutput{}, passkey.ErrNoPasskeys
+	}
+
+	assertion, session, err := u.webauthn.BeginLogin(user)
+	if err != nil {
+		u.l.Errorf(ctx, "passkey.usecase.BeginLogin.BeginLogin: %v", err)
+		return passkey.CeremonyOutput{}, fmt.Errorf("%w: %v", passkey.ErrInternalSystem, err)
+	}
+	return u.newCeremonyOutput(ctx, kindLogin, userID, assertion, session)
+}
+
+// FinishLogin verifies an assertion and returns the ID of the user it proves.
+// The signature counter is checked to detect cloned authenticators.
+func (u *usecase) FinishLogin(ctx context.Context, ip passkey.FinishLoginInput) (string, error) {
+	session, err := u.parseCeremony(ctx, ip.Ceremony, kindLogin, ip.UserID)
+	if err != nil {
+		return "", err
+	}
+
+	parsed, err := protocol.ParseCredentialRequestResponseBytes(ip.Credential)
+	if err != nil {
+		u.l.Warnf(ctx, "passkey.usecase.FinishLogin.Parse: %v", err)
+		return "", passkey.ErrVerificationFailed
+	}
+
+	var (
+		user       *webauthnUser
+		credential *webauthn.Credential
+	)
+	if ip.UserID != "" {
+		if user, err = u.loadUser(ctx, ip.UserID, ""); err != nil {
+			return "", err
+		}
+		credential, err = u.webauthn.ValidateLogin(user, session, parsed)
+	} else {
+		credential, err = u.webauthn.ValidateDiscoverableLogin(func(rawID, userHandle []byte) (webauthn.User, error) {
+			found, lookupErr := u.discoverUser(ctx, rawID, userHandle)
+			user = found
+			return found, lookupErr
+		}, session, parsed)
+	}
+	if err != nil {
+		if errors.Is(err, passkey.ErrInternalSystem) {
+			return "", err
+		}
+		u.l.Warnf(ctx, "passkey.usecase.FinishLogin.Validate: %v", err)
+		return "", passkey.ErrVerificationFailed
+	}
+
+	stored, ok := findPasskey(user, credential.ID)
+	if !ok {
+		return "", passkey.ErrVerificationFailed
+	}
+	if credential.Authenticator.CloneWarning {
+		u.l.Warnf(ctx, "Passkey rejected, signature counter did not increase (possible clone): ID=%s UserID=%s", stored.ID, user.id)
+		return "", passkey.ErrVerificationFailed
+	}
+
+	// The assertion is valid, a failed write only leaves the counter behind
+	if err := u.repo.UpdateUsage(ctx, repository.UpdateUsageOptions{
+		ID:        stored.ID,
+		Flags:     int(parsed.Response.AuthenticatorData.Flags),
+		SignCount: int64(credential.Authenticator.SignCount),
+	}); err != nil {
+		u.l.Warnf(ctx, "passkey.usecase.FinishLogin.UpdateUsage: %v", err)
+	}
+
+	return user.id, nil
+}
+
+// discoverUser resolves the owner of a discoverable credential. The user
+// handle returned by the authenticator must match the stored owner.
+func (u *usecase) discoverUser(ctx context.Context, rawID, userHandle []byte) (*webauthnUser, error) {
+	stored, err := u.repo.DetailByCredentialID(ctx, encodeID(rawID))
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, passkey.ErrPasskeyNotFound
+		}
+		u.l.Errorf(ctx, "passkey.usecase.discoverUser.DetailByCredentialID: %v", err)
+		return nil, fmt.Errorf("%w: %v", passkey.ErrInternalSystem, err)
+	}
+	if stored.UserID != string(userHandle) {
+		return nil, passkey.ErrPasskeyNotFound
+	}
+	return u.loadUser(ctx, stored.UserID, "")
+}
@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"identity-srv/config"
+	"identity-srv/internal/passkey"
+	"identity-srv/internal/passkey/repository/memory"
+
+	"github.com/smap-hcmut/shared-libs/go/log"
+)
+
+type testLogger struct{ log.Logger }
+
+func (testLogger) Infof(context.Context, string, ...any)  {}
+func (testLogger) Warnf(context.Context, string, ...any)  {}
+func (testLogger) Errorf(context.Context, string, ...any) {}
+
+func TestFinishLoginCeremonyIsSingleUse(t *testing.T) {
+	ctx := context.Background()
+	uc, err := New(testLogger{}, nil, memory.NewCeremonyStore(), config.PasskeyConfig{
+		RPID:          "localhost",
+		RPDisplayName: "SMAP",
+		RPOrigins:     []string{"http://localhost:3000"},
+		CeremonyTTL:   300,
+	}, "secret", "identity-srv")
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	begin, err := uc.BeginLogin(ctx, "")
+	if err != nil {
+		t.Fatalf("BeginLogin() error = %v", err)
+	}
+
+	// A failed assertion still uses up the ceremony
+	input := passkey.FinishLoginInput{Ceremony: begin.Ceremony, Credential: json.RawMessage(`{}`)}
+	if _, err := uc.FinishLogin(ctx, input); !errors.Is(err, passkey.ErrVerificationFailed) {
+		t.Fatalf("first FinishLogin() error = %v, want %v", err, passkey.ErrVerificationFailed)
+	}
+	if _, err := uc.FinishLogin(ctx, input); !errors.Is(err, passkey.ErrInvalidCeremony) {
+		t.Fatalf("replayed FinishLogin() error = %v, want %v", err, passkey.ErrInvalidCeremony)
+	}
+}
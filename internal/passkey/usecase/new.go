@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"fmt"
+	"time"
+
+	"identity-srv/config"
+	"identity-srv/internal/passkey"
+	"identity-srv/internal/passkey/repository"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/smap-hcmut/shared-libs/go/log"
+)
+
+type usecase struct {
+	l           log.Logger
+	repo        repository.Repository
+	ceremonies  repository.CeremonyStore
+	webauthn    *webauthn.WebAuthn
+	clock       func() time.Time
+	signingKey  []byte
+	issuer      string
+	ceremonyTTL time.Duration
+	maxPerUser  int
+}
+
+// New configures the relying party. Ceremony state is signed with a key
+// derived from signingKey (the JWT secret) and handed to the client; the
+// ceremony store makes each ceremony single-use.
+func New(l log.Logger, repo repository.Repository, ceremonies repository.CeremonyStore, cfg config.PasskeyConfig, signingKey, issuer string) (passkey.UseCase, error) {
+	ceremonyTTL := time.Duration(cfg.CeremonyTTL) * time.Second
+	timeout := webauthn.TimeoutConfig{
+		Enforce:    true,
+		Timeout:    ceremonyTTL,
+		TimeoutUVD: ceremonyTTL,
+	}
+
+	w, err := webauthn.New(&webauthn.Config{
+		RPID:          cfg.RPID,
+		RPDisplayName: cfg.RPDisplayName,
+		RPOrigins:     cfg.RPOrigins,
+		AuthenticatorSelection: protocol.AuthenticatorSelection{
+			ResidentKey:      protocol.ResidentKeyRequirementPreferred,
+			UserVerification: protocol.VerificationPreferred,
+		},
+		Timeouts: webauthn.TimeoutsConfig{
+			Login:        timeout,
+			Registration: timeout,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("webauthn.New: %w", err)
+	}
+
+	return &usecase{
+		l:           l,
+		repo:        repo,
+		ceremonies:  ceremonies,
+		webauthn:    w,
+		clock:       time.Now,
+		signingKey:  []byte(signingKey),
+		issuer:      issuer,
+		ceremonyTTL: ceremonyTTL,
+		maxPerUser:  cfg.MaxPerUser,
+	}, nil
+}
@@ -0,0 +1,166 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"identity-srv/internal/model"
+	"identity-srv/internal/passkey"
+	"identity-srv/internal/passkey/repository"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+)
+
+// List returns the caller's passkeys
+func (u *usecase) List(ctx context.Context, sc model.Scope) ([]model.Passkey, error) {
+	passkeys, err := u.repo.List(ctx, sc.UserID)
+	if err != nil {
+		u.l.Errorf(ctx, "passkey.usecase.List.List: %v", err)
+		return nil, fmt.Errorf("%w: %v", passkey.ErrInternalSystem, err)
+	}
+	return passkeys, nil
+}
+
+// BeginRegistration starts a registration ceremony for the caller.
+// Existing credentials are excluded so an authenticator is not registered twice.
+func (u *usecase) BeginRegistration(ctx context.Context, sc model.Scope) (passkey.CeremonyOutput, error) {
+	user, err := u.loadUser(ctx, sc.UserID, sc.Username)
+	if err != nil {
+		return passkey.CeremonyOutput{}, err
+	}
+	if len(user.passkeys) >= u.maxPerUser {
+		return passkey.CeremonyOutput{}, passkey.ErrTooManyPasskeys
+	}
+
+	creation, session, err := u.webauthn.BeginRegistration(user,
+		webauthn.WithExclusions(webauthn.Credentials(user.credentials).CredentialDescriptors()),
+	)
+	if err != nil {
+		u.l.Errorf(ctx, "passkey.usecase.BeginRegistration.BeginRegistration: %v", err)
+		return passkey.CeremonyOutput{}, fmt.Errorf("%w: %v", passkey.ErrInternalSystem, err)
+	}
+
+	return u.newCeremonyOutput(ctx, kindRegistration, sc.UserID, creation, session)
+}
+
+// FinishRegistration verifies the attestation and stores the new credential
+func (u *usecase) FinishRegistration(ctx context.Context, sc model.Scope, ip passkey.FinishRegistrationInput) (model.Passkey, error) {
+	name, err := normalizeName(ip.Name)
+	if err != nil {
+		return model.Passkey{}, err
+	}
+
+	session, err := u.parseCeremony(ctx, ip.Ceremony, kindRegistration, sc.UserID)
+	if err != nil {
+		return model.Passkey{}, err
+	}
+
+	parsed, err := protocol.ParseCredentialCreationResponseBytes(ip.Credential)
+	if err != nil {
+		u.l.Warnf(ctx, "passkey.usecase.FinishRegistration.Parse: %v", err)
+		return model.Passkey{}, passkey.ErrVerificationFailed
+	}
+
+	user, err := u.loadUser(ctx, sc.UserID, sc.Username)
+	if err != nil {
+		return model.Passkey{}, err
+	}
+	if len(user.passkeys) >= u.maxPerUser {
+		return model.Passkey{}, passkey.ErrTooManyPasskeys
+	}
+
+	credential, err := u.webauthn.CreateCredential(user, session, parsed)
+	if err != nil {
+		u.l.Warnf(ctx, "passkey.usecase.FinishRegistration.CreateCredential: %v", err)
+		return model.Passkey{}, passkey.ErrVerificationFailed
+	}
+
+	credentialID := encodeID(credential.ID)
+	if _, err := u.repo.DetailByCredentialID(ctx, credentialID); err == nil {
+		return model.Passkey{}, passkey.ErrAlreadyRegistered
+	} else if !errors.Is(err, repository.ErrNotFound) {
+		u.l.Errorf(ctx, "passkey.usecase.FinishRegistration.DetailByCredentialID: %v", err)
+		return model.Passkey{}, fmt.Errorf("%w: %v", passkey.ErrInternalSystem, err)
+	}
+
+	transports := make([]string, 0, len(credential.Transport))
+	for _, t := range credential.Transport {
+		transports = append(transports, string(t))
+	}
+
+	created, err := u.repo.Create(ctx, repository.CreateOptions{
+		UserID:          sc.UserID,
+		Name:            name,
+		CredentialID:    credentialID,
+		PublicKey:       encodeID(credential.PublicKey),
+		AttestationType: credential.AttestationType,
+		Transports:      transports,
+		AAGUID:          formatAAGUID(credential.Authenticator.AAGUID),
+		Attachment:      string(credential.Authenticator.Attachment),
+		Flags:           int(credential.Flags.ProtocolValue()),
+		SignCount:       int64(credential.Authenticator.SignCount),
+	})
+	if err != nil {
+		u.l.Errorf(ctx, "passkey.usecase.FinishRegistration.Create: %v", err)
+		return model.Passkey{}, fmt.Errorf("%w: %v", passkey.ErrInternalSystem, err)
+	}
+
+	u.l.Infof(ctx, "Passkey registered: ID=%s UserID=%s", created.ID, sc.UserID)
+	return created, nil
+}
+
+// Rename changes the label of one of the caller's passkeys
+func (u *usecase) Rename(ctx context.Context, sc model.Scope, ip passkey.RenameInput) (model.Passkey, error) {
+	name, err := normalizeName(ip.Name)
+	if err != nil {
+		return model.Passkey{}, err
+	}
+
+	renamed, err := u.repo.Rename(ctx, repository.RenameOptions{ID: ip.ID, UserID: sc.UserID, Name: name})
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return model.Passkey{}, passkey.ErrPasskeyNotFound
+		}
+		u.l.Errorf(ctx, "passkey.usecase.Rename.Rename: %v", err)
+		return model.Passkey{}, fmt.Errorf("%w: %v", passkey.ErrInternalSystem, err)
+	}
+	return renamed, nil
+}
+
+// Delete removes one of the caller's passkeys. Users whose role requires MFA
+// and who have no other factor are asked to enrol again at their next login.
+func (u *usecase) Delete(ctx context.Context, sc model.Scope, id string) error {
+	if err := u.repo.Delete(ctx, repository.DeleteOptions{ID: id, UserID: sc.UserID}); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return passkey.ErrPasskeyNotFound
+		}
+		u.l.Errorf(ctx, "passkey.usecase.Delete.Delete: %v", err)
+		return fmt.Errorf("%w: %v", passkey.ErrInternalSystem, err)
+	}
+
+	u.l.Warnf(ctx, "Passkey deleted: ID=%s UserID=%s", id, sc.UserID)
+	return nil
+}
+
+// newCeremonyOutput signs the session data and serializes the browser options
+func (u *usecase) newCeremonyOutput(ctx context.Context, kind, userID string, options any, session *webauthn.SessionData) (passkey.CeremonyOutput, error) {
+	ceremony, err := u.signCeremony(ctx, kind, userID, session)
+	if err != nil {
+		u.l.Errorf(ctx, "passkey.usecase.newCeremonyOutput.signCeremony: %v", err)
+		return passkey.CeremonyOutput{}, fmt.Errorf("%w: %v", passkey.ErrInternalSystem, err)
+	}
+
+	data, err := json.Marshal(options)
+	if err != nil {
+		u.l.Errorf(ctx, "passkey.usecase.newCeremonyOutput.Marshal: %v", err)
+		return passkey.CeremonyOutput{}, fmt.Errorf("%w: %v", passkey.ErrInternalSystem, err)
+	}
+
+	return passkey.CeremonyOutput{
+		Ceremony: ceremony,
+		Options:  data,
+	}, nil
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"identity-srv/internal/model"
+
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/golang-jwt/jwt"
+)
+
+// ceremonyClaims carry the WebAuthn session data between the begin and finish
+// calls. The subject is the user the ceremony is bound to, empty for a
+// discoverable login.
+type ceremonyClaims struct {
+	jwt.StandardClaims
+	Type    string               `json:"type"`
+	Kind    string               `json:"kind"`
+	Session webauthn.SessionData `json:"session"`
+}
+
+// webauthnUser adapts a user and their passkeys to webauthn.User.
+// The user handle is the user ID, so a discoverable login identifies the user.
+type webauthnUser struct {
+	id          string
+	name        string
+	passkeys    []model.Passkey
+	credentials []webauthn.Credential
+}
+
+func (u *webauthnUser) WebAuthnID() []byte {
+	return []byte(u.id)
+}
+
+func (u *webauthnUser) WebAuthnName() string {
+	return u.name
+}
+
+func (u *webauthnUser) WebAuthnDisplayName() string {
+	return u.name
+}
+
+func (u *webauthnUser) WebAuthnCredentials() []webauthn.Credential {
+	return u.credentials
+}
@@ -0,0 +1,36 @@
+package http
+
+import (
+	"errors"
+	"identity-srv/internal/ratelimit"
+
+	pkgErrors "github.com/smap-hcmut/shared-libs/go/errors"
+)
+
+// --- HTTP error constants ---
+
+var (
+	errMissingSubject  = pkgErrors.NewHTTPError(29001, "Email or IP is required")
+	errInvalidSubject  = pkgErrors.NewHTTPError(29002, "Subject must be an email or an IP address")
+	errLockoutNotFound = pkgErrors.NewHTTPError(29003, "Lockout not found")
+	errInternalSystem  = pkgErrors.NewHTTPError(29004, "Internal system error")
+	errScopeNotFound   = pkgErrors.NewHTTPError(29005, "Scope not found")
+)
+
+// mapError maps UseCase domain errors to HTTP errors
+func (h handler) mapError(err error) error {
+	switch {
+	case errors.Is(err, ratelimit.ErrInvalidSubject):
+		return errInvalidSubject
+	case errors.Is(err, ratelimit.ErrLockoutNotFound):
+		return errLockoutNotFound
+	case errors.Is(err, ratelimit.ErrInternalSystem):
+		return errInternalSystem
+	default:
+		return err
+	}
+}
+
+var NotFound = []error{
+	errLockoutNotFound,
+}
@@ -0,0 +1,69 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/smap-hcmut/shared-libs/go/response"
+)
+
+// List
+// @Summary List Lockouts
+// @Description List the emails and IPs locked out after repeated failed OAuth callbacks, soonest to expire first. Requires ADMIN role.
+// @Tags Rate Limits
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.Resp{data=listResp} "Active lockouts"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/lockouts [GET]
+// @Security CookieAuth
+func (h handler) List(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Call UseCase
+	lockouts, err := h.uc.ListLockouts(ctx)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.ListLockouts: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 2. Response
+	response.OK(c, h.newListResp(lockouts))
+}
+
+// Clear
+// @Summary Clear Lockout
+// @Description Lift the lockout of an email or IP before it expires and forget its failed attempts. Requires ADMIN role.
+// @Tags Rate Limits
+// @Accept json
+// @Produce json
+// @Param subject path string true "Locked-out email or IP address"
+// @Success 200 {object} response.Resp "Lockout cleared"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 404 {object} response.Resp "Not Found"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/lockouts/{subject} [DELETE]
+// @Security CookieAuth
+func (h handler) Clear(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	subject, sc, err := h.processClearRequest(c)
+	if err != nil {
+		response.Error(c, err, h.discord)
+		return
+	}
+
+	// 2. Call UseCase
+	if err := h.uc.ClearLockout(ctx, sc, subject); err != nil {
+		h.l.Errorf(ctx, "uc.ClearLockout: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	response.OK(c, nil)
+}
@@ -0,0 +1,16 @@
+package http
+
+import "identity-srv/internal/ratelimit"
+
+// --- Response DTOs ---
+
+type listResp struct {
+	Lockouts []ratelimit.Lockout `json:"lockouts"`
+}
+
+func (h handler) newListResp(lockouts []ratelimit.Lockout) listResp {
+	if lockouts == nil {
+		lockouts = []ratelimit.Lockout{}
+	}
+	return listResp{Lockouts: lockouts}
+}
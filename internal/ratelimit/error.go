@@ -0,0 +1,37 @@
+package ratelimit
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrRateLimited     = errors.New("rate limit exceeded")
+	ErrLockedOut       = errors.New("temporarily locked out after failed logins")
+	ErrInvalidSubject  = errors.New("invalid lockout subject")
+	ErrLockoutNotFound = errors.New("lockout not found")
+	ErrInternalSystem  = errors.New("internal system error")
+)
+
+// LimitError refuses a request until RetryAfter has passed
+type LimitError struct {
+	Err        error // ErrRateLimited or ErrLockedOut
+	RetryAfter time.Duration
+}
+
+func (e *LimitError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *LimitError) Unwrap() error {
+	return e.Err
+}
+
+// RetryAfter returns how long the client must wait when err is, or wraps, a *LimitError
+func RetryAfter(err error) (time.Duration, bool) {
+	var limitErr *LimitError
+	if !errors.As(err, &limitErr) {
+		return 0, false
+	}
+	return limitErr.RetryAfter, true
+}
@@ -0,0 +1,24 @@
+package ratelimit
+
+import (
+	"context"
+
+	"identity-srv/internal/model"
+)
+
+//go:generate mockery --name UseCase
+type UseCase interface {
+	// Allow counts a request of subject against the route's rule for the
+	// dimension. It returns a *LimitError wrapping ErrRateLimited when the
+	// limit is reached, and nil when no rule is configured. The limiter fails
+	// open: a store error is logged and the request allowed.
+	Allow(ctx context.Context, ip AllowInput) error
+
+	// Lockout of repeated failed callbacks, per email and per IP
+	CheckLockout(ctx context.Context, ip LockoutInput) error
+	RecordFailure(ctx context.Context, ip LockoutInput)
+
+	// Admin management
+	ListLockouts(ctx context.Context) ([]Lockout, error)
+	ClearLockout(ctx context.Context, sc model.Scope, subject string) error
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// Store keeps the sliding windows and lockouts. The backend (redis, memory)
+// is selected by rate_limit.backend.
+//
+//go:generate mockery --name Store
+type Store interface {
+	// Hit records a request under key unless Limit requests were already
+	// recorded within the window. Refused requests are not recorded.
+	Hit(ctx context.Context, opts HitOptions) (HitResult, error)
+	// Count records an occurrence under key and returns the occurrences within the window
+	Count(ctx context.Context, opts CountOptions) (int64, error)
+
+	Lock(ctx context.Context, opts LockOptions) error
+	// LockedUntil returns the zero time when key is not locked
+	LockedUntil(ctx context.Context, key string) (time.Time, error)
+	// ListLocks returns the active locks whose key starts with prefix
+	ListLocks(ctx context.Context, prefix string) ([]Lock, error)
+	// Delete removes keys of any kind and returns how many existed
+	Delete(ctx context.Context, keys ...string) (int64, error)
+}
@@ -0,0 +1,29 @@
+package memory
+
+import (
+	"sync"
+	"time"
+
+	"identity-srv/internal/ratelimit/repository"
+)
+
+// The memory store keeps state in process. It is meant for local development;
+// with several replicas each one enforces its own limits.
+
+type implStore struct {
+	mu      sync.Mutex
+	windows map[string][]time.Time
+	locks   map[string]time.Time // key -> locked until
+	clock   func() time.Time
+}
+
+var _ repository.Store = &implStore{}
+
+// New creates an in-memory store
+func New() repository.Store {
+	return &implStore{
+		windows: make(map[string][]time.Time),
+		locks:   make(map[string]time.Time),
+		clock:   time.Now,
+	}
+}
@@ -0,0 +1,131 @@
+package memory
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"identity-srv/internal/ratelimit/repository"
+)
+
+// maxWindow bounds how long a window is kept; config rejects longer windows
+const maxWindow = 24 * time.Hour
+
+// Hit records a request unless the window is full
+func (s *implStore) Hit(ctx context.Context, opts repository.HitOptions) (repository.HitResult, error) {
+	now := s.clock()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.purgeExpiredLocked(now)
+	recent := s.recentLocked(opts.Key, now.Add(-opts.Window))
+	if len(recent) >= opts.Limit {
+		// recentLocked filters in place, the window must be shortened either way
+		s.windows[opts.Key] = recent
+		return repository.HitResult{RetryAfter: recent[0].Add(opts.Window).Sub(now)}, nil
+	}
+	s.windows[opts.Key] = append(recent, now)
+	return repository.HitResult{Allowed: true}, nil
+}
+
+// Count records an occurrence and returns the occurrences within the window
+func (s *implStore) Count(ctx context.Context, opts repository.CountOptions) (int64, error) {
+	now := s.clock()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.purgeExpiredLocked(now)
+	recent := append(s.recentLocked(opts.Key, now.Add(-opts.Window)), now)
+	s.windows[opts.Key] = recent
+	return int64(len(recent)), nil
+}
+
+// Lock locks key for the TTL
+func (s *implStore) Lock(ctx context.Context, opts repository.LockOptions) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.locks[opts.Key] = s.clock().Add(opts.TTL)
+	return nil
+}
+
+// LockedUntil returns when the lock on key ends, zero if none
+func (s *implStore) LockedUntil(ctx context.Context, key string) (time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	until, ok := s.locks[key]
+	if !ok || !until.After(s.clock()) {
+		return time.Time{}, nil
+	}
+	return until, nil
+}
+
+// ListLocks returns the active locks with the prefix
+func (s *implStore) ListLocks(ctx context.Context, prefix string) ([]repository.Lock, error) {
+	now := s.clock()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.purgeExpiredLocked(now)
+	var locks []repository.Lock
+	for key, until := range s.locks {
+		if strings.HasPrefix(key, prefix) {
+			locks = append(locks, repository.Lock{Key: key, Until: until})
+		}
+	}
+	return locks, nil
+}
+
+// Delete removes windows and locks
+func (s *implStore) Delete(ctx context.Context, keys ...string) (int64, error) {
+	now := s.clock()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var deleted int64
+	for _, key := range keys {
+		if _, ok := s.windows[key]; ok {
+			delete(s.windows, key)
+			deleted++
+		}
+		if until, ok := s.locks[key]; ok {
+			delete(s.locks, key)
+			if until.After(now) {
+				deleted++
+			}
+		}
+	}
+	return deleted, nil
+}
+
+// recentLocked returns the occurrences of key after since, reusing the stored
+// slice; the caller must hold the lock and store the result back
+func (s *implStore) recentLocked(key string, since time.Time) []time.Time {
+	recent := s.windows[key][:0]
+	for _, at := range s.windows[key] {
+		if at.After(since) {
+			recent = append(recent, at)
+		}
+	}
+	return recent
+}
+
+// purgeExpiredLocked drops windows and locks that can no longer matter; the
+// caller must hold the lock
+func (s *implStore) purgeExpiredLocked(now time.Time) {
+	for key, until := range s.locks {
+		if !until.After(now) {
+			delete(s.locks, key)
+		}
+	}
+	for key, occurrences := range s.windows {
+		if len(occurrences) == 0 || now.Sub(occurrences[len(occurrences)-1]) > maxWindow {
+			delete(s.windows, key)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"identity-srv/internal/ratelimit/repository"
+)
+
+// newTestStore returns a store whose clock is moved by advance
+func newTestStore() (*implStore, func(time.Duration)) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := New().(*implStore)
+	s.clock = func() time.Time { return now }
+	return s, func(d time.Duration) { now = now.Add(d) }
+}
+
+func TestHit(t *testing.T) {
+	ctx := context.Background()
+	s, advance := newTestStore()
+	opts := repository.HitOptions{Key: "ip:1", Limit: 2, Window: 10 * time.Second}
+
+	steps := []struct {
+		name       string
+		advance    time.Duration
+		limit      int
+		allowed    bool
+		retryAfter time.Duration
+	}{
+		{name: "first request", allowed: true},
+		{name: "second request", advance: 4 * time.Second, allowed: true},
+		{name: "window full", advance: time.Second, retryAfter: 5 * time.Second},
+		{name: "refused request is not recorded", advance: time.Second, retryAfter: 4 * time.Second},
+		{name: "oldest request left the window", advance: 5 * time.Second, allowed: true},
+		{name: "full again", retryAfter: 3 * time.Second},
+		// A lowered limit refuses while older requests leave the window; they
+		// must not be counted again once the limit is raised
+		{name: "lowered limit", advance: 4 * time.Second, limit: 1, retryAfter: 6 * time.Second},
+		{name: "raised limit", advance: time.Second, limit: 3, allowed: true},
+		{name: "raised limit, second", limit: 3, allowed: true},
+		{name: "raised limit, full", limit: 3, retryAfter: 5 * time.Second},
+	}
+
+	for _, step := range steps {
+		advance(step.advance)
+		opts.Limit = 2
+		if step.limit > 0 {
+			opts.Limit = step.limit
+		}
+		got, err := s.Hit(ctx, opts)
+		if err != nil {
+			t.Fatalf("%s: Hit() error = %v", step.name, err)
+		}
+		if got.Allowed != step.allowed || got.RetryAfter != step.retryAfter {
+			t.Fatalf("%s: Hit() = %+v, want allowed %v, retry after %v", step.name, got, step.allowed, step.retryAfter)
+		}
+	}
+}
+
+func TestCount(t *testing.T) {
+	ctx := context.Background()
+	s, advance := newTestStore()
+	opts := repository.CountOptions{Key: "failures:a@example.com", Window: time.Minute}
+
+	for i, want := range []int64{1, 2, 3} {
+		got, err := s.Count(ctx, opts)
+		if err != nil || got != want {
+			t.Fatalf("Count() #%d = %d, %v, want %d", i+1, got, err, want)
+		}
+		advance(20 * time.Second)
+	}
+
+	// The first occurrence is now a minute old
+	if got, _ := s.Count(ctx, opts); got != 3 {
+		t.Fatalf("Count() after the window moved = %d, want 3", got)
+	}
+}
+
+func TestDeleteClearsLockout(t *testing.T) {
+	ctx := context.Background()
+	s, advance := newTestStore()
+
+	if _, err := s.Count(ctx, repository.CountOptions{Key: "failures:a@example.com", Window: time.Hour}); err != nil {
+		t.Fatalf("Count() error = %v", err)
+	}
+	if err := s.Lock(ctx, repository.LockOptions{Key: "lock:a@example.com", TTL: 15 * time.Minute}); err != nil {
+		t.Fatalf("Lock() error = %v", err)
+	}
+	if until, _ := s.LockedUntil(ctx, "lock:a@example.com"); until.IsZero() {
+		t.Fatalf("LockedUntil() is zero, want a lock")
+	}
+	if locks, _ := s.ListLocks(ctx, "lock:"); len(locks) != 1 {
+		t.Fatalf("ListLocks() = %v, want one lock", locks)
+	}
+
+	deleted, err := s.Delete(ctx, "lock:a@example.com", "failures:a@example.com")
+	if err != nil || deleted != 2 {
+		t.Fatalf("Delete() = %d, %v, want 2", deleted, err)
+	}
+	if until, _ := s.LockedUntil(ctx, "lock:a@example.com"); !until.IsZero() {
+		t.Fatalf("LockedUntil() = %v after Delete, want zero", until)
+	}
+	if got, _ := s.Count(ctx, repository.CountOptions{Key: "failures:a@example.com", Window: time.Hour}); got != 1 {
+		t.Fatalf("Count() after Delete = %d, want the failures forgotten", got)
+	}
+
+	// An expired lock is gone without Delete and is not counted by it
+	_ = s.Lock(ctx, repository.LockOptions{Key: "lock:10.0.0.1", TTL: time.Minute})
+	advance(time.Minute)
+	if until, _ := s.LockedUntil(ctx, "lock:10.0.0.1"); !until.IsZero() {
+		t.Fatalf("LockedUntil() = %v after expiry, want zero", until)
+	}
+}
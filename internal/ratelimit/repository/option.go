@@ -0,0 +1,31 @@
+package repository
+
+import "time"
+
+type HitOptions struct {
+	Key    string
+	Limit  int
+	Window time.Duration
+}
+
+// HitResult tells whether the request was allowed and, when it was not, how
+// long until the oldest request in the window expires
+type HitResult struct {
+	Allowed    bool
+	RetryAfter time.Duration
+}
+
+type CountOptions struct {
+	Key    string
+	Window time.Duration
+}
+
+type LockOptions struct {
+	Key string
+	TTL time.Duration
+}
+
+type Lock struct {
+	Key   string
+	Until time.Time
+}
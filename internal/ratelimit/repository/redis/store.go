@@ -0,0 +1,147 @@
+package redis
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"identity-srv/internal/ratelimit/repository"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// hitScript trims the window, then adds the request if the window has room.
+// Scores are unix microseconds. Returns {allowed, retry_after_us}.
+var hitScript = goredis.NewScript(`
+local key = KEYS[1]
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local limit = tonumber(ARGV[3])
+redis.call('ZREMRANGEBYSCORE', key, '-inf', now - window)
+if redis.call('ZCARD', key) < limit then
+  redis.call('ZADD', key, now, ARGV[4])
+  redis.call('PEXPIRE', key, math.ceil(window / 1000))
+  return {1, 0}
+end
+local oldest = redis.call('ZRANGE', key, 0, 0, 'WITHSCORES')
+return {0, tonumber(oldest[2]) + window - now}
+`)
+
+// Hit runs the sliding window atomically in a script
+func (s *implStore) Hit(ctx context.Context, opts repository.HitOptions) (repository.HitResult, error) {
+	now := time.Now()
+	member, err := occurrenceID(now)
+	if err != nil {
+		return repository.HitResult{}, err
+	}
+
+	values, err := hitScript.Run(ctx, s.redis.GetClient(), []string{s.keyPrefix + opts.Key},
+		now.UnixMicro(), opts.Window.Microseconds(), opts.Limit, member).Int64Slice()
+	if err != nil {
+		return repository.HitResult{}, fmt.Errorf("hit %s: %w", opts.Key, err)
+	}
+	if len(values) != 2 {
+		return repository.HitResult{}, fmt.Errorf("hit %s: unexpected script result %v", opts.Key, values)
+	}
+	return repository.HitResult{
+		Allowed:    values[0] == 1,
+		RetryAfter: time.Duration(values[1]) * time.Microsecond,
+	}, nil
+}
+
+// Count keeps the occurrences in a sorted set scored by time
+func (s *implStore) Count(ctx context.Context, opts repository.CountOptions) (int64, error) {
+	key := s.keyPrefix + opts.Key
+	now := time.Now()
+	member, err := occurrenceID(now)
+	if err != nil {
+		return 0, err
+	}
+
+	var card *goredis.IntCmd
+	if _, err := s.redis.GetClient().TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		pipe.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(now.Add(-opts.Window).UnixMicro(), 10))
+		pipe.ZAdd(ctx, key, goredis.Z{Score: float64(now.UnixMicro()), Member: member})
+		card = pipe.ZCard(ctx, key)
+		pipe.PExpire(ctx, key, opts.Window)
+		return nil
+	}); err != nil {
+		return 0, fmt.Errorf("count %s: %w", opts.Key, err)
+	}
+	return card.Val(), nil
+}
+
+// Lock stores the lock's end time, expiring with it
+func (s *implStore) Lock(ctx context.Context, opts repository.LockOptions) error {
+	until := time.Now().Add(opts.TTL)
+	if err := s.redis.Set(ctx, s.keyPrefix+opts.Key, strconv.FormatInt(until.Unix(), 10), opts.TTL); err != nil {
+		return fmt.Errorf("lock %s: %w", opts.Key, err)
+	}
+	return nil
+}
+
+// LockedUntil reads the lock's end time
+func (s *implStore) LockedUntil(ctx context.Context, key string) (time.Time, error) {
+	value, err := s.redis.GetClient().Get(ctx, s.keyPrefix+key).Result()
+	if err != nil {
+		if errors.Is(err, goredis.Nil) {
+			return time.Time{}, nil
+		}
+		return time.Time{}, fmt.Errorf("locked until %s: %w", key, err)
+	}
+	unix, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("locked until %s: %w", key, err)
+	}
+	return time.Unix(unix, 0), nil
+}
+
+// ListLocks scans for lock keys. Locks are few, so a SCAN is cheap enough
+// for an admin listing.
+func (s *implStore) ListLocks(ctx context.Context, prefix string) ([]repository.Lock, error) {
+	client := s.redis.GetClient()
+	var locks []repository.Lock
+
+	iter := client.Scan(ctx, 0, s.keyPrefix+prefix+"*", 100).Iterator()
+	for iter.Next(ctx) {
+		key := strings.TrimPrefix(iter.Val(), s.keyPrefix)
+		until, err := s.LockedUntil(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		if !until.IsZero() {
+			locks = append(locks, repository.Lock{Key: key, Until: until})
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return nil, fmt.Errorf("list locks: %w", err)
+	}
+	return locks, nil
+}
+
+// Delete removes keys
+func (s *implStore) Delete(ctx context.Context, keys ...string) (int64, error) {
+	prefixed := make([]string, 0, len(keys))
+	for _, key := range keys {
+		prefixed = append(prefixed, s.keyPrefix+key)
+	}
+	deleted, err := s.redis.GetClient().Del(ctx, prefixed...).Result()
+	if err != nil {
+		return 0, fmt.Errorf("delete: %w", err)
+	}
+	return deleted, nil
+}
+
+// occurrenceID returns a unique sorted-set member for an occurrence
+func occurrenceID(now time.Time) (string, error) {
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", fmt.Errorf("rand.Read: %w", err)
+	}
+	return strconv.FormatInt(now.UnixNano(), 10) + "-" + hex.EncodeToString(suffix), nil
+}
@@ -0,0 +1,65 @@
+package ratelimit
+
+import "time"
+
+// Rate-limited routes, as named in rate_limit.routes
+const (
+	RouteLogin     = "login"
+	RouteCallback  = "callback"
+	RouteMagicLink = "magic_link"
+	RouteValidate  = "validate"
+)
+
+// What a request is counted by
+const (
+	DimensionIP     = "ip"
+	DimensionEmail  = "email"
+	DimensionClient = "client" // internal key name or service account client ID
+)
+
+// Kinds of lockout subject
+const (
+	SubjectEmail = "email"
+	SubjectIP    = "ip"
+)
+
+// Rule allows Limit requests per sliding Window. A zero limit disables it.
+type Rule struct {
+	Limit  int
+	Window time.Duration
+}
+
+// RouteRules are the rules of one route
+type RouteRules struct {
+	PerIP     Rule
+	PerEmail  Rule
+	PerClient Rule
+}
+
+// Options configures the limiter
+type Options struct {
+	Routes           map[string]RouteRules
+	LockoutThreshold int // failed callbacks within LockoutWindow that lock the subject out, 0 disables
+	LockoutWindow    time.Duration
+	LockoutDuration  time.Duration
+}
+
+// AllowInput is one request to count
+type AllowInput struct {
+	Route     string
+	Dimension string
+	Subject   string // the IP, email or client
+}
+
+// LockoutInput names the subjects of a callback; either may be empty
+type LockoutInput struct {
+	Email     string
+	IPAddress string
+}
+
+// Lockout is a subject refused until LockedUntil
+type Lockout struct {
+	Subject     string    `json:"subject"`
+	Kind        string    `json:"kind"` // email or ip
+	LockedUntil time.Time `json:"locked_until"`
+}
@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"net"
+	"strings"
+
+	"identity-srv/internal/ratelimit"
+)
+
+// Store key families
+const (
+	hitPrefix     = "hit:"
+	failurePrefix = "failure:"
+	lockPrefix    = "lock:"
+)
+
+// rule returns the route's rule for the dimension, zero when none
+func (u *usecase) rule(route, dimension string) ratelimit.Rule {
+	rules := u.opts.Routes[route]
+	switch dimension {
+	case ratelimit.DimensionIP:
+		return rules.PerIP
+	case ratelimit.DimensionEmail:
+		return rules.PerEmail
+	case ratelimit.DimensionClient:
+		return rules.PerClient
+	default:
+		return ratelimit.Rule{}
+	}
+}
+
+// subjects returns the lockout subjects ("email:<email>", "ip:<ip>") of a callback
+func subjects(ip ratelimit.LockoutInput) []string {
+	var result []string
+	if email := normalizeEmail(ip.Email); email != "" {
+		result = append(result, ratelimit.SubjectEmail+":"+email)
+	}
+	if ip.IPAddress != "" {
+		result = append(result, ratelimit.SubjectIP+":"+ip.IPAddress)
+	}
+	return result
+}
+
+// parseSubject turns an email or IP from the admin API into a lockout subject
+func parseSubject(raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if strings.Contains(raw, "@") {
+		return ratelimit.SubjectEmail + ":" + normalizeEmail(raw), nil
+	}
+	if ip := net.ParseIP(raw); ip != nil {
+		return ratelimit.SubjectIP + ":" + ip.String(), nil
+	}
+	return "", ratelimit.ErrInvalidSubject
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
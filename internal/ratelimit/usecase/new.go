@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"time"
+
+	"identity-srv/internal/ratelimit"
+	"identity-srv/internal/ratelimit/repository"
+
+	"github.com/smap-hcmut/shared-libs/go/log"
+)
+
+type usecase struct {
+	l     log.Logger
+	store repository.Store
+	clock func() time.Time
+	opts  ratelimit.Options
+}
+
+func New(l log.Logger, store repository.Store, opts ratelimit.Options) ratelimit.UseCase {
+	return &usecase{
+		l:     l,
+		store: store,
+		clock: time.Now,
+		opts:  opts,
+	}
+}
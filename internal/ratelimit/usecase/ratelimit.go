@@ -0,0 +1,142 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"identity-srv/internal/model"
+	"identity-srv/internal/ratelimit"
+	"identity-srv/internal/ratelimit/repository"
+)
+
+// Allow counts the request against the route's sliding window
+func (u *usecase) Allow(ctx context.Context, ip ratelimit.AllowInput) error {
+	rule := u.rule(ip.Route, ip.Dimension)
+	subject := ip.Subject
+	if ip.Dimension == ratelimit.DimensionEmail {
+		subject = normalizeEmail(subject)
+	}
+	if rule.Limit <= 0 || subject == "" {
+		return nil
+	}
+
+	result, err := u.store.Hit(ctx, repository.HitOptions{
+		Key:    hitPrefix + ip.Route + ":" + ip.Dimension + ":" + subject,
+		Limit:  rule.Limit,
+		Window: rule.Window,
+	})
+	if err != nil {
+		u.l.Errorf(ctx, "ratelimit.usecase.Allow.Hit: %v", err)
+		return nil
+	}
+	if result.Allowed {
+		return nil
+	}
+
+	u.l.Warnf(ctx, "Rate limit reached: Route=%s %s=%s RetryAfter=%s", ip.Route, ip.Dimension, subject, result.RetryAfter)
+	return &ratelimit.LimitError{Err: ratelimit.ErrRateLimited, RetryAfter: result.RetryAfter}
+}
+
+// CheckLockout refuses the callback while its email or IP is locked out
+func (u *usecase) CheckLockout(ctx context.Context, ip ratelimit.LockoutInput) error {
+	if u.opts.LockoutThreshold <= 0 {
+		return nil
+	}
+
+	now := u.clock()
+	for _, subject := range subjects(ip) {
+		until, err := u.store.LockedUntil(ctx, lockPrefix+subject)
+		if err != nil {
+			u.l.Errorf(ctx, "ratelimit.usecase.CheckLockout.LockedUntil: %v", err)
+			continue
+		}
+		if until.After(now) {
+			return &ratelimit.LimitError{Err: ratelimit.ErrLockedOut, RetryAfter: until.Sub(now)}
+		}
+	}
+	return nil
+}
+
+// RecordFailure counts a failed callback for its email and IP, and locks a
+// subject out once its failures reach the threshold within the window
+func (u *usecase) RecordFailure(ctx context.Context, ip ratelimit.LockoutInput) {
+	if u.opts.LockoutThreshold <= 0 {
+		return
+	}
+
+	for _, subject := range subjects(ip) {
+		count, err := u.store.Count(ctx, repository.CountOptions{
+			Key:    failurePrefix + subject,
+			Window: u.opts.LockoutWindow,
+		})
+		if err != nil {
+			u.l.Errorf(ctx, "ratelimit.usecase.RecordFailure.Count: %v", err)
+			continue
+		}
+		if count < int64(u.opts.LockoutThreshold) {
+			continue
+		}
+
+		if err := u.store.Lock(ctx, repository.LockOptions{
+			Key: lockPrefix + subject,
+			TTL: u.opts.LockoutDuration,
+		}); err != nil {
+			u.l.Errorf(ctx, "ratelimit.usecase.RecordFailure.Lock: %v", err)
+			continue
+		}
+		u.l.Warnf(ctx, "Locked out after %d failed logins: Subject=%s Duration=%s", count, subject, u.opts.LockoutDuration)
+	}
+}
+
+// ListLockouts returns the active lockouts, ending soonest first
+func (u *usecase) ListLockouts(ctx context.Context) ([]ratelimit.Lockout, error) {
+	locks, err := u.store.ListLocks(ctx, lockPrefix)
+	if err != nil {
+		u.l.Errorf(ctx, "ratelimit.usecase.ListLockouts.ListLocks: %v", err)
+		return nil, fmt.Errorf("%w: %v", ratelimit.ErrInternalSystem, err)
+	}
+
+	lockouts := make([]ratelimit.Lockout, 0, len(locks))
+	for _, lock := range locks {
+		kind, subject, ok := strings.Cut(strings.TrimPrefix(lock.Key, lockPrefix), ":")
+		if !ok {
+			continue
+		}
+		lockouts = append(lockouts, ratelimit.Lockout{
+			Subject:     subject,
+			Kind:        kind,
+			LockedUntil: lock.Until,
+		})
+	}
+	sort.Slice(lockouts, func(i, j int) bool {
+		return lockouts[i].LockedUntil.Before(lockouts[j].LockedUntil)
+	})
+	return lockouts, nil
+}
+
+// ClearLockout lifts the lockout of an email or IP and forgets its failures
+func (u *usecase) ClearLockout(ctx context.Context, sc model.Scope, raw string) error {
+	subject, err := parseSubject(raw)
+	if err != nil {
+		return err
+	}
+
+	until, err := u.store.LockedUntil(ctx, lockPrefix+subject)
+	if err != nil {
+		u.l.Errorf(ctx, "ratelimit.usecase.ClearLockout.LockedUntil: %v", err)
+		return fmt.Errorf("%w: %v", ratelimit.ErrInternalSystem, err)
+	}
+	if until.IsZero() {
+		return ratelimit.ErrLockoutNotFound
+	}
+
+	if _, err := u.store.Delete(ctx, lockPrefix+subject, failurePrefix+subject); err != nil {
+		u.l.Errorf(ctx, "ratelimit.usecase.ClearLockout.Delete: %v", err)
+		return fmt.Errorf("%w: %v", ratelimit.ErrInternalSystem, err)
+	}
+
+	u.l.Infof(ctx, "Lockout cleared: Subject=%s By=%s", subject, sc.UserID)
+	return nil
+}
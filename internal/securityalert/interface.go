@@ -0,0 +1,11 @@
+package securityalert
+
+import "context"
+
+//go:generate mockery --name UseCase
+type UseCase interface {
+	// Observe checks an authentication event against the alert rules and
+	// sends an alert when one fires. Failures are logged, never returned, so
+	// alerting cannot break a login.
+	Observe(ctx context.Context, ip ObserveInput)
+}
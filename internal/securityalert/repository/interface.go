@@ -0,0 +1,18 @@
+package repository
+
+import "context"
+
+// Store keeps the short-lived state of the alert rules. The backend (redis,
+// memory) is selected by security_alert.backend.
+//
+//go:generate mockery --name Store
+type Store interface {
+	// Count records an occurrence under key and returns the number of
+	// occurrences within the sliding window, this one included
+	Count(ctx context.Context, opts CountOptions) (int64, error)
+	// Acquire returns true when key was not acquired within the TTL, and holds it for the TTL
+	Acquire(ctx context.Context, opts AcquireOptions) (bool, error)
+	// Remember adds member to the set under key and reports whether it was
+	// already there. Members not remembered again within the TTL are forgotten.
+	Remember(ctx context.Context, opts RememberOptions) (RememberResult, error)
+}
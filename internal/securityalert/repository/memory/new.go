@@ -0,0 +1,31 @@
+package memory
+
+import (
+	"sync"
+	"time"
+
+	"identity-srv/internal/securityalert/repository"
+)
+
+// The memory store keeps state in process. It is meant for local development;
+// with several replicas each one counts on its own and alerts are sent once per replica.
+
+type implStore struct {
+	mu      sync.Mutex
+	counts  map[string][]time.Time
+	holds   map[string]time.Time            // key -> held until
+	members map[string]map[string]time.Time // key -> member -> forgotten at
+	clock   func() time.Time
+}
+
+var _ repository.Store = &implStore{}
+
+// New creates an in-memory store
+func New() repository.Store {
+	return &implStore{
+		counts:  make(map[string][]time.Time),
+		holds:   make(map[string]time.Time),
+		members: make(map[string]map[string]time.Time),
+		clock:   time.Now,
+	}
+}
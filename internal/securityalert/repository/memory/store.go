@@ -0,0 +1,85 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"identity-srv/internal/securityalert/repository"
+)
+
+// Count records an occurrence and returns the occurrences within the window
+func (s *implStore) Count(ctx context.Context, opts repository.CountOptions) (int64, error) {
+	now := s.clock()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.purgeExpiredLocked(now)
+	since := now.Add(-opts.Window)
+	recent := s.counts[opts.Key][:0]
+	for _, at := range s.counts[opts.Key] {
+		if at.After(since) {
+			recent = append(recent, at)
+		}
+	}
+	recent = append(recent, now)
+	s.counts[opts.Key] = recent
+	return int64(len(recent)), nil
+}
+
+// Acquire holds key for the TTL unless it is already held
+func (s *implStore) Acquire(ctx context.Context, opts repository.AcquireOptions) (bool, error) {
+	now := s.clock()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if until, ok := s.holds[opts.Key]; ok && until.After(now) {
+		return false, nil
+	}
+	s.holds[opts.Key] = now.Add(opts.TTL)
+	return true, nil
+}
+
+// Remember adds member to the set and reports whether it was known
+func (s *implStore) Remember(ctx context.Context, opts repository.RememberOptions) (repository.RememberResult, error) {
+	now := s.clock()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	set, ok := s.members[opts.Key]
+	if !ok {
+		set = make(map[string]time.Time)
+		s.members[opts.Key] = set
+	}
+	for member, forgetAt := range set {
+		if !forgetAt.After(now) {
+			delete(set, member)
+		}
+	}
+
+	_, known := set[opts.Member]
+	result := repository.RememberResult{
+		Known: known,
+		Empty: len(set) == 0,
+	}
+	set[opts.Member] = now.Add(opts.TTL)
+	return result, nil
+}
+
+// purgeExpiredLocked drops counters and holds that can no longer matter; the
+// caller must hold the lock. Counters are dropped once their newest occurrence
+// is a day old, which outlasts any configured window.
+func (s *implStore) purgeExpiredLocked(now time.Time) {
+	for key, until := range s.holds {
+		if !until.After(now) {
+			delete(s.holds, key)
+		}
+	}
+	for key, occurrences := range s.counts {
+		if len(occurrences) == 0 || now.Sub(occurrences[len(occurrences)-1]) > 24*time.Hour {
+			delete(s.counts, key)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package repository
+
+import "time"
+
+type CountOptions struct {
+	Key    string
+	Window time.Duration
+}
+
+type AcquireOptions struct {
+	Key string
+	TTL time.Duration
+}
+
+type RememberOptions struct {
+	Key    string
+	Member string
+	TTL    time.Duration
+}
+
+// RememberResult tells whether the member was known, and whether the set held
+// any other member, so a first observation can be told from a new one
+type RememberResult struct {
+	Known bool
+	Empty bool
+}
@@ -0,0 +1,23 @@
+package redis
+
+import (
+	"identity-srv/internal/securityalert/repository"
+
+	pkgRedis "github.com/smap-hcmut/shared-libs/go/redis"
+)
+
+type implStore struct {
+	redis     pkgRedis.IRedis
+	keyPrefix string
+}
+
+var _ repository.Store = &implStore{}
+
+// New creates a Redis-backed store, shared by all replicas.
+// keyPrefix namespaces the counters, holds and known sets of the alert rules.
+func New(redisClient pkgRedis.IRedis, keyPrefix string) repository.Store {
+	return &implStore{
+		redis:     redisClient,
+		keyPrefix: keyPrefix,
+	}
+}
@@ -0,0 +1,81 @@
+package redis
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"identity-srv/internal/securityalert/repository"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// Count keeps the occurrences in a sorted set scored by time: older ones are
+// trimmed, the new one added and the rest counted in a single transaction
+func (s *implStore) Count(ctx context.Context, opts repository.CountOptions) (int64, error) {
+	key := s.keyPrefix + "count:" + opts.Key
+	now := time.Now()
+	member, err := occurrenceID(now)
+	if err != nil {
+		return 0, err
+	}
+
+	var card *goredis.IntCmd
+	if _, err := s.redis.GetClient().TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		pipe.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(now.Add(-opts.Window).UnixMicro(), 10))
+		pipe.ZAdd(ctx, key, goredis.Z{Score: float64(now.UnixMicro()), Member: member})
+		card = pipe.ZCard(ctx, key)
+		pipe.PExpire(ctx, key, opts.Window)
+		return nil
+	}); err != nil {
+		return 0, fmt.Errorf("count %s: %w", opts.Key, err)
+	}
+	return card.Val(), nil
+}
+
+// Acquire sets the hold key only if it does not exist
+func (s *implStore) Acquire(ctx context.Context, opts repository.AcquireOptions) (bool, error) {
+	ok, err := s.redis.GetClient().SetNX(ctx, s.keyPrefix+"hold:"+opts.Key, "1", opts.TTL).Result()
+	if err != nil {
+		return false, fmt.Errorf("acquire %s: %w", opts.Key, err)
+	}
+	return ok, nil
+}
+
+// Remember keeps the members in a sorted set scored by when they are forgotten
+func (s *implStore) Remember(ctx context.Context, opts repository.RememberOptions) (repository.RememberResult, error) {
+	key := s.keyPrefix + "known:" + opts.Key
+	now := time.Now()
+
+	var score *goredis.FloatCmd
+	var card *goredis.IntCmd
+	if _, err := s.redis.GetClient().TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		pipe.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(now.Unix(), 10))
+		score = pipe.ZScore(ctx, key, opts.Member)
+		card = pipe.ZCard(ctx, key)
+		pipe.ZAdd(ctx, key, goredis.Z{Score: float64(now.Add(opts.TTL).Unix()), Member: opts.Member})
+		pipe.Expire(ctx, key, opts.TTL)
+		return nil
+	}); err != nil && !errors.Is(err, goredis.Nil) { // Nil: the member is not known
+		return repository.RememberResult{}, fmt.Errorf("remember %s: %w", opts.Key, err)
+	}
+
+	known := score.Err() == nil
+	return repository.RememberResult{
+		Known: known,
+		Empty: card.Val() == 0,
+	}, nil
+}
+
+// occurrenceID returns a unique sorted-set member for an occurrence
+func occurrenceID(now time.Time) (string, error) {
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", fmt.Errorf("rand.Read: %w", err)
+	}
+	return strconv.FormatInt(now.UnixNano(), 10) + "-" + hex.EncodeToString(suffix), nil
+}
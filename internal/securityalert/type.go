@@ -0,0 +1,36 @@
+package securityalert
+
+import "time"
+
+// Alert rules, used as the alert kind
+const (
+	KindAdminNewDevice = "admin_new_device" // ADMIN login from an IP or device not seen before
+	KindFailedLogins   = "failed_logins"    // repeated failed or denied logins for one email
+	KindRoleEscalation = "role_escalation"  // a user became ADMIN
+	KindMassRevocation = "mass_revocation"  // many revocations by one actor in a short time
+	KindImpersonation  = "impersonation"    // an admin started impersonating a user
+)
+
+// ObserveInput is an audited authentication event with its request metadata
+type ObserveInput struct {
+	EventType   string // model.AuditEvent*
+	Outcome     string // model.AuditOutcome*, success when empty
+	Reason      string
+	ActorID     string
+	ActorEmail  string
+	TargetID    string
+	TargetEmail string
+	Metadata    map[string]string
+	IPAddress   string
+	UserAgent   string
+}
+
+// Options holds the rule thresholds. A zero threshold disables its rule.
+type Options struct {
+	DedupWindow          time.Duration // an alert with the same subject is sent at most once per window
+	KnownDeviceTTL       time.Duration // how long an admin's IP or device stays known after its last login
+	FailedLoginThreshold int
+	FailedLoginWindow    time.Duration
+	RevocationThreshold  int
+	RevocationWindow     time.Duration
+}
@@ -0,0 +1,21 @@
+package usecase
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// deviceID identifies a device by its user agent. Browsers change the string
+// on upgrades, so an upgrade counts as a new device once.
+func deviceID(userAgent string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(userAgent)))
+	return hex.EncodeToString(sum[:8])
+}
+
+func orDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"time"
+
+	"identity-srv/internal/securityalert"
+	"identity-srv/internal/securityalert/repository"
+	"identity-srv/pkg/alert"
+
+	"github.com/smap-hcmut/shared-libs/go/log"
+)
+
+type usecase struct {
+	l     log.Logger
+	store repository.Store
+	sink  alert.Sink
+	clock func() time.Time
+	opts  securityalert.Options
+}
+
+func New(l log.Logger, store repository.Store, sink alert.Sink, opts securityalert.Options) securityalert.UseCase {
+	return &usecase{
+		l:     l,
+		store: store,
+		sink:  sink,
+		clock: time.Now,
+		opts:  opts,
+	}
+}
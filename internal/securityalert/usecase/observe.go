@@ -0,0 +1,222 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"identity-srv/internal/model"
+	"identity-srv/internal/securityalert"
+	"identity-srv/internal/securityalert/repository"
+	"identity-srv/pkg/alert"
+)
+
+// Observe routes the event to the rules that watch it
+func (u *usecase) Observe(ctx context.Context, ip securityalert.ObserveInput) {
+	failed := ip.Outcome == model.AuditOutcomeFailure
+
+	switch ip.EventType {
+	case model.AuditEventLogin:
+		if failed {
+			u.checkFailedLogins(ctx, ip)
+		} else if ip.Metadata["role"] == model.RoleAdmin {
+			u.checkAdminLogin(ctx, ip)
+		}
+	case model.AuditEventAccessDenied:
+		u.checkFailedLogins(ctx, ip)
+	case model.AuditEventRoleChange:
+		if ip.Metadata["new_role"] == model.RoleAdmin {
+			u.alertRoleEscalation(ctx, ip)
+		}
+	case model.AuditEventTokenRevoke, model.AuditEventLogoutAll:
+		u.checkRevocations(ctx, ip)
+	case model.AuditEventImpersonation:
+		if !failed {
+			u.alertImpersonation(ctx, ip)
+		}
+	}
+}
+
+// checkAdminLogin alerts when an admin logs in from an IP or device that none
+// of their logins used within the known-device TTL. The first login seen for
+// an admin only records the baseline.
+func (u *usecase) checkAdminLogin(ctx context.Context, ip securityalert.ObserveInput) {
+	if ip.ActorID == "" {
+		return
+	}
+
+	var changes []string
+	var first bool
+	if ip.IPAddress != "" {
+		known, err := u.store.Remember(ctx, repository.RememberOptions{
+			Key:    "ip:" + ip.ActorID,
+			Member: ip.IPAddress,
+			TTL:    u.opts.KnownDeviceTTL,
+		})
+		if err != nil {
+			u.l.Errorf(ctx, "securityalert.usecase.checkAdminLogin.Remember: %v", err)
+			return
+		}
+		first = known.Empty
+		if !known.Known && !known.Empty {
+			changes = append(changes, "IP address")
+		}
+	}
+
+	device := deviceID(ip.UserAgent)
+	known, err := u.store.Remember(ctx, repository.RememberOptions{
+		Key:    "device:" + ip.ActorID,
+		Member: device,
+		TTL:    u.opts.KnownDeviceTTL,
+	})
+	if err != nil {
+		u.l.Errorf(ctx, "securityalert.usecase.checkAdminLogin.Remember: %v", err)
+		return
+	}
+	first = first || known.Empty
+	if !known.Known && !known.Empty {
+		changes = append(changes, "device")
+	}
+
+	if first || len(changes) == 0 {
+		return
+	}
+	u.send(ctx, ip.ActorID+":"+ip.IPAddress+":"+device, alert.Alert{
+		Kind:     securityalert.KindAdminNewDevice,
+		Severity: alert.SeverityWarning,
+		Title:    "Admin login from a new " + strings.Join(changes, " and "),
+		Fields: []alert.Field{
+			{Name: "User", Value: ip.ActorEmail},
+			{Name: "IP", Value: orDash(ip.IPAddress)},
+			{Name: "User agent", Value: orDash(ip.UserAgent)},
+			{Name: "Method", Value: orDash(ip.Metadata["amr"])},
+		},
+	})
+}
+
+// checkFailedLogins alerts when the failed or denied logins of one email reach
+// the threshold within the window
+func (u *usecase) checkFailedLogins(ctx context.Context, ip securityalert.ObserveInput) {
+	email := strings.ToLower(strings.TrimSpace(ip.ActorEmail))
+	if u.opts.FailedLoginThreshold <= 0 || email == "" {
+		return
+	}
+
+	count, err := u.store.Count(ctx, repository.CountOptions{
+		Key:    "failed_login:" + email,
+		Window: u.opts.FailedLoginWindow,
+	})
+	if err != nil {
+		u.l.Errorf(ctx, "securityalert.usecase.checkFailedLogins.Count: %v", err)
+		return
+	}
+	if count < int64(u.opts.FailedLoginThreshold) {
+		return
+	}
+
+	u.send(ctx, email, alert.Alert{
+		Kind:     securityalert.KindFailedLogins,
+		Severity: alert.SeverityWarning,
+		Title:    "Repeated failed logins",
+		Fields: []alert.Field{
+			{Name: "Email", Value: email},
+			{Name: "Failures", Value: fmt.Sprintf("%d in %s", count, u.opts.FailedLoginWindow)},
+			{Name: "Last reason", Value: orDash(ip.Reason)},
+			{Name: "Last IP", Value: orDash(ip.IPAddress)},
+		},
+	})
+}
+
+// alertRoleEscalation alerts on every user that becomes ADMIN, whether an
+// admin or the login role mapping changed the role
+func (u *usecase) alertRoleEscalation(ctx context.Context, ip securityalert.ObserveInput) {
+	changedBy := ip.ActorEmail
+	if changedBy == "" {
+		changedBy = "role mapping at login"
+	}
+	u.send(ctx, ip.TargetID, alert.Alert{
+		Kind:     securityalert.KindRoleEscalation,
+		Severity: alert.SeverityCritical,
+		Title:    "User escalated to ADMIN",
+		Fields: []alert.Field{
+			{Name: "User", Value: orDash(ip.TargetEmail)},
+			{Name: "Previous role", Value: orDash(ip.Metadata["old_role"])},
+			{Name: "Changed by", Value: changedBy},
+			{Name: "IP", Value: orDash(ip.IPAddress)},
+		},
+	})
+}
+
+// checkRevocations alerts when the revocations by one actor reach the
+// threshold within the window
+func (u *usecase) checkRevocations(ctx context.Context, ip securityalert.ObserveInput) {
+	if u.opts.RevocationThreshold <= 0 {
+		return
+	}
+	actor := ip.ActorID
+	if actor == "" {
+		actor = "internal"
+	}
+
+	count, err := u.store.Count(ctx, repository.CountOptions{
+		Key:    "revocation:" + actor,
+		Window: u.opts.RevocationWindow,
+	})
+	if err != nil {
+		u.l.Errorf(ctx, "securityalert.usecase.checkRevocations.Count: %v", err)
+		return
+	}
+	if count < int64(u.opts.RevocationThreshold) {
+		return
+	}
+
+	u.send(ctx, actor, alert.Alert{
+		Kind:     securityalert.KindMassRevocation,
+		Severity: alert.SeverityWarning,
+		Title:    "Mass token revocation",
+		Fields: []alert.Field{
+			{Name: "Actor", Value: orDash(ip.ActorEmail)},
+			{Name: "Revocations", Value: fmt.Sprintf("%d in %s", count, u.opts.RevocationWindow)},
+			{Name: "IP", Value: orDash(ip.IPAddress)},
+		},
+	})
+}
+
+// alertImpersonation alerts when an admin starts impersonating a user
+func (u *usecase) alertImpersonation(ctx context.Context, ip securityalert.ObserveInput) {
+	u.send(ctx, ip.ActorID+":"+ip.TargetID, alert.Alert{
+		Kind:     securityalert.KindImpersonation,
+		Severity: alert.SeverityWarning,
+		Title:    "Impersonation started",
+		Fields: []alert.Field{
+			{Name: "Admin", Value: ip.ActorEmail},
+			{Name: "User", Value: orDash(ip.TargetEmail)},
+			{Name: "Reason", Value: orDash(ip.Metadata["reason"])},
+			{Name: "Expires at", Value: orDash(ip.Metadata["expires_at"])},
+			{Name: "IP", Value: orDash(ip.IPAddress)},
+		},
+	})
+}
+
+// send delivers the alert unless one of the same kind and subject was sent
+// within the dedup window. A failure is only logged.
+func (u *usecase) send(ctx context.Context, subject string, a alert.Alert) {
+	ok, err := u.store.Acquire(ctx, repository.AcquireOptions{
+		Key: a.Kind + ":" + subject,
+		TTL: u.opts.DedupWindow,
+	})
+	if err != nil {
+		u.l.Errorf(ctx, "securityalert.usecase.send.Acquire: %v", err)
+		return
+	}
+	if !ok {
+		return
+	}
+
+	a.OccurredAt = u.clock()
+	if err := u.sink.Send(ctx, a); err != nil {
+		u.l.Errorf(ctx, "securityalert.usecase.send.Send: kind=%s: %v", a.Kind, err)
+		return
+	}
+	u.l.Infof(ctx, "Security alert sent: Kind=%s Subject=%s", a.Kind, subject)
+}
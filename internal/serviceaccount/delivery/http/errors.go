@@ -0,0 +1,92 @@
+package http
+
+import (
+	"errors"
+	"identity-srv/internal/serviceaccount"
+	"net/http"
+
+	pkgErrors "github.com/smap-hcmut/shared-libs/go/errors"
+)
+
+// --- HTTP error constants ---
+
+var (
+	errWrongBody       = pkgErrors.NewHTTPError(22001, "Wrong body")
+	errAccountNotFound = pkgErrors.NewHTTPError(22002, "Service account not found")
+	errClientIDExisted = pkgErrors.NewHTTPError(22003, "Client ID already exists")
+	errInvalidClientID = pkgErrors.NewHTTPError(22004, "Invalid client ID")
+	errInvalidName     = pkgErrors.NewHTTPError(22005, "Invalid service account name")
+	errInvalidScope    = pkgErrors.NewHTTPError(22006, "Invalid scope")
+	errInvalidAudience = pkgErrors.NewHTTPError(22007, "Invalid audience")
+	errMissingID       = pkgErrors.NewHTTPError(22008, "Service account ID is required")
+	errInternalSystem  = pkgErrors.NewHTTPError(22009, "Internal system error")
+	errScopeNotFound   = pkgErrors.NewHTTPError(22010, "Scope not found")
+)
+
+// mapError maps UseCase domain errors to HTTP errors
+func (h handler) mapError(err error) error {
+	switch {
+	case errors.Is(err, serviceaccount.ErrAccountNotFound):
+		return errAccountNotFound
+	case errors.Is(err, serviceaccount.ErrClientIDExisted):
+		return errClientIDExisted
+	case errors.Is(err, serviceaccount.ErrInvalidClientID):
+		return errInvalidClientID
+	case errors.Is(err, serviceaccount.ErrInvalidName):
+		return errInvalidName
+	case errors.Is(err, serviceaccount.ErrInvalidScope):
+		return errInvalidScope
+	case errors.Is(err, serviceaccount.ErrInvalidAudience):
+		return errInvalidAudience
+	case errors.Is(err, serviceaccount.ErrInternalSystem):
+		return errInternalSystem
+	default:
+		return err
+	}
+}
+
+var NotFound = []error{
+	errAccountNotFound,
+}
+
+// --- OAuth2 token endpoint errors (RFC 6749 section 5.2) ---
+
+// tokenError is an OAuth2 error response. The token endpoint answers in the
+// RFC format rather than response.Resp so standard OAuth2 client libraries work.
+type tokenError struct {
+	status      int
+	Code        string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+}
+
+func (e tokenError) Error() string {
+	return e.Code
+}
+
+var (
+	tokenErrInvalidRequest       = tokenError{status: http.StatusBadRequest, Code: "invalid_request"}
+	tokenErrInvalidSubjectToken  = tokenError{status: http.StatusBadRequest, Code: "invalid_request", Description: "subject_token is missing, invalid or of an unsupported type"}
+	tokenErrInvalidClient        = tokenError{status: http.StatusUnauthorized, Code: "invalid_client"}
+	tokenErrUnsupportedGrantType = tokenError{status: http.StatusBadRequest, Code: "unsupported_grant_type"}
+	tokenErrInvalidScope         = tokenError{status: http.StatusBadRequest, Code: "invalid_scope"}
+	tokenErrInvalidTarget        = tokenError{status: http.StatusBadRequest, Code: "invalid_target"} // RFC 8707
+	tokenErrServerError          = tokenError{status: http.StatusInternalServerError, Code: "server_error"}
+)
+
+// mapTokenError maps UseCase domain errors to OAuth2 token errors
+func (h handler) mapTokenError(err error) tokenError {
+	switch {
+	case errors.Is(err, serviceaccount.ErrInvalidClient):
+		return tokenErrInvalidClient
+	case errors.Is(err, serviceaccount.ErrUnsupportedGrantType):
+		return tokenErrUnsupportedGrantType
+	case errors.Is(err, serviceaccount.ErrInvalidScope):
+		return tokenErrInvalidScope
+	case errors.Is(err, serviceaccount.ErrInvalidAudience):
+		return tokenErrInvalidTarget
+	case errors.Is(err, serviceaccount.ErrInvalidSubjectToken):
+		return tokenErrInvalidSubjectToken
+	default:
+		return tokenErrServerError
+	}
+}
@@ -0,0 +1,198 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/smap-hcmut/shared-libs/go/response"
+)
+
+// Create
+// @Summary Create Service Account
+// @Description Register a service client for the client_credentials grant. The client secret is returned only once. Requires ADMIN role.
+// @Tags Service Accounts
+// @Accept json
+// @Produce json
+// @Param body body createReq true "Client ID, name, scopes and audiences"
+// @Success 200 {object} response.Resp{data=createResp} "Created service account (secret shown once)"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/service-accounts [POST]
+// @Security CookieAuth
+func (h handler) Create(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	input, sc, err := h.processCreateRequest(c)
+	if err != nil {
+		response.Error(c, err, h.discord)
+		return
+	}
+
+	// 2. Call UseCase
+	output, err := h.uc.Create(ctx, sc, input)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.Create: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	response.OK(c, h.newCreateResp(output))
+}
+
+// List
+// @Summary List Service Accounts
+// @Description List all service accounts (without secrets). Requires ADMIN role.
+// @Tags Service Accounts
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.Resp{data=listResp} "Service accounts"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/service-accounts [GET]
+// @Security CookieAuth
+func (h handler) List(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Call UseCase
+	accounts, err := h.uc.List(ctx)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.List: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 2. Response
+	response.OK(c, h.newListResp(accounts))
+}
+
+// Disable
+// @Summary Disable Service Account
+// @Description Stop a service account from obtaining new tokens. Issued tokens expire within service_account.token_ttl. Requires ADMIN role.
+// @Tags Service Accounts
+// @Accept json
+// @Produce json
+// @Param id path string true "Service account ID"
+// @Success 200 {object} response.Resp "Service account disabled"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 404 {object} response.Resp "Not Found"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/service-accounts/{id} [DELETE]
+// @Security CookieAuth
+func (h handler) Disable(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	id, sc, err := h.processIDRequest(c)
+	if err != nil {
+		response.Error(c, err, h.discord)
+		return
+	}
+
+	// 2. Call UseCase
+	if err := h.uc.Disable(ctx, sc, id); err != nil {
+		h.l.Errorf(ctx, "uc.Disable: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	response.OK(c, nil)
+}
+
+// RotateSecret
+// @Summary Rotate Service Account Secret
+// @Description Replace the client secret. The old secret stops working immediately; the new one is returned only once. Requires ADMIN role.
+// @Tags Service Accounts
+// @Accept json
+// @Produce json
+// @Param id path string true "Service account ID"
+// @Success 200 {object} response.Resp{data=createResp} "Service account with its new secret"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 404 {object} response.Resp "Not Found"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/service-accounts/{id}/rotate-secret [POST]
+// @Security CookieAuth
+func (h handler) RotateSecret(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	id, sc, err := h.processIDRequest(c)
+	if err != nil {
+		response.Error(c, err, h.discord)
+		return
+	}
+
+	// 2. Call UseCase
+	output, err := h.uc.RotateSecret(ctx, sc, id)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.RotateSecret: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	response.OK(c, h.newCreateResp(output))
+}
+
+// Token
+// @Summary OAuth2 Token Endpoint
+// @Description client_credentials grant for service accounts, and RFC 8693 token exchange: a service with scope tokens:exchange trades a user token it received for a short-lived user token scoped to one of its audiences. Client credentials are read from HTTP Basic auth or the form body. Responds in the RFC 6749 format, not the standard envelope.
+// @Tags OAuth2
+// @Accept x-www-form-urlencoded
+// @Produce json
+// @Param grant_type formData string true "client_credentials or urn:ietf:params:oauth:grant-type:token-exchange"
+// @Param client_id formData string false "Client ID (if not using Basic auth)"
+// @Param client_secret formData string false "Client secret (if not using Basic auth)"
+// @Param scope formData string false "Space-separated scopes, defaults to all granted scopes (client_credentials only)"
+// @Param audience formData string false "Target service, optional when the client has one audience"
+// @Param subject_token formData string false "User token to exchange (token exchange only)"
+// @Param subject_token_type formData string false "urn:ietf:params:oauth:token-type:access_token or urn:ietf:params:oauth:token-type:jwt (token exchange only)"
+// @Success 200 {object} tokenResp "Access token"
+// @Failure 400 {object} tokenError "invalid_request, unsupported_grant_type, invalid_scope or invalid_target"
+// @Failure 401 {object} tokenError "invalid_client"
+// @Failure 500 {object} tokenError "server_error"
+// @Router /oauth2/token [POST]
+func (h handler) Token(c *gin.Context) {
+	ctx := c.Request.Context()
+	c.Header("Cache-Control", "no-store")
+	c.Header("Pragma", "no-cache")
+
+	// 1. Process Request
+	input, err := h.processTokenRequest(c)
+	if err != nil {
+		h.tokenError(c, err)
+		return
+	}
+
+	// 2. Call UseCase
+	output, err := h.uc.IssueToken(ctx, input)
+	if err != nil {
+		h.l.Warnf(ctx, "uc.IssueToken: %v", err)
+		h.tokenError(c, h.mapTokenError(err))
+		return
+	}
+
+	// 3. Response
+	c.JSON(http.StatusOK, h.newTokenResp(output))
+}
+
+func (h handler) tokenError(c *gin.Context, err error) {
+	var tErr tokenError
+	if !errors.As(err, &tErr) {
+		tErr = tokenErrServerError
+	}
+	if tErr.status == http.StatusUnauthorized {
+		c.Header("WWW-Authenticate", `Basic realm="oauth2"`)
+	}
+	c.JSON(tErr.status, tErr)
+}
@@ -0,0 +1,30 @@
+package http
+
+import (
+	internalmw "identity-srv/internal/middleware"
+	"identity-srv/internal/serviceaccount"
+
+	"github.com/gin-gonic/gin"
+	"github.com/smap-hcmut/shared-libs/go/discord"
+	"github.com/smap-hcmut/shared-libs/go/log"
+	"github.com/smap-hcmut/shared-libs/go/middleware"
+)
+
+type Handler interface {
+	RegisterRoutes(r *gin.RouterGroup, mw *middleware.Middleware, imw *internalmw.Middleware)
+	RegisterTokenRoutes(r *gin.RouterGroup)
+}
+
+type handler struct {
+	l       log.Logger
+	uc      serviceaccount.UseCase
+	discord discord.IDiscord
+}
+
+func New(l log.Logger, uc serviceaccount.UseCase, discord discord.IDiscord) Handler {
+	return handler{
+		l:       l,
+		uc:      uc,
+		discord: discord,
+	}
+}
@@ -0,0 +1,125 @@
+package http
+
+import (
+	"identity-srv/internal/model"
+	"identity-srv/internal/serviceaccount"
+	"strings"
+	"time"
+)
+
+// --- Request DTOs ---
+
+type createReq struct {
+	ClientID  string   `json:"client_id" binding:"required"`
+	Name      string   `json:"name" binding:"required"`
+	Scopes    []string `json:"scopes"`
+	Audiences []string `json:"audiences" binding:"required"`
+}
+
+func (r createReq) toInput() serviceaccount.CreateInput {
+	return serviceaccount.CreateInput{
+		ClientID:  r.ClientID,
+		Name:      r.Name,
+		Scopes:    r.Scopes,
+		Audiences: r.Audiences,
+	}
+}
+
+// tokenReq is a form-encoded OAuth2 token request (RFC 6749 section 4.4.2,
+// RFC 8693 section 2.1)
+type tokenReq struct {
+	GrantType        string `form:"grant_type" binding:"required"`
+	ClientID         string `form:"client_id"`
+	ClientSecret     string `form:"client_secret"`
+	Scope            string `form:"scope"`    // space-separated
+	Audience         string `form:"audience"` // target service
+	SubjectToken     string `form:"subject_token"`
+	SubjectTokenType string `form:"subject_token_type"`
+}
+
+func (r tokenReq) toInput() serviceaccount.IssueTokenInput {
+	return serviceaccount.IssueTokenInput{
+		GrantType:        r.GrantType,
+		ClientID:         r.ClientID,
+		ClientSecret:     r.ClientSecret,
+		Scopes:           strings.Fields(r.Scope),
+		Audience:         r.Audience,
+		SubjectToken:     r.SubjectToken,
+		SubjectTokenType: r.SubjectTokenType,
+	}
+}
+
+// --- Response DTOs ---
+
+type serviceAccountResp struct {
+	ID           string     `json:"id"`
+	ClientID     string     `json:"client_id"`
+	Name         string     `json:"name"`
+	SecretPrefix string     `json:"secret_prefix"`
+	Scopes       []string   `json:"scopes"`
+	Audiences    []string   `json:"audiences"`
+	IsActive     bool       `json:"is_active"`
+	LastUsedAt   *time.Time `json:"last_used_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+type createResp struct {
+	ClientSecret string `json:"client_secret"` // plaintext, shown once
+	serviceAccountResp
+}
+
+type listResp struct {
+	ServiceAccounts []serviceAccountResp `json:"service_accounts"`
+}
+
+// tokenResp is an OAuth2 access token response (RFC 6749 section 5.1, RFC 8693 section 2.2.1)
+type tokenResp struct {
+	AccessToken     string `json:"access_token"`
+	IssuedTokenType string `json:"issued_token_type,omitempty"`
+	TokenType       string `json:"token_type"`
+	ExpiresIn       int64  `json:"expires_in"`
+	Scope           string `json:"scope,omitempty"`
+}
+
+// --- Response Mappers ---
+
+func (h handler) newServiceAccountResp(o model.ServiceAccount) serviceAccountResp {
+	return serviceAccountResp{
+		ID:           o.ID,
+		ClientID:     o.ClientID,
+		Name:         o.Name,
+		SecretPrefix: o.SecretPrefix,
+		Scopes:       o.Scopes,
+		Audiences:    o.Audiences,
+		IsActive:     o.IsActive,
+		LastUsedAt:   o.LastUsedAt,
+		CreatedAt:    o.CreatedAt,
+		UpdatedAt:    o.UpdatedAt,
+	}
+}
+
+func (h handler) newCreateResp(o serviceaccount.CreateOutput) createResp {
+	return createResp{
+		ClientSecret:       o.ClientSecret,
+		serviceAccountResp: h.newServiceAccountResp(o.ServiceAccount),
+	}
+}
+
+func (h handler) newListResp(o []model.ServiceAccount) listResp {
+	accounts := make([]serviceAccountResp, 0, len(o))
+	for _, account := range o {
+		accounts = append(accounts, h.newServiceAccountResp(account))
+	}
+	return listResp{ServiceAccounts: accounts}
+}
+
+func (h handler) newTokenResp(o serviceaccount.IssueTokenOutput) tokenResp {
+	return tokenResp{
+		AccessToken:     o.AccessToken,
+		IssuedTokenType: o.IssuedTokenType,
+		TokenType:       o.TokenType,
+		ExpiresIn:       int64(o.ExpiresIn / time.Second),
+		Scope:           strings.Join(o.Scopes, " "),
+	}
+}
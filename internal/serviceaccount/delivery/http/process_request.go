@@ -0,0 +1,73 @@
+package http
+
+import (
+	"identity-srv/internal/model"
+	"identity-srv/internal/serviceaccount"
+
+	"github.com/gin-gonic/gin"
+	"github.com/smap-hcmut/shared-libs/go/auth"
+)
+
+// --- Scope extraction ---
+
+func (h handler) getScope(c *gin.Context) (model.Scope, bool) {
+	payload, ok := auth.GetPayloadFromContext(c.Request.Context())
+	if !ok || payload.UserID == "" {
+		return model.Scope{}, false
+	}
+	return model.Scope{
+		UserID:    payload.UserID,
+		Username:  payload.Username,
+		Role:      payload.Role,
+		JTI:       payload.Id,
+		ExpiresAt: payload.ExpiresAt,
+	}, true
+}
+
+// --- Process request functions ---
+
+func (h handler) processCreateRequest(c *gin.Context) (serviceaccount.CreateInput, model.Scope, error) {
+	sc, ok := h.getScope(c)
+	if !ok {
+		return serviceaccount.CreateInput{}, model.Scope{}, errScopeNotFound
+	}
+
+	var req createReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		return serviceaccount.CreateInput{}, model.Scope{}, errWrongBody
+	}
+
+	return req.toInput(), sc, nil
+}
+
+func (h handler) processIDRequest(c *gin.Context) (string, model.Scope, error) {
+	sc, ok := h.getScope(c)
+	if !ok {
+		return "", model.Scope{}, errScopeNotFound
+	}
+
+	id := c.Param("id")
+	if id == "" {
+		return "", model.Scope{}, errMissingID
+	}
+	return id, sc, nil
+}
+
+// processTokenRequest reads a token request. Client credentials may come from
+// HTTP Basic auth (preferred by RFC 6749) or from the form body.
+func (h handler) processTokenRequest(c *gin.Context) (serviceaccount.IssueTokenInput, error) {
+	var req tokenReq
+	if err := c.ShouldBind(&req); err != nil {
+		return serviceaccount.IssueTokenInput{}, tokenErrInvalidRequest
+	}
+
+	if clientID, clientSecret, ok := c.Request.BasicAuth(); ok {
+		if req.ClientID != "" && req.ClientID != clientID {
+			return serviceaccount.IssueTokenInput{}, tokenErrInvalidRequest
+		}
+		req.ClientID = clientID
+		req.ClientSecret = clientSecret
+	}
+
+	return req.toInput(), nil
+}
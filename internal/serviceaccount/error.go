@@ -0,0 +1,18 @@
+package serviceaccount
+
+import "errors"
+
+var (
+	ErrAccountNotFound      = errors.New("service account not found")
+	ErrClientIDExisted      = errors.New("client id already exists")
+	ErrInvalidClientID      = errors.New("invalid client id")
+	ErrInvalidName          = errors.New("invalid service account name")
+	ErrInvalidScope         = errors.New("invalid scope")
+	ErrInvalidAudience      = errors.New("invalid audience")
+	ErrInvalidClient        = errors.New("invalid client credentials")
+	ErrUnsupportedGrantType = errors.New("unsupported grant type")
+	ErrInvalidToken         = errors.New("invalid service token")
+	ErrInvalidSubjectToken  = errors.New("invalid subject token")
+	ErrInsufficientScope    = errors.New("insufficient scope")
+	ErrInternalSystem       = errors.New("internal system error")
+)
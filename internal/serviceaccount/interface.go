@@ -0,0 +1,22 @@
+package serviceaccount
+
+import (
+	"context"
+
+	"identity-srv/internal/model"
+)
+
+//go:generate mockery --name UseCase
+type UseCase interface {
+	// Admin management
+	Create(ctx context.Context, sc model.Scope, ip CreateInput) (CreateOutput, error)
+	List(ctx context.Context) ([]model.ServiceAccount, error)
+	Disable(ctx context.Context, sc model.Scope, id string) error
+	RotateSecret(ctx context.Context, sc model.Scope, id string) (CreateOutput, error)
+
+	// OAuth2 token endpoint (client_credentials and token exchange grants)
+	IssueToken(ctx context.Context, ip IssueTokenInput) (IssueTokenOutput, error)
+
+	// Validation (used by the internal route middleware)
+	ValidateToken(ctx context.Context, ip ValidateTokenInput) (ServiceClaims, error)
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"identity-srv/internal/model"
+)
+
+//go:generate mockery --name Repository
+type Repository interface {
+	Create(ctx context.Context, opts CreateOptions) (model.ServiceAccount, error)
+	List(ctx context.Context) ([]model.ServiceAccount, error)
+	Detail(ctx context.Context, id string) (model.ServiceAccount, error)
+	DetailByClientID(ctx context.Context, clientID string) (model.ServiceAccount, error)
+	Update(ctx context.Context, opts UpdateOptions) (model.ServiceAccount, error)
+	TouchLastUsed(ctx context.Context, opts TouchLastUsedOptions) error
+}
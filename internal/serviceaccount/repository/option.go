@@ -0,0 +1,28 @@
+package repository
+
+import "time"
+
+type CreateOptions struct {
+	ClientID     string
+	Name         string
+	SecretPrefix string
+	SecretHash   string
+	Scopes       []string
+	Audiences    []string
+	CreatedBy    string
+}
+
+// UpdateOptions changes only the fields that are set
+type UpdateOptions struct {
+	ID           string
+	IsActive     *bool
+	SecretPrefix string // set together with SecretHash
+	SecretHash   string
+}
+
+type TouchLastUsedOptions struct {
+	ID string
+	// MinInterval skips the write when last_used_at is more recent, so
+	// frequent token requests do not cost one UPDATE each
+	MinInterval time.Duration
+}
@@ -0,0 +1,50 @@
+package postgres
+
+import (
+	"identity-srv/internal/serviceaccount/repository"
+	"identity-srv/internal/sqlboiler"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/types"
+	"github.com/smap-hcmut/shared-libs/go/postgres"
+)
+
+func (r *implRepository) buildServiceAccount(opts repository.CreateOptions) *sqlboiler.ServiceAccount {
+	now := r.clock()
+	account := &sqlboiler.ServiceAccount{
+		ID:           postgres.NewUUID(),
+		ClientID:     opts.ClientID,
+		Name:         opts.Name,
+		SecretPrefix: opts.SecretPrefix,
+		SecretHash:   opts.SecretHash,
+		Scopes:       types.StringArray(opts.Scopes),
+		Audiences:    types.StringArray(opts.Audiences),
+		IsActive:     true,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	if account.Scopes == nil {
+		account.Scopes = types.StringArray{}
+	}
+	if account.Audiences == nil {
+		account.Audiences = types.StringArray{}
+	}
+	if opts.CreatedBy != "" {
+		account.CreatedBy = null.StringFrom(opts.CreatedBy)
+	}
+	return account
+}
+
+func (r *implRepository) buildUpdateColumns(opts repository.UpdateOptions) sqlboiler.M {
+	cols := sqlboiler.M{
+		sqlboiler.ServiceAccountColumns.UpdatedAt: r.clock(),
+	}
+	if opts.IsActive != nil {
+		cols[sqlboiler.ServiceAccountColumns.IsActive] = *opts.IsActive
+	}
+	if opts.SecretHash != "" {
+		cols[sqlboiler.ServiceAccountColumns.SecretHash] = opts.SecretHash
+		cols[sqlboiler.ServiceAccountColumns.SecretPrefix] = opts.SecretPrefix
+	}
+	return cols
+}
@@ -0,0 +1,98 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"identity-srv/internal/model"
+	"identity-srv/internal/serviceaccount/repository"
+	"identity-srv/internal/sqlboiler"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// Create inserts a new service account
+func (r *implRepository) Create(ctx context.Context, opts repository.CreateOptions) (model.ServiceAccount, error) {
+	account := r.buildServiceAccount(opts)
+	if err := account.Insert(ctx, r.db, boil.Infer()); err != nil {
+		r.l.Errorf(ctx, "serviceaccount.repository.postgres.Create: %v", err)
+		return model.ServiceAccount{}, err
+	}
+	return *model.NewServiceAccountFromDB(account), nil
+}
+
+// List returns all service accounts ordered by client id
+func (r *implRepository) List(ctx context.Context) ([]model.ServiceAccount, error) {
+	accounts, err := sqlboiler.ServiceAccounts(
+		qm.OrderBy(sqlboiler.ServiceAccountColumns.ClientID),
+	).All(ctx, r.db)
+	if err != nil {
+		r.l.Errorf(ctx, "serviceaccount.repository.postgres.List: %v", err)
+		return nil, err
+	}
+
+	result := make([]model.ServiceAccount, 0, len(accounts))
+	for _, account := range accounts {
+		result = append(result, *model.NewServiceAccountFromDB(account))
+	}
+	return result, nil
+}
+
+// Detail finds a service account by ID
+func (r *implRepository) Detail(ctx context.Context, id string) (model.ServiceAccount, error) {
+	return r.detail(ctx, "Detail", sqlboiler.ServiceAccountWhere.ID.EQ(id))
+}
+
+// DetailByClientID finds a service account by its client id
+func (r *implRepository) DetailByClientID(ctx context.Context, clientID string) (model.ServiceAccount, error) {
+	return r.detail(ctx, "DetailByClientID", sqlboiler.ServiceAccountWhere.ClientID.EQ(clientID))
+}
+
+func (r *implRepository) detail(ctx context.Context, method string, mods ...qm.QueryMod) (model.ServiceAccount, error) {
+	account, err := sqlboiler.ServiceAccounts(mods...).One(ctx, r.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.ServiceAccount{}, repository.ErrNotFound
+		}
+		r.l.Errorf(ctx, "serviceaccount.repository.postgres.%s: %v", method, err)
+		return model.ServiceAccount{}, err
+	}
+	return *model.NewServiceAccountFromDB(account), nil
+}
+
+// Update changes the active flag and/or the secret of a service account
+func (r *implRepository) Update(ctx context.Context, opts repository.UpdateOptions) (model.ServiceAccount, error) {
+	rows, err := sqlboiler.ServiceAccounts(
+		sqlboiler.ServiceAccountWhere.ID.EQ(opts.ID),
+	).UpdateAll(ctx, r.db, r.buildUpdateColumns(opts))
+	if err != nil {
+		r.l.Errorf(ctx, "serviceaccount.repository.postgres.Update: %v", err)
+		return model.ServiceAccount{}, err
+	}
+	if rows == 0 {
+		return model.ServiceAccount{}, repository.ErrNotFound
+	}
+	return r.Detail(ctx, opts.ID)
+}
+
+// TouchLastUsed records a token request, at most once per MinInterval
+func (r *implRepository) TouchLastUsed(ctx context.Context, opts repository.TouchLastUsedOptions) error {
+	now := r.clock()
+	_, err := sqlboiler.ServiceAccounts(
+		sqlboiler.ServiceAccountWhere.ID.EQ(opts.ID),
+		qm.Expr(
+			sqlboiler.ServiceAccountWhere.LastUsedAt.IsNull(),
+			qm.Or2(sqlboiler.ServiceAccountWhere.LastUsedAt.LT(null.TimeFrom(now.Add(-opts.MinInterval)))),
+		),
+	).UpdateAll(ctx, r.db, sqlboiler.M{
+		sqlboiler.ServiceAccountColumns.LastUsedAt: null.TimeFrom(now),
+	})
+	if err != nil {
+		r.l.Errorf(ctx, "serviceaccount.repository.postgres.TouchLastUsed: %v", err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package serviceaccount
+
+import (
+	"identity-srv/internal/model"
+	"time"
+)
+
+// Grants served by the token endpoint
+const (
+	GrantTypeClientCredentials = "client_credentials"
+	GrantTypeTokenExchange     = "urn:ietf:params:oauth:grant-type:token-exchange" // RFC 8693
+)
+
+// Token type identifiers used by token exchange (RFC 8693 section 3)
+const (
+	TokenTypeAccessToken = "urn:ietf:params:oauth:token-type:access_token"
+	TokenTypeJWT         = "urn:ietf:params:oauth:token-type:jwt"
+)
+
+// ScopeTokenExchange is the scope a service account needs to exchange user tokens
+const ScopeTokenExchange = "tokens:exchange"
+
+// TokenTypeBearer is the token_type reported for issued service tokens
+const TokenTypeBearer = "Bearer"
+
+// CreateInput contains the data for registering a service account
+type CreateInput struct {
+	ClientID  string
+	Name      string
+	Scopes    []string
+	Audiences []string
+}
+
+// CreateOutput contains the created or rotated account and its plaintext secret
+type CreateOutput struct {
+	ClientSecret   string // plaintext, shown once
+	ServiceAccount model.ServiceAccount
+}
+
+// IssueTokenInput contains a client_credentials or token exchange request
+type IssueTokenInput struct {
+	GrantType        string
+	ClientID         string
+	ClientSecret     string
+	Scopes           []string // empty requests every scope granted to the client
+	Audience         string   // may be empty when the client has a single audience
+	SubjectToken     string   // token exchange only: the user token to exchange
+	SubjectTokenType string   // token exchange only: TokenTypeAccessToken or TokenTypeJWT
+}
+
+// IssueTokenOutput contains an issued service token or exchanged user token
+type IssueTokenOutput struct {
+	AccessToken     string
+	TokenType       string
+	IssuedTokenType string // token exchange only
+	ExpiresIn       time.Duration
+	Scopes          []string
+	Audience        string
+}
+
+// ValidateTokenInput contains a service token and what the caller requires of it
+type ValidateTokenInput struct {
+	Token    string
+	Audience string // required audience, usually the validating service itself
+	Scope    string // required scope, empty accepts any
+}
+
+// ServiceClaims identifies the service behind a validated service token
+type ServiceClaims struct {
+	ClientID  string
+	Audience  string
+	Scopes    []string
+	JTI       string
+	ExpiresAt time.Time
+}
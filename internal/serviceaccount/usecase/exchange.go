@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"identity-srv/internal/authentication"
+	"identity-srv/internal/serviceaccount"
+	"identity-srv/internal/serviceaccount/repository"
+)
+
+// exchangeToken trades a user token presented by a service for a short-lived
+// user token scoped to one of the service's downstream audiences (RFC 8693)
+func (u *usecase) exchangeToken(ctx context.Context, ip serviceaccount.IssueTokenInput) (serviceaccount.IssueTokenOutput, error) {
+	if ip.SubjectToken == "" {
+		return serviceaccount.IssueTokenOutput{}, fmt.Errorf("%w: subject_token is required", serviceaccount.ErrInvalidSubjectToken)
+	}
+	if ip.SubjectTokenType != serviceaccount.TokenTypeAccessToken && ip.SubjectTokenType != serviceaccount.TokenTypeJWT {
+		return serviceaccount.IssueTokenOutput{}, fmt.Errorf("%w: unsupported subject_token_type %q", serviceaccount.ErrInvalidSubjectToken, ip.SubjectTokenType)
+	}
+
+	account, err := u.authenticateClient(ctx, ip.ClientID, ip.ClientSecret)
+	if err != nil {
+		return serviceaccount.IssueTokenOutput{}, err
+	}
+	if !account.HasScope(serviceaccount.ScopeTokenExchange) {
+		u.l.Warnf(ctx, "serviceaccount.usecase.exchangeToken: client %s lacks scope %s", account.ClientID, serviceaccount.ScopeTokenExchange)
+		return serviceaccount.IssueTokenOutput{}, fmt.Errorf("%w: %q", serviceaccount.ErrInvalidScope, serviceaccount.ScopeTokenExchange)
+	}
+
+	audience, err := u.grantedAudience(account, ip.Audience)
+	if err != nil {
+		return serviceaccount.IssueTokenOutput{}, err
+	}
+
+	output, err := u.authUC.ExchangeToken(ctx, authentication.ExchangeTokenInput{
+		SubjectToken: ip.SubjectToken,
+		ClientID:     account.ClientID,
+		Audience:     audience,
+		TTL:          u.exchangeTTL,
+	})
+	if err != nil {
+		if errors.Is(err, authentication.ErrInvalidSubjectToken) {
+			u.l.Warnf(ctx, "serviceaccount.usecase.exchangeToken: client %s presented an invalid subject token", account.ClientID)
+			return serviceaccount.IssueTokenOutput{}, serviceaccount.ErrInvalidSubjectToken
+		}
+		u.l.Errorf(ctx, "serviceaccount.usecase.exchangeToken.ExchangeToken: %v", err)
+		return serviceaccount.IssueTokenOutput{}, fmt.Errorf("%w: %v", serviceaccount.ErrInternalSystem, err)
+	}
+
+	// Usage tracking must not fail the request
+	if err := u.repo.TouchLastUsed(ctx, repository.TouchLastUsedOptions{
+		ID:          account.ID,
+		MinInterval: lastUsedInterval,
+	}); err != nil {
+		u.l.Warnf(ctx, "serviceaccount.usecase.exchangeToken.TouchLastUsed: %v", err)
+	}
+
+	return serviceaccount.IssueTokenOutput{
+		AccessToken:     output.Token,
+		TokenType:       serviceaccount.TokenTypeBearer,
+		IssuedTokenType: serviceaccount.TokenTypeAccessToken,
+		ExpiresIn:       output.ExpiresAt.Sub(u.clock()),
+		Audience:        audience,
+	}, nil
+}
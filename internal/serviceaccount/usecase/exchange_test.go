@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"identity-srv/internal/authentication"
+	"identity-srv/internal/serviceaccount"
+)
+
+// fakeExchanger issues exchanged tokens that expire with the subject token
+type fakeExchanger struct {
+	authentication.UseCase
+	now           time.Time
+	subjectExpiry time.Time
+	got           authentication.ExchangeTokenInput
+}
+
+func (f *fakeExchanger) ExchangeToken(ctx context.Context, input authentication.ExchangeTokenInput) (*authentication.ExchangeTokenOutput, error) {
+	f.got = input
+	if input.SubjectToken != "user-token" {
+		return nil, authentication.ErrInvalidSubjectToken
+	}
+	expiresAt := f.now.Add(input.TTL)
+	if f.subjectExpiry.Before(expiresAt) {
+		expiresAt = f.subjectExpiry
+	}
+	return &authentication.ExchangeTokenOutput{Token: "exchanged", ExpiresAt: expiresAt}, nil
+}
+
+func TestExchangeToken(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		clientID      string
+		subjectToken  string
+		subjectExpiry time.Time
+		want          error
+		wantExpiresIn time.Duration
+	}{
+		{name: "exchange_ttl", clientID: "gateway-srv", subjectToken: "user-token", subjectExpiry: now.Add(time.Hour), wantExpiresIn: 5 * time.Minute},
+		{name: "capped by the subject token", clientID: "gateway-srv", subjectToken: "user-token", subjectExpiry: now.Add(2 * time.Minute), wantExpiresIn: 2 * time.Minute},
+		{name: "invalid subject token", clientID: "gateway-srv", subjectToken: "revoked", want: serviceaccount.ErrInvalidSubjectToken},
+		{name: "client without tokens:exchange", clientID: "report-srv", subjectToken: "user-token", want: serviceaccount.ErrInvalidScope},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := newTestUsecase()
+			uc.clock = func() time.Time { return now }
+			exchanger := &fakeExchanger{now: now, subjectExpiry: tt.subjectExpiry}
+			uc.authUC = exchanger
+
+			out, err := uc.IssueToken(context.Background(), serviceaccount.IssueTokenInput{
+				GrantType:        serviceaccount.GrantTypeTokenExchange,
+				ClientID:         tt.clientID,
+				ClientSecret:     testSecret,
+				SubjectToken:     tt.subjectToken,
+				SubjectTokenType: serviceaccount.TokenTypeAccessToken,
+			})
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("IssueToken() error = %v, want %v", err, tt.want)
+			}
+			if tt.want != nil {
+				return
+			}
+			if exchanger.got.TTL != uc.exchangeTTL || exchanger.got.ClientID != "gateway-srv" || exchanger.got.Audience != "report-srv" {
+				t.Fatalf("ExchangeToken() input = %+v, want exchange_ttl for gateway-srv to report-srv", exchanger.got)
+			}
+			if out.ExpiresIn != tt.wantExpiresIn || out.IssuedTokenType != serviceaccount.TokenTypeAccessToken {
+				t.Fatalf("IssueToken() = %+v, want expires in %v", out, tt.wantExpiresIn)
+			}
+		})
+	}
+}
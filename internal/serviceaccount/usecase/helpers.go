@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"time"
+
+	"identity-srv/internal/model"
+)
+
+const (
+	maxNameLength       = 100
+	maxListLength       = 20
+	displayPrefixLength = 6 // characters of the secret kept in secret_prefix
+	secretBytes         = 32
+	jtiBytes            = 16
+	lastUsedInterval    = time.Minute
+)
+
+var (
+	// clientIDPattern accepts service names like "project-srv"
+	clientIDPattern = regexp.MustCompile(`^[a-z][a-z0-9-]{2,63}$`)
+	// valuePattern accepts scopes like "users:read" and audiences like "identity-srv"
+	valuePattern = regexp.MustCompile(`^[a-z][a-z0-9_.:-]{0,63}$`)
+)
+
+// generateSecret returns "smap_sk_" followed by 256 bits of base64url randomness
+func generateSecret() (string, error) {
+	secret := make([]byte, secretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("rand.Read: %w", err)
+	}
+	return model.ServiceClientSecretPrefix + base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+// hashSecret returns the hex SHA-256 of the secret. The secret is high-entropy,
+// so a fast hash is enough.
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// secretPrefix returns the part of the secret that is safe to display
+func secretPrefix(secret string) string {
+	return secret[:len(model.ServiceClientSecretPrefix)+displayPrefixLength]
+}
+
+// generateJTI returns a random token ID
+func generateJTI() (string, error) {
+	id := make([]byte, jtiBytes)
+	if _, err := rand.Read(id); err != nil {
+		return "", fmt.Errorf("rand.Read: %w", err)
+	}
+	return hex.EncodeToString(id), nil
+}
+
+// normalizeList validates and de-duplicates scopes or audiences, preserving order.
+// invalid is returned (wrapped) for the first value that does not match.
+func normalizeList(values []string, invalid error) ([]string, error) {
+	if len(values) > maxListLength {
+		return nil, invalid
+	}
+
+	normalized := make([]string, 0, len(values))
+	seen := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		if !valuePattern.MatchString(value) {
+			return nil, fmt.Errorf("%w: %q", invalid, value)
+		}
+		if _, ok := seen[value]; ok {
+			continue
+		}
+		seen[value] = struct{}{}
+		normalized = append(normalized, value)
+	}
+	return normalized, nil
+}
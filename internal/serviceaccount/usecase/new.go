@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"time"
+
+	"identity-srv/config"
+	"identity-srv/internal/authentication"
+	"identity-srv/internal/serviceaccount"
+	"identity-srv/internal/serviceaccount/repository"
+
+	"github.com/smap-hcmut/shared-libs/go/log"
+)
+
+type usecase struct {
+	l           log.Logger
+	repo        repository.Repository
+	authUC      authentication.UseCase
+	clock       func() time.Time
+	signingKey  []byte
+	issuer      string
+	tokenTTL    time.Duration
+	exchangeTTL time.Duration
+}
+
+// New creates the service account usecase. authUC validates and issues user
+// tokens for token exchange; nil disables that grant.
+func New(l log.Logger, repo repository.Repository, authUC authentication.UseCase, cfg config.ServiceAccountConfig, issuer string) serviceaccount.UseCase {
+	return &usecase{
+		l:           l,
+		repo:        repo,
+		authUC:      authUC,
+		clock:       time.Now,
+		signingKey:  []byte(cfg.SigningKey),
+		issuer:      issuer,
+		tokenTTL:    time.Duration(cfg.TokenTTL) * time.Second,
+		exchangeTTL: time.Duration(cfg.ExchangeTTL) * time.Second,
+	}
+}
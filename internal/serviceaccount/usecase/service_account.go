@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"identity-srv/internal/model"
+	"identity-srv/internal/serviceaccount"
+	"identity-srv/internal/serviceaccount/repository"
+)
+
+// Create registers a new service account.
+// The plaintext client secret is only returned here; Postgres keeps its hash.
+func (u *usecase) Create(ctx context.Context, sc model.Scope, ip serviceaccount.CreateInput) (serviceaccount.CreateOutput, error) {
+	clientID := strings.TrimSpace(ip.ClientID)
+	if !clientIDPattern.MatchString(clientID) {
+		return serviceaccount.CreateOutput{}, serviceaccount.ErrInvalidClientID
+	}
+
+	name := strings.TrimSpace(ip.Name)
+	if name == "" || len(name) > maxNameLength {
+		return serviceaccount.CreateOutput{}, serviceaccount.ErrInvalidName
+	}
+
+	scopes, err := normalizeList(ip.Scopes, serviceaccount.ErrInvalidScope)
+	if err != nil {
+		return serviceaccount.CreateOutput{}, err
+	}
+	audiences, err := normalizeList(ip.Audiences, serviceaccount.ErrInvalidAudience)
+	if err != nil {
+		return serviceaccount.CreateOutput{}, err
+	}
+	if len(audiences) == 0 {
+		return serviceaccount.CreateOutput{}, serviceaccount.ErrInvalidAudience
+	}
+
+	_, err = u.repo.DetailByClientID(ctx, clientID)
+	if err == nil {
+		return serviceaccount.CreateOutput{}, serviceaccount.ErrClientIDExisted
+	}
+	if !errors.Is(err, repository.ErrNotFound) {
+		u.l.Errorf(ctx, "serviceaccount.usecase.Create.DetailByClientID: %v", err)
+		return serviceaccount.CreateOutput{}, fmt.Errorf("%w: %v", serviceaccount.ErrInternalSystem, err)
+	}
+
+	secret, err := generateSecret()
+	if err != nil {
+		u.l.Errorf(ctx, "serviceaccount.usecase.Create.generateSecret: %v", err)
+		return serviceaccount.CreateOutput{}, fmt.Errorf("%w: %v", serviceaccount.ErrInternalSystem, err)
+	}
+
+	created, err := u.repo.Create(ctx, repository.CreateOptions{
+		ClientID:     clientID,
+		Name:         name,
+		SecretPrefix: secretPrefix(secret),
+		SecretHash:   hashSecret(secret),
+		Scopes:       scopes,
+		Audiences:    audiences,
+		CreatedBy:    sc.UserID,
+	})
+	if err != nil {
+		u.l.Errorf(ctx, "serviceaccount.usecase.Create.Create: %v", err)
+		return serviceaccount.CreateOutput{}, fmt.Errorf("%w: %v", serviceaccount.ErrInternalSystem, err)
+	}
+
+	u.l.Infof(ctx, "Service account created: ClientID=%s By=%s", clientID, sc.UserID)
+	return serviceaccount.CreateOutput{
+		ClientSecret:   secret,
+		ServiceAccount: created,
+	}, nil
+}
+
+// List returns all service accounts, including disabled ones
+func (u *usecase) List(ctx context.Context) ([]model.ServiceAccount, error) {
+	accounts, err := u.repo.List(ctx)
+	if err != nil {
+		u.l.Errorf(ctx, "serviceaccount.usecase.List.List: %v", err)
+		return nil, fmt.Errorf("%w: %v", serviceaccount.ErrInternalSystem, err)
+	}
+	return accounts, nil
+}
+
+// Disable stops a service account from obtaining new tokens.
+// Tokens already issued stay valid until they expire (at most service_account.token_ttl).
+func (u *usecase) Disable(ctx context.Context, sc model.Scope, id string) error {
+	active := false
+	if _, err := u.repo.Update(ctx, repository.UpdateOptions{ID: id, IsActive: &active}); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return serviceaccount.ErrAccountNotFound
+		}
+		u.l.Errorf(ctx, "serviceaccount.usecase.Disable.Update: %v", err)
+		return fmt.Errorf("%w: %v", serviceaccount.ErrInternalSystem, err)
+	}
+
+	u.l.Infof(ctx, "Service account disabled: ID=%s By=%s", id, sc.UserID)
+	return nil
+}
+
+// RotateSecret replaces the client secret. The old secret stops working immediately.
+func (u *usecase) RotateSecret(ctx context.Context, sc model.Scope, id string) (serviceaccount.CreateOutput, error) {
+	secret, err := generateSecret()
+	if err != nil {
+		u.l.Errorf(ctx, "serviceaccount.usecase.RotateSecret.generateSecret: %v", err)
+		return serviceaccount.CreateOutput{}, fmt.Errorf("%w: %v", serviceaccount.ErrInternalSystem, err)
+	}
+
+	updated, err := u.repo.Update(ctx, repository.UpdateOptions{
+		ID:           id,
+		SecretPrefix: secretPrefix(secret),
+		SecretHash:   hashSecret(secret),
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return serviceaccount.CreateOutput{}, serviceaccount.ErrAccountNotFound
+		}
+		u.l.Errorf(ctx, "serviceaccount.usecase.RotateSecret.Update: %v", err)
+		return serviceaccount.CreateOutput{}, fmt.Errorf("%w: %v", serviceaccount.ErrInternalSystem, err)
+	}
+
+	u.l.Infof(ctx, "Service account secret rotated: ClientID=%s By=%s", updated.ClientID, sc.UserID)
+	return serviceaccount.CreateOutput{
+		ClientSecret:   secret,
+		ServiceAccount: updated,
+	}, nil
+}
@@ -0,0 +1,197 @@
+package usecase
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"identity-srv/internal/model"
+	"identity-srv/internal/serviceaccount"
+	"identity-srv/internal/serviceaccount/repository"
+
+	"github.com/golang-jwt/jwt"
+)
+
+// serviceTokenType is the "type" claim of service tokens, so they are never
+// mistaken for user access tokens
+const serviceTokenType = "service"
+
+// serviceTokenClaims are the claims of a service JWT. The subject is the client id.
+type serviceTokenClaims struct {
+	jwt.StandardClaims
+	Scope string `json:"scope,omitempty"` // space-separated, as in RFC 6749
+	Type  string `json:"type"`
+}
+
+// IssueToken authenticates a client and serves the requested grant
+func (u *usecase) IssueToken(ctx context.Context, ip serviceaccount.IssueTokenInput) (serviceaccount.IssueTokenOutput, error) {
+	switch ip.GrantType {
+	case serviceaccount.GrantTypeClientCredentials:
+		return u.issueClientCredentials(ctx, ip)
+	case serviceaccount.GrantTypeTokenExchange:
+		if u.authUC == nil {
+			return serviceaccount.IssueTokenOutput{}, serviceaccount.ErrUnsupportedGrantType
+		}
+		return u.exchangeToken(ctx, ip)
+	default:
+		return serviceaccount.IssueTokenOutput{}, serviceaccount.ErrUnsupportedGrantType
+	}
+}
+
+// issueClientCredentials issues a short-lived service token
+func (u *usecase) issueClientCredentials(ctx context.Context, ip serviceaccount.IssueTokenInput) (serviceaccount.IssueTokenOutput, error) {
+	account, err := u.authenticateClient(ctx, ip.ClientID, ip.ClientSecret)
+	if err != nil {
+		return serviceaccount.IssueTokenOutput{}, err
+	}
+
+	scopes, err := u.grantedScopes(account, ip.Scopes)
+	if err != nil {
+		return serviceaccount.IssueTokenOutput{}, err
+	}
+	audience, err := u.grantedAudience(account, ip.Audience)
+	if err != nil {
+		return serviceaccount.IssueTokenOutput{}, err
+	}
+
+	token, err := u.signToken(account.ClientID, audience, scopes)
+	if err != nil {
+		u.l.Errorf(ctx, "serviceaccount.usecase.issueClientCredentials.signToken: %v", err)
+		return serviceaccount.IssueTokenOutput{}, fmt.Errorf("%w: %v", serviceaccount.ErrInternalSystem, err)
+	}
+
+	// Usage tracking must not fail the request
+	if err := u.repo.TouchLastUsed(ctx, repository.TouchLastUsedOptions{
+		ID:          account.ID,
+		MinInterval: lastUsedInterval,
+	}); err != nil {
+		u.l.Warnf(ctx, "serviceaccount.usecase.issueClientCredentials.TouchLastUsed: %v", err)
+	}
+
+	u.l.Infof(ctx, "Service token issued: ClientID=%s Audience=%s Scopes=%v", account.ClientID, audience, scopes)
+	return serviceaccount.IssueTokenOutput{
+		AccessToken: token,
+		TokenType:   serviceaccount.TokenTypeBearer,
+		ExpiresIn:   u.tokenTTL,
+		Scopes:      scopes,
+		Audience:    audience,
+	}, nil
+}
+
+// ValidateToken verifies a service token and checks the required audience and scope
+func (u *usecase) ValidateToken(ctx context.Context, ip serviceaccount.ValidateTokenInput) (serviceaccount.ServiceClaims, error) {
+	claims := &serviceTokenClaims{}
+	_, err := jwt.ParseWithClaims(ip.Token, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return u.signingKey, nil
+	})
+	if err != nil {
+		return serviceaccount.ServiceClaims{}, serviceaccount.ErrInvalidToken
+	}
+
+	if claims.Type != serviceTokenType || claims.Issuer != u.issuer || claims.Subject == "" {
+		return serviceaccount.ServiceClaims{}, serviceaccount.ErrInvalidToken
+	}
+	if ip.Audience != "" && !claims.VerifyAudience(ip.Audience, true) {
+		return serviceaccount.ServiceClaims{}, serviceaccount.ErrInvalidToken
+	}
+
+	scopes := strings.Fields(claims.Scope)
+	if ip.Scope != "" && !slices.Contains(scopes, ip.Scope) {
+		u.l.Warnf(ctx, "serviceaccount.usecase.ValidateToken: client %s lacks scope %s", claims.Subject, ip.Scope)
+		return serviceaccount.ServiceClaims{}, serviceaccount.ErrInsufficientScope
+	}
+
+	return serviceaccount.ServiceClaims{
+		ClientID:  claims.Subject,
+		Audience:  claims.Audience,
+		Scopes:    scopes,
+		JTI:       claims.Id,
+		ExpiresAt: time.Unix(claims.ExpiresAt, 0),
+	}, nil
+}
+
+// authenticateClient checks the client secret in constant time.
+// Unknown, disabled and wrong-secret clients all return ErrInvalidClient.
+func (u *usecase) authenticateClient(ctx context.Context, clientID, clientSecret string) (model.ServiceAccount, error) {
+	if clientID == "" || clientSecret == "" {
+		return model.ServiceAccount{}, serviceaccount.ErrInvalidClient
+	}
+
+	account, err := u.repo.DetailByClientID(ctx, clientID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return model.ServiceAccount{}, serviceaccount.ErrInvalidClient
+		}
+		u.l.Errorf(ctx, "serviceaccount.usecase.authenticateClient.DetailByClientID: %v", err)
+		return model.ServiceAccount{}, fmt.Errorf("%w: %v", serviceaccount.ErrInternalSystem, err)
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hashSecret(clientSecret)), []byte(account.SecretHash)) != 1 {
+		u.l.Warnf(ctx, "serviceaccount.usecase.authenticateClient: wrong secret for client %s", clientID)
+		return model.ServiceAccount{}, serviceaccount.ErrInvalidClient
+	}
+	if !account.IsActive {
+		u.l.Warnf(ctx, "serviceaccount.usecase.authenticateClient: client %s is disabled", clientID)
+		return model.ServiceAccount{}, serviceaccount.ErrInvalidClient
+	}
+	return account, nil
+}
+
+// grantedScopes returns the requested scopes, or every scope of the account when none are requested
+func (u *usecase) grantedScopes(account model.ServiceAccount, requested []string) ([]string, error) {
+	if len(requested) == 0 {
+		return account.Scopes, nil
+	}
+	for _, scope := range requested {
+		if !account.HasScope(scope) {
+			return nil, fmt.Errorf("%w: %q", serviceaccount.ErrInvalidScope, scope)
+		}
+	}
+	return requested, nil
+}
+
+// grantedAudience returns the requested audience. It may be omitted when the
+// account has exactly one audience.
+func (u *usecase) grantedAudience(account model.ServiceAccount, requested string) (string, error) {
+	if requested == "" {
+		if len(account.Audiences) != 1 {
+			return "", serviceaccount.ErrInvalidAudience
+		}
+		return account.Audiences[0], nil
+	}
+	if !account.HasAudience(requested) {
+		return "", fmt.Errorf("%w: %q", serviceaccount.ErrInvalidAudience, requested)
+	}
+	return requested, nil
+}
+
+// signToken creates an HS256 service JWT
+func (u *usecase) signToken(clientID, audience string, scopes []string) (string, error) {
+	jti, err := generateJTI()
+	if err != nil {
+		return "", err
+	}
+
+	now := u.clock()
+	claims := serviceTokenClaims{
+		StandardClaims: jwt.StandardClaims{
+			Id:        jti,
+			Subject:   clientID,
+			Audience:  audience,
+			Issuer:    u.issuer,
+			IssuedAt:  now.Unix(),
+			NotBefore: now.Unix(),
+			ExpiresAt: now.Add(u.tokenTTL).Unix(),
+		},
+		Scope: strings.Join(scopes, " "),
+		Type:  serviceTokenType,
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(u.signingKey)
+}
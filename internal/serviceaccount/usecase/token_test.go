@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"identity-srv/internal/model"
+	"identity-srv/internal/serviceaccount"
+	"identity-srv/internal/serviceaccount/repository"
+
+	"github.com/smap-hcmut/shared-libs/go/log"
+)
+
+type testLogger struct{ log.Logger }
+
+func (testLogger) Infof(context.Context, string, ...any)  {}
+func (testLogger) Warnf(context.Context, string, ...any)  {}
+func (testLogger) Errorf(context.Context, string, ...any) {}
+
+const testSecret = "smap_sk_correct-secret"
+
+// fakeRepo serves service accounts by client ID
+type fakeRepo struct {
+	repository.Repository
+	accounts map[string]model.ServiceAccount
+}
+
+func (r *fakeRepo) DetailByClientID(ctx context.Context, clientID string) (model.ServiceAccount, error) {
+	account, ok := r.accounts[clientID]
+	if !ok {
+		return model.ServiceAccount{}, repository.ErrNotFound
+	}
+	return account, nil
+}
+
+func (r *fakeRepo) TouchLastUsed(ctx context.Context, opts repository.TouchLastUsedOptions) error {
+	return nil
+}
+
+func newTestUsecase() *usecase {
+	return &usecase{
+		l: testLogger{},
+		repo: &fakeRepo{accounts: map[string]model.ServiceAccount{
+			"report-srv": {
+				ID: "sa1", ClientID: "report-srv", SecretHash: hashSecret(testSecret), IsActive: true,
+				Scopes: []string{"users:read", "tokens:validate"}, Audiences: []string{"identity-srv"},
+			},
+			"gateway-srv": {
+				ID: "sa3", ClientID: "gateway-srv", SecretHash: hashSecret(testSecret), IsActive: true,
+				Scopes: []string{serviceaccount.ScopeTokenExchange}, Audiences: []string{"report-srv"},
+			},
+			"old-srv": {
+				ID: "sa2", ClientID: "old-srv", SecretHash: hashSecret(testSecret), IsActive: false,
+				Scopes: []string{"users:read"}, Audiences: []string{"identity-srv"},
+			},
+		}},
+		clock:       time.Now, // issued tokens are verified against the wall clock
+		signingKey:  []byte("0123456789abcdef0123456789abcdef"),
+		issuer:      "identity-srv",
+		tokenTTL:    15 * time.Minute,
+		exchangeTTL: 5 * time.Minute,
+	}
+}
+
+func TestIssueClientCredentials(t *testing.T) {
+	tests := []struct {
+		name       string
+		clientID   string
+		secret     string
+		scopes     []string
+		want       error
+		wantScopes []string
+	}{
+		{name: "every scope of the account", clientID: "report-srv", secret: testSecret, wantScopes: []string{"users:read", "tokens:validate"}},
+		{name: "narrowed scopes", clientID: "report-srv", secret: testSecret, scopes: []string{"users:read"}, wantScopes: []string{"users:read"}},
+		{name: "scope not granted to the account", clientID: "report-srv", secret: testSecret, scopes: []string{"users:deactivate"}, want: serviceaccount.ErrInvalidScope},
+		{name: "wrong secret", clientID: "report-srv", secret: "smap_sk_wrong", want: serviceaccount.ErrInvalidClient},
+		{name: "no secret", clientID: "report-srv", want: serviceaccount.ErrInvalidClient},
+		{name: "disabled account", clientID: "old-srv", secret: testSecret, want: serviceaccount.ErrInvalidClient},
+		{name: "unknown client", clientID: "nobody-srv", secret: testSecret, want: serviceaccount.ErrInvalidClient},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := newTestUsecase()
+			out, err := uc.IssueToken(context.Background(), serviceaccount.IssueTokenInput{
+				GrantType:    serviceaccount.GrantTypeClientCredentials,
+				ClientID:     tt.clientID,
+				ClientSecret: tt.secret,
+				Scopes:       tt.scopes,
+			})
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("IssueToken() error = %v, want %v", err, tt.want)
+			}
+			if tt.want != nil {
+				return
+			}
+			if !slices.Equal(out.Scopes, tt.wantScopes) || out.Audience != "identity-srv" || out.ExpiresIn != uc.tokenTTL {
+				t.Fatalf("IssueToken() = %+v, want scopes %v for identity-srv", out, tt.wantScopes)
+			}
+
+			// The token carries the narrowed scopes, not those of the account
+			_, err = uc.ValidateToken(context.Background(), serviceaccount.ValidateTokenInput{
+				Token: out.AccessToken, Audience: "identity-srv", Scope: "tokens:validate",
+			})
+			want := serviceaccount.ErrInsufficientScope
+			if slices.Contains(tt.wantScopes, "tokens:validate") {
+				want = nil
+			}
+			if !errors.Is(err, want) {
+				t.Fatalf("ValidateToken(tokens:validate) error = %v, want %v", err, want)
+			}
+		})
+	}
+}
@@ -4,9 +4,39 @@
 package sqlboiler
 
 var TableNames = struct {
-	JWTKeys string
-	Users   string
+	AccessRequests       string
+	AuditLogs            string
+	InternalKeys         string
+	Invitations          string
+	JWTKeys              string
+	MagicLinks           string
+	MfaRecoveryCodes     string
+	OutboxEvents         string
+	PersonalAccessTokens string
+	ServiceAccounts      string
+	Sessions             string
+	TokenBlacklist       string
+	UserTotp             string
+	Users                string
+	WebauthnCredentials  string
+	WebhookDeliveries    string
+	Webhooks             string
 }{
-	JWTKeys: "jwt_keys",
-	Users:   "users",
+	AccessRequests:       "access_requests",
+	AuditLogs:            "audit_logs",
+	InternalKeys:         "internal_keys",
+	Invitations:          "invitations",
+	JWTKeys:              "jwt_keys",
+	MagicLinks:           "magic_links",
+	MfaRecoveryCodes:     "mfa_recovery_codes",
+	OutboxEvents:         "outbox_events",
+	PersonalAccessTokens: "personal_access_tokens",
+	ServiceAccounts:      "service_accounts",
+	Sessions:             "sessions",
+	TokenBlacklist:       "token_blacklist",
+	UserTotp:             "user_totp",
+	Users:                "users",
+	WebauthnCredentials:  "webauthn_credentials",
+	WebhookDeliveries:    "webhook_deliveries",
+	Webhooks:             "webhooks",
 }
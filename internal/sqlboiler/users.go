@@ -37,53 +37,59 @@ type User struct {
 	LastLoginAt null.Time `boil:"last_login_at" json:"last_login_at,omitempty" toml:"last_login_at" yaml:"last_login_at,omitempty"`
 	CreatedAt   time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	UpdatedAt   time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	// Tokens of this user issued before this time are rejected
+	TokensRevokedBefore null.Time `boil:"tokens_revoked_before" json:"tokens_revoked_before,omitempty" toml:"tokens_revoked_before" yaml:"tokens_revoked_before,omitempty"`
 
 	R *userR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L userL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var UserColumns = struct {
-	ID          string
-	Email       string
-	Name        string
-	AvatarURL   string
-	RoleHash    string
-	IsActive    string
-	LastLoginAt string
-	CreatedAt   string
-	UpdatedAt   string
+	ID                  string
+	Email               string
+	Name                string
+	AvatarURL           string
+	RoleHash            string
+	IsActive            string
+	LastLoginAt         string
+	CreatedAt           string
+	UpdatedAt           string
+	TokensRevokedBefore string
 }{
-	ID:          "id",
-	Email:       "email",
-	Name:        "name",
-	AvatarURL:   "avatar_url",
-	RoleHash:    "role_hash",
-	IsActive:    "is_active",
-	LastLoginAt: "last_login_at",
-	CreatedAt:   "created_at",
-	UpdatedAt:   "updated_at",
+	ID:                  "id",
+	Email:               "email",
+	Name:                "name",
+	AvatarURL:           "avatar_url",
+	RoleHash:            "role_hash",
+	IsActive:            "is_active",
+	LastLoginAt:         "last_login_at",
+	CreatedAt:           "created_at",
+	UpdatedAt:           "updated_at",
+	TokensRevokedBefore: "tokens_revoked_before",
 }
 
 var UserTableColumns = struct {
-	ID          string
-	Email       string
-	Name        string
-	AvatarURL   string
-	RoleHash    string
-	IsActive    string
-	LastLoginAt string
-	CreatedAt   string
-	UpdatedAt   string
+	ID                  string
+	Email               string
+	Name                string
+	AvatarURL           string
+	RoleHash            string
+	IsActive            string
+	LastLoginAt         string
+	CreatedAt           string
+	UpdatedAt           string
+	TokensRevokedBefore string
 }{
-	ID:          "users.id",
-	Email:       "users.email",
-	Name:        "users.name",
-	AvatarURL:   "users.avatar_url",
-	RoleHash:    "users.role_hash",
-	IsActive:    "users.is_active",
-	LastLoginAt: "users.last_login_at",
-	CreatedAt:   "users.created_at",
-	UpdatedAt:   "users.updated_at",
+	ID:                  "users.id",
+	Email:               "users.email",
+	Name:                "users.name",
+	AvatarURL:           "users.avatar_url",
+	RoleHash:            "users.role_hash",
+	IsActive:            "users.is_active",
+	LastLoginAt:         "users.last_login_at",
+	CreatedAt:           "users.created_at",
+	UpdatedAt:           "users.updated_at",
+	TokensRevokedBefore: "users.tokens_revoked_before",
 }
 
 // Generated where
@@ -113,33 +119,69 @@ func (w whereHelpernull_Bool) IsNull() qm.QueryMod    { return qmhelper.WhereIsN
 func (w whereHelpernull_Bool) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
 var UserWhere = struct {
-	ID          whereHelperstring
-	Email       whereHelperstring
-	Name        whereHelpernull_String
-	AvatarURL   whereHelpernull_String
-	RoleHash    whereHelperstring
-	IsActive    whereHelpernull_Bool
-	LastLoginAt whereHelpernull_Time
-	CreatedAt   whereHelpertime_Time
-	UpdatedAt   whereHelpertime_Time
+	ID                  whereHelperstring
+	Email               whereHelperstring
+	Name                whereHelpernull_String
+	AvatarURL           whereHelpernull_String
+	RoleHash            whereHelperstring
+	IsActive            whereHelpernull_Bool
+	LastLoginAt         whereHelpernull_Time
+	CreatedAt           whereHelpertime_Time
+	UpdatedAt           whereHelpertime_Time
+	TokensRevokedBefore whereHelpernull_Time
 }{
-	ID:          whereHelperstring{field: "\"identity\".\"users\".\"id\""},
-	Email:       whereHelperstring{field: "\"identity\".\"users\".\"email\""},
-	Name:        whereHelpernull_String{field: "\"identity\".\"users\".\"name\""},
-	AvatarURL:   whereHelpernull_String{field: "\"identity\".\"users\".\"avatar_url\""},
-	RoleHash:    whereHelperstring{field: "\"identity\".\"users\".\"role_hash\""},
-	IsActive:    whereHelpernull_Bool{field: "\"identity\".\"users\".\"is_active\""},
-	LastLoginAt: whereHelpernull_Time{field: "\"identity\".\"users\".\"last_login_at\""},
-	CreatedAt:   whereHelpertime_Time{field: "\"identity\".\"users\".\"created_at\""},
-	UpdatedAt:   whereHelpertime_Time{field: "\"identity\".\"users\".\"updated_at\""},
+	ID:                  whereHelperstring{field: "\"identity\".\"users\".\"id\""},
+	Email:               whereHelperstring{field: "\"identity\".\"users\".\"email\""},
+	Name:                whereHelpernull_String{field: "\"identity\".\"users\".\"name\""},
+	AvatarURL:           whereHelpernull_String{field: "\"identity\".\"users\".\"avatar_url\""},
+	RoleHash:            whereHelperstring{field: "\"identity\".\"users\".\"role_hash\""},
+	IsActive:            whereHelpernull_Bool{field: "\"identity\".\"users\".\"is_active\""},
+	LastLoginAt:         whereHelpernull_Time{field: "\"identity\".\"users\".\"last_login_at\""},
+	CreatedAt:           whereHelpertime_Time{field: "\"identity\".\"users\".\"created_at\""},
+	UpdatedAt:           whereHelpertime_Time{field: "\"identity\".\"users\".\"updated_at\""},
+	TokensRevokedBefore: whereHelpernull_Time{field: "\"identity\".\"users\".\"tokens_revoked_before\""},
 }
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
-}{}
+	UserTotp                 string
+	DecidedByAccessRequests  string
+	InvitedByInvitations     string
+	AcceptedByInvitations    string
+	MfaRecoveryCodes         string
+	PersonalAccessTokens     string
+	CreatedByServiceAccounts string
+	Sessions                 string
+	ImpersonatorSessions     string
+	WebauthnCredentials      string
+	CreatedByWebhooks        string
+}{
+	UserTotp:                 "UserTotp",
+	DecidedByAccessRequests:  "DecidedByAccessRequests",
+	InvitedByInvitations:     "InvitedByInvitations",
+	AcceptedByInvitations:    "AcceptedByInvitations",
+	MfaRecoveryCodes:         "MfaRecoveryCodes",
+	PersonalAccessTokens:     "PersonalAccessTokens",
+	CreatedByServiceAccounts: "CreatedByServiceAccounts",
+	Sessions:                 "Sessions",
+	ImpersonatorSessions:     "ImpersonatorSessions",
+	WebauthnCredentials:      "WebauthnCredentials",
+	CreatedByWebhooks:        "CreatedByWebhooks",
+}
 
 // userR is where relationships are stored.
 type userR struct {
+	UserTotp                 *UserTotp                `boil:"UserTotp" json:"UserTotp" toml:"UserTotp" yaml:"UserTotp"`
+	DecidedByAccessRequests  AccessRequestSlice       `boil:"DecidedByAccessRequests" json:"DecidedByAccessRequests" toml:"DecidedByAccessRequests" yaml:"DecidedByAccessRequests"`
+	InvitedByInvitations     InvitationSlice          `boil:"InvitedByInvitations" json:"InvitedByInvitations" toml:"InvitedByInvitations" yaml:"InvitedByInvitations"`
+	AcceptedByInvitations    InvitationSlice          `boil:"AcceptedByInvitations" json:"AcceptedByInvitations" toml:"AcceptedByInvitations" yaml:"AcceptedByInvitations"`
+	MfaRecoveryCodes         MfaRecoveryCodeSlice     `boil:"MfaRecoveryCodes" json:"MfaRecoveryCodes" toml:"MfaRecoveryCodes" yaml:"MfaRecoveryCodes"`
+	PersonalAccessTokens     PersonalAccessTokenSlice `boil:"PersonalAccessTokens" json:"PersonalAccessTokens" toml:"PersonalAccessTokens" yaml:"PersonalAccessTokens"`
+	CreatedByServiceAccounts ServiceAccountSlice      `boil:"CreatedByServiceAccounts" json:"CreatedByServiceAccounts" toml:"CreatedByServiceAccounts" yaml:"CreatedByServiceAccounts"`
+	Sessions                 SessionSlice             `boil:"Sessions" json:"Sessions" toml:"Sessions" yaml:"Sessions"`
+	ImpersonatorSessions     SessionSlice             `boil:"ImpersonatorSessions" json:"ImpersonatorSessions" toml:"ImpersonatorSessions" yaml:"ImpersonatorSessions"`
+	WebauthnCredentials      WebauthnCredentialSlice  `boil:"WebauthnCredentials" json:"WebauthnCredentials" toml:"WebauthnCredentials" yaml:"WebauthnCredentials"`
+	CreatedByWebhooks        WebhookSlice             `boil:"CreatedByWebhooks" json:"CreatedByWebhooks" toml:"CreatedByWebhooks" yaml:"CreatedByWebhooks"`
 }
 
 // NewStruct creates a new relationship struct
@@ -147,13 +189,189 @@ func (*userR) NewStruct() *userR {
 	return &userR{}
 }
 
+func (o *User) GetUserTotp() *UserTotp {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetUserTotp()
+}
+
+func (r *userR) GetUserTotp() *UserTotp {
+	if r == nil {
+		return nil
+	}
+
+	return r.UserTotp
+}
+
+func (o *User) GetDecidedByAccessRequests() AccessRequestSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetDecidedByAccessRequests()
+}
+
+func (r *userR) GetDecidedByAccessRequests() AccessRequestSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.DecidedByAccessRequests
+}
+
+func (o *User) GetInvitedByInvitations() InvitationSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetInvitedByInvitations()
+}
+
+func (r *userR) GetInvitedByInvitations() InvitationSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.InvitedByInvitations
+}
+
+func (o *User) GetAcceptedByInvitations() InvitationSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetAcceptedByInvitations()
+}
+
+func (r *userR) GetAcceptedByInvitations() InvitationSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.AcceptedByInvitations
+}
+
+func (o *User) GetMfaRecoveryCodes() MfaRecoveryCodeSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetMfaRecoveryCodes()
+}
+
+func (r *userR) GetMfaRecoveryCodes() MfaRecoveryCodeSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.MfaRecoveryCodes
+}
+
+func (o *User) GetPersonalAccessTokens() PersonalAccessTokenSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetPersonalAccessTokens()
+}
+
+func (r *userR) GetPersonalAccessTokens() PersonalAccessTokenSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.PersonalAccessTokens
+}
+
+func (o *User) GetCreatedByServiceAccounts() ServiceAccountSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCreatedByServiceAccounts()
+}
+
+func (r *userR) GetCreatedByServiceAccounts() ServiceAccountSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.CreatedByServiceAccounts
+}
+
+func (o *User) GetSessions() SessionSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetSessions()
+}
+
+func (r *userR) GetSessions() SessionSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.Sessions
+}
+
+func (o *User) GetImpersonatorSessions() SessionSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetImpersonatorSessions()
+}
+
+func (r *userR) GetImpersonatorSessions() SessionSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.ImpersonatorSessions
+}
+
+func (o *User) GetWebauthnCredentials() WebauthnCredentialSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetWebauthnCredentials()
+}
+
+func (r *userR) GetWebauthnCredentials() WebauthnCredentialSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.WebauthnCredentials
+}
+
+func (o *User) GetCreatedByWebhooks() WebhookSlice {
+	if o == nil {
+		return nil
+	}
+
+	return o.R.GetCreatedByWebhooks()
+}
+
+func (r *userR) GetCreatedByWebhooks() WebhookSlice {
+	if r == nil {
+		return nil
+	}
+
+	return r.CreatedByWebhooks
+}
+
 // userL is where Load methods for each relationship are stored.
 type userL struct{}
 
 var (
-	userAllColumns            = []string{"id", "email", "name", "avatar_url", "role_hash", "is_active", "last_login_at", "created_at", "updated_at"}
+	userAllColumns            = []string{"id", "email", "name", "avatar_url", "role_hash", "is_active", "last_login_at", "created_at", "updated_at", "tokens_revoked_before"}
 	userColumnsWithoutDefault = []string{"email", "role_hash"}
-	userColumnsWithDefault    = []string{"id", "name", "avatar_url", "is_active", "last_login_at", "created_at", "updated_at"}
+	userColumnsWithDefault    = []string{"id", "name", "avatar_url", "is_active", "last_login_at", "created_at", "updated_at", "tokens_revoked_before"}
 	userPrimaryKeyColumns     = []string{"id"}
 	userGeneratedColumns      = []string{}
 )
@@ -463,6 +681,2428 @@ func (q userQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool,
 	return count > 0, nil
 }
 
+// UserTotp pointed to by the foreign key.
+func (o *User) UserTotp(mods ...qm.QueryMod) userTotpQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"user_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return UserTotps(queryMods...)
+}
+
+// DecidedByAccessRequests retrieves all the access_request's AccessRequests with an executor via decided_by column.
+func (o *User) DecidedByAccessRequests(mods ...qm.QueryMod) accessRequestQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"identity\".\"access_requests\".\"decided_by\"=?", o.ID),
+	)
+
+	return AccessRequests(queryMods...)
+}
+
+// InvitedByInvitations retrieves all the invitation's Invitations with an executor via invited_by column.
+func (o *User) InvitedByInvitations(mods ...qm.QueryMod) invitationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"identity\".\"invitations\".\"invited_by\"=?", o.ID),
+	)
+
+	return Invitations(queryMods...)
+}
+
+// AcceptedByInvitations retrieves all the invitation's Invitations with an executor via accepted_by column.
+func (o *User) AcceptedByInvitations(mods ...qm.QueryMod) invitationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"identity\".\"invitations\".\"accepted_by\"=?", o.ID),
+	)
+
+	return Invitations(queryMods...)
+}
+
+// MfaRecoveryCodes retrieves all the mfa_recovery_code's MfaRecoveryCodes with an executor.
+func (o *User) MfaRecoveryCodes(mods ...qm.QueryMod) mfaRecoveryCodeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"identity\".\"mfa_recovery_codes\".\"user_id\"=?", o.ID),
+	)
+
+	return MfaRecoveryCodes(queryMods...)
+}
+
+// PersonalAccessTokens retrieves all the personal_access_token's PersonalAccessTokens with an executor.
+func (o *User) PersonalAccessTokens(mods ...qm.QueryMod) personalAccessTokenQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"identity\".\"personal_access_tokens\".\"user_id\"=?", o.ID),
+	)
+
+	return PersonalAccessTokens(queryMods...)
+}
+
+// CreatedByServiceAccounts retrieves all the service_account's ServiceAccounts with an executor via created_by column.
+func (o *User) CreatedByServiceAccounts(mods ...qm.QueryMod) serviceAccountQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"identity\".\"service_accounts\".\"created_by\"=?", o.ID),
+	)
+
+	return ServiceAccounts(queryMods...)
+}
+
+// Sessions retrieves all the session's Sessions with an executor.
+func (o *User) Sessions(mods ...qm.QueryMod) sessionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"identity\".\"sessions\".\"user_id\"=?", o.ID),
+	)
+
+	return Sessions(queryMods...)
+}
+
+// ImpersonatorSessions retrieves all the session's Sessions with an executor via impersonator_id column.
+func (o *User) ImpersonatorSessions(mods ...qm.QueryMod) sessionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"identity\".\"sessions\".\"impersonator_id\"=?", o.ID),
+	)
+
+	return Sessions(queryMods...)
+}
+
+// WebauthnCredentials retrieves all the webauthn_credential's WebauthnCredentials with an executor.
+func (o *User) WebauthnCredentials(mods ...qm.QueryMod) webauthnCredentialQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"identity\".\"webauthn_credentials\".\"user_id\"=?", o.ID),
+	)
+
+	return WebauthnCredentials(queryMods...)
+}
+
+// CreatedByWebhooks retrieves all the webhook's Webhooks with an executor via created_by column.
+func (o *User) CreatedByWebhooks(mods ...qm.QueryMod) webhookQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"identity\".\"webhooks\".\"created_by\"=?", o.ID),
+	)
+
+	return Webhooks(queryMods...)
+}
+
+// LoadUserTotp allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (userL) LoadUserTotp(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser any, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`identity.user_totp`),
+		qm.WhereIn(`identity.user_totp.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load UserTotp")
+	}
+
+	var resultSlice []*UserTotp
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice UserTotp")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for user_totp")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_totp")
+	}
+
+	if len(userTotpAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.UserTotp = foreign
+		if foreign.R == nil {
+			foreign.R = &userTotpR{}
+		}
+		foreign.R.User = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.UserID {
+				local.R.UserTotp = foreign
+				if foreign.R == nil {
+					foreign.R = &userTotpR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadDecidedByAccessRequests allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadDecidedByAccessRequests(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser any, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`identity.access_requests`),
+		qm.WhereIn(`identity.access_requests.decided_by in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load access_requests")
+	}
+
+	var resultSlice []*AccessRequest
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice access_requests")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on access_requests")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for access_requests")
+	}
+
+	if len(accessRequestAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.DecidedByAccessRequests = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &accessRequestR{}
+			}
+			foreign.R.DecidedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.DecidedBy) {
+				local.R.DecidedByAccessRequests = append(local.R.DecidedByAccessRequests, foreign)
+				if foreign.R == nil {
+					foreign.R = &accessRequestR{}
+				}
+				foreign.R.DecidedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadInvitedByInvitations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadInvitedByInvitations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser any, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`identity.invitations`),
+		qm.WhereIn(`identity.invitations.invited_by in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load invitations")
+	}
+
+	var resultSlice []*Invitation
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice invitations")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on invitations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for invitations")
+	}
+
+	if len(invitationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.InvitedByInvitations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &invitationR{}
+			}
+			foreign.R.InvitedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.InvitedBy) {
+				local.R.InvitedByInvitations = append(local.R.InvitedByInvitations, foreign)
+				if foreign.R == nil {
+					foreign.R = &invitationR{}
+				}
+				foreign.R.InvitedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAcceptedByInvitations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadAcceptedByInvitations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser any, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`identity.invitations`),
+		qm.WhereIn(`identity.invitations.accepted_by in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load invitations")
+	}
+
+	var resultSlice []*Invitation
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice invitations")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on invitations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for invitations")
+	}
+
+	if len(invitationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.AcceptedByInvitations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &invitationR{}
+			}
+			foreign.R.AcceptedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.AcceptedBy) {
+				local.R.AcceptedByInvitations = append(local.R.AcceptedByInvitations, foreign)
+				if foreign.R == nil {
+					foreign.R = &invitationR{}
+				}
+				foreign.R.AcceptedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadMfaRecoveryCodes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadMfaRecoveryCodes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser any, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`identity.mfa_recovery_codes`),
+		qm.WhereIn(`identity.mfa_recovery_codes.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load mfa_recovery_codes")
+	}
+
+	var resultSlice []*MfaRecoveryCode
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice mfa_recovery_codes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on mfa_recovery_codes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for mfa_recovery_codes")
+	}
+
+	if len(mfaRecoveryCodeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.MfaRecoveryCodes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &mfaRecoveryCodeR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.MfaRecoveryCodes = append(local.R.MfaRecoveryCodes, foreign)
+				if foreign.R == nil {
+					foreign.R = &mfaRecoveryCodeR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPersonalAccessTokens allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadPersonalAccessTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser any, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`identity.personal_access_tokens`),
+		qm.WhereIn(`identity.personal_access_tokens.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load personal_access_tokens")
+	}
+
+	var resultSlice []*PersonalAccessToken
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice personal_access_tokens")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on personal_access_tokens")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for personal_access_tokens")
+	}
+
+	if len(personalAccessTokenAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PersonalAccessTokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &personalAccessTokenR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.PersonalAccessTokens = append(local.R.PersonalAccessTokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &personalAccessTokenR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatedByServiceAccounts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreatedByServiceAccounts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser any, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`identity.service_accounts`),
+		qm.WhereIn(`identity.service_accounts.created_by in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load service_accounts")
+	}
+
+	var resultSlice []*ServiceAccount
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice service_accounts")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on service_accounts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for service_accounts")
+	}
+
+	if len(serviceAccountAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CreatedByServiceAccounts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &serviceAccountR{}
+			}
+			foreign.R.CreatedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CreatedBy) {
+				local.R.CreatedByServiceAccounts = append(local.R.CreatedByServiceAccounts, foreign)
+				if foreign.R == nil {
+					foreign.R = &serviceAccountR{}
+				}
+				foreign.R.CreatedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSessions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadSessions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser any, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`identity.sessions`),
+		qm.WhereIn(`identity.sessions.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load sessions")
+	}
+
+	var resultSlice []*Session
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice sessions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on sessions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for sessions")
+	}
+
+	if len(sessionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Sessions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &sessionR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.Sessions = append(local.R.Sessions, foreign)
+				if foreign.R == nil {
+					foreign.R = &sessionR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadImpersonatorSessions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadImpersonatorSessions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser any, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`identity.sessions`),
+		qm.WhereIn(`identity.sessions.impersonator_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load sessions")
+	}
+
+	var resultSlice []*Session
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice sessions")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on sessions")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for sessions")
+	}
+
+	if len(sessionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ImpersonatorSessions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &sessionR{}
+			}
+			foreign.R.Impersonator = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ImpersonatorID) {
+				local.R.ImpersonatorSessions = append(local.R.ImpersonatorSessions, foreign)
+				if foreign.R == nil {
+					foreign.R = &sessionR{}
+				}
+				foreign.R.Impersonator = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWebauthnCredentials allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadWebauthnCredentials(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser any, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`identity.webauthn_credentials`),
+		qm.WhereIn(`identity.webauthn_credentials.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load webauthn_credentials")
+	}
+
+	var resultSlice []*WebauthnCredential
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice webauthn_credentials")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on webauthn_credentials")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for webauthn_credentials")
+	}
+
+	if len(webauthnCredentialAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.WebauthnCredentials = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &webauthnCredentialR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.WebauthnCredentials = append(local.R.WebauthnCredentials, foreign)
+				if foreign.R == nil {
+					foreign.R = &webauthnCredentialR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatedByWebhooks allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreatedByWebhooks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser any, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[any]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]any, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`identity.webhooks`),
+		qm.WhereIn(`identity.webhooks.created_by in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load webhooks")
+	}
+
+	var resultSlice []*Webhook
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice webhooks")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on webhooks")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for webhooks")
+	}
+
+	if len(webhookAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CreatedByWebhooks = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &webhookR{}
+			}
+			foreign.R.CreatedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.CreatedBy) {
+				local.R.CreatedByWebhooks = append(local.R.CreatedByWebhooks, foreign)
+				if foreign.R == nil {
+					foreign.R = &webhookR{}
+				}
+				foreign.R.CreatedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUserTotp of the user to the related item.
+// Sets o.R.UserTotp to related.
+// Adds o to related.R.User.
+func (o *User) SetUserTotp(ctx context.Context, exec boil.ContextExecutor, insert bool, related *UserTotp) error {
+	var err error
+
+	if insert {
+		related.UserID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"identity\".\"user_totp\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+			strmangle.WhereClause("\"", "\"", 2, userTotpPrimaryKeyColumns),
+		)
+		values := []any{o.ID, related.UserID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.UserID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			UserTotp: related,
+		}
+	} else {
+		o.R.UserTotp = related
+	}
+
+	if related.R == nil {
+		related.R = &userTotpR{
+			User: o,
+		}
+	} else {
+		related.R.User = o
+	}
+	return nil
+}
+
+// AddDecidedByAccessRequests adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.DecidedByAccessRequests.
+// Sets related.R.DecidedByUser appropriately.
+func (o *User) AddDecidedByAccessRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AccessRequest) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.DecidedBy, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"identity\".\"access_requests\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"decided_by"}),
+				strmangle.WhereClause("\"", "\"", 2, accessRequestPrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.DecidedBy, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			DecidedByAccessRequests: related,
+		}
+	} else {
+		o.R.DecidedByAccessRequests = append(o.R.DecidedByAccessRequests, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &accessRequestR{
+				DecidedByUser: o,
+			}
+		} else {
+			rel.R.DecidedByUser = o
+		}
+	}
+	return nil
+}
+
+// SetDecidedByAccessRequests removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.DecidedByUser's DecidedByAccessRequests accordingly.
+// Replaces o.R.DecidedByAccessRequests with related.
+// Sets related.R.DecidedByUser's DecidedByAccessRequests accordingly.
+func (o *User) SetDecidedByAccessRequests(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*AccessRequest) error {
+	query := "update \"identity\".\"access_requests\" set \"decided_by\" = null where \"decided_by\" = $1"
+	values := []any{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.DecidedByAccessRequests {
+			queries.SetScanner(&rel.DecidedBy, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.DecidedByUser = nil
+		}
+		o.R.DecidedByAccessRequests = nil
+	}
+
+	return o.AddDecidedByAccessRequests(ctx, exec, insert, related...)
+}
+
+// RemoveDecidedByAccessRequests relationships from objects passed in.
+// Removes related items from R.DecidedByAccessRequests (uses pointer comparison, removal does not keep order)
+// Sets related.R.DecidedByUser.
+func (o *User) RemoveDecidedByAccessRequests(ctx context.Context, exec boil.ContextExecutor, related ...*AccessRequest) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.DecidedBy, nil)
+		if rel.R != nil {
+			rel.R.DecidedByUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("decided_by")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.DecidedByAccessRequests {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.DecidedByAccessRequests)
+			if ln > 1 && i < ln-1 {
+				o.R.DecidedByAccessRequests[i] = o.R.DecidedByAccessRequests[ln-1]
+			}
+			o.R.DecidedByAccessRequests = o.R.DecidedByAccessRequests[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddInvitedByInvitations adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.InvitedByInvitations.
+// Sets related.R.InvitedByUser appropriately.
+func (o *User) AddInvitedByInvitations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Invitation) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.InvitedBy, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"identity\".\"invitations\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"invited_by"}),
+				strmangle.WhereClause("\"", "\"", 2, invitationPrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.InvitedBy, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			InvitedByInvitations: related,
+		}
+	} else {
+		o.R.InvitedByInvitations = append(o.R.InvitedByInvitations, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &invitationR{
+				InvitedByUser: o,
+			}
+		} else {
+			rel.R.InvitedByUser = o
+		}
+	}
+	return nil
+}
+
+// SetInvitedByInvitations removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.InvitedByUser's InvitedByInvitations accordingly.
+// Replaces o.R.InvitedByInvitations with related.
+// Sets related.R.InvitedByUser's InvitedByInvitations accordingly.
+func (o *User) SetInvitedByInvitations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Invitation) error {
+	query := "update \"identity\".\"invitations\" set \"invited_by\" = null where \"invited_by\" = $1"
+	values := []any{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.InvitedByInvitations {
+			queries.SetScanner(&rel.InvitedBy, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.InvitedByUser = nil
+		}
+		o.R.InvitedByInvitations = nil
+	}
+
+	return o.AddInvitedByInvitations(ctx, exec, insert, related...)
+}
+
+// RemoveInvitedByInvitations relationships from objects passed in.
+// Removes related items from R.InvitedByInvitations (uses pointer comparison, removal does not keep order)
+// Sets related.R.InvitedByUser.
+func (o *User) RemoveInvitedByInvitations(ctx context.Context, exec boil.ContextExecutor, related ...*Invitation) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.InvitedBy, nil)
+		if rel.R != nil {
+			rel.R.InvitedByUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("invited_by")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.InvitedByInvitations {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.InvitedByInvitations)
+			if ln > 1 && i < ln-1 {
+				o.R.InvitedByInvitations[i] = o.R.InvitedByInvitations[ln-1]
+			}
+			o.R.InvitedByInvitations = o.R.InvitedByInvitations[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddAcceptedByInvitations adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AcceptedByInvitations.
+// Sets related.R.AcceptedByUser appropriately.
+func (o *User) AddAcceptedByInvitations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Invitation) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.AcceptedBy, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"identity\".\"invitations\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"accepted_by"}),
+				strmangle.WhereClause("\"", "\"", 2, invitationPrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.AcceptedBy, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			AcceptedByInvitations: related,
+		}
+	} else {
+		o.R.AcceptedByInvitations = append(o.R.AcceptedByInvitations, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &invitationR{
+				AcceptedByUser: o,
+			}
+		} else {
+			rel.R.AcceptedByUser = o
+		}
+	}
+	return nil
+}
+
+// SetAcceptedByInvitations removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.AcceptedByUser's AcceptedByInvitations accordingly.
+// Replaces o.R.AcceptedByInvitations with related.
+// Sets related.R.AcceptedByUser's AcceptedByInvitations accordingly.
+func (o *User) SetAcceptedByInvitations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Invitation) error {
+	query := "update \"identity\".\"invitations\" set \"accepted_by\" = null where \"accepted_by\" = $1"
+	values := []any{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.AcceptedByInvitations {
+			queries.SetScanner(&rel.AcceptedBy, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.AcceptedByUser = nil
+		}
+		o.R.AcceptedByInvitations = nil
+	}
+
+	return o.AddAcceptedByInvitations(ctx, exec, insert, related...)
+}
+
+// RemoveAcceptedByInvitations relationships from objects passed in.
+// Removes related items from R.AcceptedByInvitations (uses pointer comparison, removal does not keep order)
+// Sets related.R.AcceptedByUser.
+func (o *User) RemoveAcceptedByInvitations(ctx context.Context, exec boil.ContextExecutor, related ...*Invitation) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.AcceptedBy, nil)
+		if rel.R != nil {
+			rel.R.AcceptedByUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("accepted_by")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.AcceptedByInvitations {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.AcceptedByInvitations)
+			if ln > 1 && i < ln-1 {
+				o.R.AcceptedByInvitations[i] = o.R.AcceptedByInvitations[ln-1]
+			}
+			o.R.AcceptedByInvitations = o.R.AcceptedByInvitations[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddMfaRecoveryCodes adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.MfaRecoveryCodes.
+// Sets related.R.User appropriately.
+func (o *User) AddMfaRecoveryCodes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MfaRecoveryCode) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"identity\".\"mfa_recovery_codes\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, mfaRecoveryCodePrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			MfaRecoveryCodes: related,
+		}
+	} else {
+		o.R.MfaRecoveryCodes = append(o.R.MfaRecoveryCodes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &mfaRecoveryCodeR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddPersonalAccessTokens adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.PersonalAccessTokens.
+// Sets related.R.User appropriately.
+func (o *User) AddPersonalAccessTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PersonalAccessToken) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"identity\".\"personal_access_tokens\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, personalAccessTokenPrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			PersonalAccessTokens: related,
+		}
+	} else {
+		o.R.PersonalAccessTokens = append(o.R.PersonalAccessTokens, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &personalAccessTokenR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddCreatedByServiceAccounts adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CreatedByServiceAccounts.
+// Sets related.R.CreatedByUser appropriately.
+func (o *User) AddCreatedByServiceAccounts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ServiceAccount) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CreatedBy, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"identity\".\"service_accounts\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"created_by"}),
+				strmangle.WhereClause("\"", "\"", 2, serviceAccountPrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.CreatedBy, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			CreatedByServiceAccounts: related,
+		}
+	} else {
+		o.R.CreatedByServiceAccounts = append(o.R.CreatedByServiceAccounts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &serviceAccountR{
+				CreatedByUser: o,
+			}
+		} else {
+			rel.R.CreatedByUser = o
+		}
+	}
+	return nil
+}
+
+// SetCreatedByServiceAccounts removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.CreatedByUser's CreatedByServiceAccounts accordingly.
+// Replaces o.R.CreatedByServiceAccounts with related.
+// Sets related.R.CreatedByUser's CreatedByServiceAccounts accordingly.
+func (o *User) SetCreatedByServiceAccounts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ServiceAccount) error {
+	query := "update \"identity\".\"service_accounts\" set \"created_by\" = null where \"created_by\" = $1"
+	values := []any{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.CreatedByServiceAccounts {
+			queries.SetScanner(&rel.CreatedBy, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.CreatedByUser = nil
+		}
+		o.R.CreatedByServiceAccounts = nil
+	}
+
+	return o.AddCreatedByServiceAccounts(ctx, exec, insert, related...)
+}
+
+// RemoveCreatedByServiceAccounts relationships from objects passed in.
+// Removes related items from R.CreatedByServiceAccounts (uses pointer comparison, removal does not keep order)
+// Sets related.R.CreatedByUser.
+func (o *User) RemoveCreatedByServiceAccounts(ctx context.Context, exec boil.ContextExecutor, related ...*ServiceAccount) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CreatedBy, nil)
+		if rel.R != nil {
+			rel.R.CreatedByUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("created_by")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.CreatedByServiceAccounts {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.CreatedByServiceAccounts)
+			if ln > 1 && i < ln-1 {
+				o.R.CreatedByServiceAccounts[i] = o.R.CreatedByServiceAccounts[ln-1]
+			}
+			o.R.CreatedByServiceAccounts = o.R.CreatedByServiceAccounts[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddSessions adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.Sessions.
+// Sets related.R.User appropriately.
+func (o *User) AddSessions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Session) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"identity\".\"sessions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, sessionPrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.Jti}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			Sessions: related,
+		}
+	} else {
+		o.R.Sessions = append(o.R.Sessions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &sessionR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddImpersonatorSessions adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ImpersonatorSessions.
+// Sets related.R.Impersonator appropriately.
+func (o *User) AddImpersonatorSessions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Session) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ImpersonatorID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"identity\".\"sessions\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"impersonator_id"}),
+				strmangle.WhereClause("\"", "\"", 2, sessionPrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.Jti}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ImpersonatorID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ImpersonatorSessions: related,
+		}
+	} else {
+		o.R.ImpersonatorSessions = append(o.R.ImpersonatorSessions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &sessionR{
+				Impersonator: o,
+			}
+		} else {
+			rel.R.Impersonator = o
+		}
+	}
+	return nil
+}
+
+// SetImpersonatorSessions removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Impersonator's ImpersonatorSessions accordingly.
+// Replaces o.R.ImpersonatorSessions with related.
+// Sets related.R.Impersonator's ImpersonatorSessions accordingly.
+func (o *User) SetImpersonatorSessions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Session) error {
+	query := "update \"identity\".\"sessions\" set \"impersonator_id\" = null where \"impersonator_id\" = $1"
+	values := []any{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ImpersonatorSessions {
+			queries.SetScanner(&rel.ImpersonatorID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Impersonator = nil
+		}
+		o.R.ImpersonatorSessions = nil
+	}
+
+	return o.AddImpersonatorSessions(ctx, exec, insert, related...)
+}
+
+// RemoveImpersonatorSessions relationships from objects passed in.
+// Removes related items from R.ImpersonatorSessions (uses pointer comparison, removal does not keep order)
+// Sets related.R.Impersonator.
+func (o *User) RemoveImpersonatorSessions(ctx context.Context, exec boil.ContextExecutor, related ...*Session) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ImpersonatorID, nil)
+		if rel.R != nil {
+			rel.R.Impersonator = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("impersonator_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ImpersonatorSessions {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ImpersonatorSessions)
+			if ln > 1 && i < ln-1 {
+				o.R.ImpersonatorSessions[i] = o.R.ImpersonatorSessions[ln-1]
+			}
+			o.R.ImpersonatorSessions = o.R.ImpersonatorSessions[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddWebauthnCredentials adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.WebauthnCredentials.
+// Sets related.R.User appropriately.
+func (o *User) AddWebauthnCredentials(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*WebauthnCredential) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"identity\".\"webauthn_credentials\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, webauthnCredentialPrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			WebauthnCredentials: related,
+		}
+	} else {
+		o.R.WebauthnCredentials = append(o.R.WebauthnCredentials, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &webauthnCredentialR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddCreatedByWebhooks adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CreatedByWebhooks.
+// Sets related.R.CreatedByUser appropriately.
+func (o *User) AddCreatedByWebhooks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Webhook) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.CreatedBy, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"identity\".\"webhooks\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"created_by"}),
+				strmangle.WhereClause("\"", "\"", 2, webhookPrimaryKeyColumns),
+			)
+			values := []any{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.CreatedBy, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			CreatedByWebhooks: related,
+		}
+	} else {
+		o.R.CreatedByWebhooks = append(o.R.CreatedByWebhooks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &webhookR{
+				CreatedByUser: o,
+			}
+		} else {
+			rel.R.CreatedByUser = o
+		}
+	}
+	return nil
+}
+
+// SetCreatedByWebhooks removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.CreatedByUser's CreatedByWebhooks accordingly.
+// Replaces o.R.CreatedByWebhooks with related.
+// Sets related.R.CreatedByUser's CreatedByWebhooks accordingly.
+func (o *User) SetCreatedByWebhooks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Webhook) error {
+	query := "update \"identity\".\"webhooks\" set \"created_by\" = null where \"created_by\" = $1"
+	values := []any{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.CreatedByWebhooks {
+			queries.SetScanner(&rel.CreatedBy, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.CreatedByUser = nil
+		}
+		o.R.CreatedByWebhooks = nil
+	}
+
+	return o.AddCreatedByWebhooks(ctx, exec, insert, related...)
+}
+
+// RemoveCreatedByWebhooks relationships from objects passed in.
+// Removes related items from R.CreatedByWebhooks (uses pointer comparison, removal does not keep order)
+// Sets related.R.CreatedByUser.
+func (o *User) RemoveCreatedByWebhooks(ctx context.Context, exec boil.ContextExecutor, related ...*Webhook) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.CreatedBy, nil)
+		if rel.R != nil {
+			rel.R.CreatedByUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("created_by")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.CreatedByWebhooks {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.CreatedByWebhooks)
+			if ln > 1 && i < ln-1 {
+				o.R.CreatedByWebhooks[i] = o.R.CreatedByWebhooks[ln-1]
+			}
+			o.R.CreatedByWebhooks = o.R.CreatedByWebhooks[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // Users retrieves all the records using an executor.
 func Users(mods ...qm.QueryMod) userQuery {
 	mods = append(mods, qm.From("\"identity\".\"users\""))
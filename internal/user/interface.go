@@ -12,4 +12,6 @@ type UseCase interface {
 	Create(ctx context.Context, ip CreateInput) (model.User, error)
 	Update(ctx context.Context, ip UpdateInput) error
 	Detail(ctx context.Context, id string) (model.User, error)
+	RevokeTokens(ctx context.Context, ip RevokeTokensInput) error
+	Deactivate(ctx context.Context, id string) (model.User, error)
 }
@@ -12,4 +12,6 @@ type Repository interface {
 	Upsert(ctx context.Context, opts UpsertOptions) (model.User, error)
 	Update(ctx context.Context, opts UpdateOptions) error
 	Detail(ctx context.Context, opts DetailOptions) (model.User, error)
+	SetTokensRevokedBefore(ctx context.Context, opts SetTokensRevokedBeforeOptions) error
+	Deactivate(ctx context.Context, opts DeactivateOptions) (model.User, error)
 }
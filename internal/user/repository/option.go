@@ -1,5 +1,7 @@
 package repository
 
+import "time"
+
 // OAuth user operations Options structs
 type UpsertOptions struct {
 	Email     string
@@ -15,3 +17,12 @@ type UpdateOptions struct {
 type DetailOptions struct {
 	UserID string
 }
+
+type SetTokensRevokedBeforeOptions struct {
+	UserID string
+	Before time.Time
+}
+
+type DeactivateOptions struct {
+	UserID string
+}
@@ -0,0 +1,26 @@
+package postgres
+
+import (
+	"encoding/json"
+
+	"identity-srv/internal/sqlboiler"
+
+	"github.com/smap-hcmut/shared-libs/go/postgres"
+)
+
+func (r *implRepository) buildOutboxEvent(eventType, userID string, payload any) (*sqlboiler.OutboxEvent, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	now := r.clock()
+	return &sqlboiler.OutboxEvent{
+		ID:            postgres.NewUUID(),
+		EventType:     eventType,
+		AggregateID:   userID,
+		Payload:       data,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}, nil
+}
@@ -13,14 +13,17 @@ type implRepository struct {
 	l     log.Logger
 	db    *sql.DB
 	clock func() time.Time
+	// outbox writes a domain event with every user change, in the same transaction
+	outbox bool
 }
 
 var _ repository.Repository = &implRepository{}
 
-func New(l log.Logger, db *sql.DB) *implRepository {
+func New(l log.Logger, db *sql.DB, outbox bool) *implRepository {
 	return &implRepository{
-		l:     l,
-		db:    db,
-		clock: time.Now,
+		l:      l,
+		db:     db,
+		clock:  time.Now,
+		outbox: outbox,
 	}
 }
@@ -0,0 +1,21 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+)
+
+// writeEvent adds a domain event to the outbox within the caller's transaction.
+// It does nothing when the outbox is disabled.
+func (r *implRepository) writeEvent(ctx context.Context, exec boil.ContextExecutor, eventType, userID string, payload any) error {
+	if !r.outbox {
+		return nil
+	}
+
+	event, err := r.buildOutboxEvent(eventType, userID, payload)
+	if err != nil {
+		return err
+	}
+	return event.Insert(ctx, exec, boil.Infer())
+}
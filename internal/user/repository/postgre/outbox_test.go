@@ -0,0 +1,90 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"identity-srv/internal/user/repository"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/smap-hcmut/shared-libs/go/log"
+)
+
+type testLogger struct{ log.Logger }
+
+func (testLogger) Infof(context.Context, string, ...any)  {}
+func (testLogger) Errorf(context.Context, string, ...any) {}
+
+func newTestRepository(t *testing.T, outbox bool) (*implRepository, sqlmock.Sqlmock) {
+	t.Helper()
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+	// The transaction holds the only connection: a statement outside it
+	// blocks until the context deadline
+	db.SetMaxOpenConns(1)
+	return &implRepository{l: testLogger{}, db: db, clock: time.Now, outbox: outbox}, mock
+}
+
+// The session.revoked event of a revoke-all is written in the transaction of
+// the watermark: both are committed, or neither is.
+func TestSetTokensRevokedBeforeWritesEventInTransaction(t *testing.T) {
+	opts := repository.SetTokensRevokedBeforeOptions{UserID: "u1", Before: time.Now()}
+	insertEvent := `INSERT INTO "identity"."outbox_events"`
+	eventColumns := []string{"attempts", "last_error", "published_at"}
+	newContext := func(t *testing.T) context.Context {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		t.Cleanup(cancel)
+		return ctx
+	}
+
+	t.Run("committed together", func(t *testing.T) {
+		r, mock := newTestRepository(t, true)
+		mock.ExpectBegin()
+		mock.ExpectExec(`UPDATE "identity"."users"`).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(insertEvent).
+			WithArgs(sqlmock.AnyArg(), "session.revoked", "u1", sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+			WillReturnRows(sqlmock.NewRows(eventColumns).AddRow(0, "", nil))
+		mock.ExpectCommit()
+
+		if err := r.SetTokensRevokedBefore(newContext(t), opts); err != nil {
+			t.Fatalf("SetTokensRevokedBefore() error = %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("event failure rolls back the watermark", func(t *testing.T) {
+		r, mock := newTestRepository(t, true)
+		mock.ExpectBegin()
+		mock.ExpectExec(`UPDATE "identity"."users"`).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(insertEvent).WillReturnError(errors.New("outbox_events: disk full"))
+		mock.ExpectRollback()
+
+		if err := r.SetTokensRevokedBefore(newContext(t), opts); err == nil {
+			t.Fatal("SetTokensRevokedBefore() error = nil, want the insert error")
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("outbox disabled", func(t *testing.T) {
+		r, mock := newTestRepository(t, false)
+		mock.ExpectBegin()
+		mock.ExpectExec(`UPDATE "identity"."users"`).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		if err := r.SetTokensRevokedBefore(newContext(t), opts); err != nil {
+			t.Fatalf("SetTokensRevokedBefore() error = %v", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Error(err)
+		}
+	})
+}
@@ -11,29 +11,46 @@ import (
 
 	"github.com/aarondl/null/v8"
 	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/smap-hcmut/shared-libs/go/postgres"
 )
 
 // Upsert creates or updates a user by email (for OAuth)
 func (r *implRepository) Upsert(ctx context.Context, opts repository.UpsertOptions) (model.User, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.l.Errorf(ctx, "Failed to begin transaction: %v", err)
+		return model.User{}, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	// Try to find existing user by email
 	existingUser, err := sqlboiler.Users(
 		sqlboiler.UserWhere.Email.EQ(opts.Email),
-	).One(ctx, r.db)
+	).One(ctx, tx)
 
 	if err == nil {
-		// User exists - update
-		existingUser.Name = null.StringFrom(opts.Name)
-		existingUser.AvatarURL = null.StringFrom(opts.AvatarURL)
+		// User exists - update; a login without a profile (e.g. a magic link) keeps the stored one
+		if opts.Name != "" {
+			existingUser.Name = null.StringFrom(opts.Name)
+		}
+		if opts.AvatarURL != "" {
+			existingUser.AvatarURL = null.StringFrom(opts.AvatarURL)
+		}
 		existingUser.LastLoginAt = null.TimeFrom(time.Now())
 		existingUser.UpdatedAt = time.Now()
 
-		_, updateErr := existingUser.Update(ctx, r.db, boil.Infer())
+		_, updateErr := existingUser.Update(ctx, tx, boil.Infer())
 		if updateErr != nil {
 			r.l.Errorf(ctx, "Failed to update user: %v", updateErr)
 			return model.User{}, updateErr
 		}
 
+		if err := tx.Commit(); err != nil {
+			r.l.Errorf(ctx, "Failed to commit user update: %v", err)
+			return model.User{}, err
+		}
+
 		return *model.NewUserFromDB(existingUser), nil
 	}
 
@@ -62,19 +79,41 @@ func (r *implRepository) Upsert(ctx context.Context, opts repository.UpsertOptio
 	}
 	newUser.RoleHash = roleHash
 
-	if err := newUser.Insert(ctx, r.db, boil.Infer()); err != nil {
+	if err := newUser.Insert(ctx, tx, boil.Infer()); err != nil {
 		r.l.Errorf(ctx, "Failed to insert user: %v", err)
 		return model.User{}, err
 	}
 
+	if err := r.writeEvent(ctx, tx, model.EventUserCreated, newUser.ID, model.UserEvent{
+		UserID: newUser.ID,
+		Email:  newUser.Email,
+		Role:   model.RoleViewer,
+	}); err != nil {
+		r.l.Errorf(ctx, "Failed to write user.created event: %v", err)
+		return model.User{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.l.Errorf(ctx, "Failed to commit user insert: %v", err)
+		return model.User{}, err
+	}
+
 	return *model.NewUserFromDB(newUser), nil
 }
 
 // Update updates user (currently only supports role update)
 func (r *implRepository) Update(ctx context.Context, opts repository.UpdateOptions) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.l.Errorf(ctx, "Failed to begin transaction: %v", err)
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	user, err := sqlboiler.Users(
 		sqlboiler.UserWhere.ID.EQ(opts.UserID),
-	).One(ctx, r.db)
+		qm.For("UPDATE"),
+	).One(ctx, tx)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -85,6 +124,8 @@ func (r *implRepository) Update(ctx context.Context, opts repository.UpdateOptio
 		return err
 	}
 
+	oldRole := model.NewUserFromDB(user).GetRole()
+
 	// Encrypt and set role
 	roleHash, err := model.EncryptRole(opts.Role)
 	if err != nil {
@@ -95,7 +136,7 @@ func (r *implRepository) Update(ctx context.Context, opts repository.UpdateOptio
 	user.RoleHash = roleHash
 	user.UpdatedAt = time.Now()
 
-	_, updateErr := user.Update(ctx, r.db, boil.Whitelist(
+	_, updateErr := user.Update(ctx, tx, boil.Whitelist(
 		sqlboiler.UserColumns.RoleHash,
 		sqlboiler.UserColumns.UpdatedAt,
 	))
@@ -104,6 +145,23 @@ func (r *implRepository) Update(ctx context.Context, opts repository.UpdateOptio
 		return updateErr
 	}
 
+	if oldRole != opts.Role {
+		if err := r.writeEvent(ctx, tx, model.EventUserRoleChanged, user.ID, model.UserEvent{
+			UserID:  user.ID,
+			Email:   user.Email,
+			Role:    opts.Role,
+			OldRole: oldRole,
+		}); err != nil {
+			r.l.Errorf(ctx, "Failed to write user.role_changed event: %v", err)
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.l.Errorf(ctx, "Failed to commit user role update: %v", err)
+		return err
+	}
+
 	r.l.Infof(ctx, "Updated user %s role to %s", opts.UserID, opts.Role)
 	return nil
 }
@@ -124,3 +182,99 @@ func (r *implRepository) Detail(ctx context.Context, opts repository.DetailOptio
 
 	return *model.NewUserFromDB(user), nil
 }
+
+// SetTokensRevokedBefore stores the token revocation watermark for a user
+func (r *implRepository) SetTokensRevokedBefore(ctx context.Context, opts repository.SetTokensRevokedBeforeOptions) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.l.Errorf(ctx, "Failed to begin transaction: %v", err)
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	rows, err := sqlboiler.Users(
+		sqlboiler.UserWhere.ID.EQ(opts.UserID),
+	).UpdateAll(ctx, tx, sqlboiler.M{
+		sqlboiler.UserColumns.TokensRevokedBefore: null.TimeFrom(opts.Before),
+		sqlboiler.UserColumns.UpdatedAt:           time.Now(),
+	})
+	if err != nil {
+		r.l.Errorf(ctx, "Failed to update tokens_revoked_before: %v", err)
+		return err
+	}
+	if rows == 0 {
+		r.l.Errorf(ctx, "User not found: %s", opts.UserID)
+		return sql.ErrNoRows
+	}
+
+	before := opts.Before
+	if err := r.writeEvent(ctx, tx, model.EventSessionRevoked, opts.UserID, model.SessionRevokedEvent{
+		UserID:        opts.UserID,
+		RevokedBefore: &before,
+	}); err != nil {
+		r.l.Errorf(ctx, "Failed to write session.revoked event: %v", err)
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.l.Errorf(ctx, "Failed to commit tokens_revoked_before: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// Deactivate marks a user inactive
+func (r *implRepository) Deactivate(ctx context.Context, opts repository.DeactivateOptions) (model.User, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.l.Errorf(ctx, "Failed to begin transaction: %v", err)
+		return model.User{}, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	user, err := sqlboiler.Users(
+		sqlboiler.UserWhere.ID.EQ(opts.UserID),
+		qm.For("UPDATE"),
+	).One(ctx, tx)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			r.l.Errorf(ctx, "Failed to query user: %v", err)
+		}
+		return model.User{}, err
+	}
+
+	// Already inactive: nothing changes and no event is written
+	if user.IsActive.Valid && !user.IsActive.Bool {
+		return *model.NewUserFromDB(user), nil
+	}
+
+	user.IsActive = null.BoolFrom(false)
+	user.UpdatedAt = time.Now()
+
+	if _, err := user.Update(ctx, tx, boil.Whitelist(
+		sqlboiler.UserColumns.IsActive,
+		sqlboiler.UserColumns.UpdatedAt,
+	)); err != nil {
+		r.l.Errorf(ctx, "Failed to deactivate user: %v", err)
+		return model.User{}, err
+	}
+
+	deactivated := *model.NewUserFromDB(user)
+	if err := r.writeEvent(ctx, tx, model.EventUserDeactivated, user.ID, model.UserEvent{
+		UserID: user.ID,
+		Email:  user.Email,
+		Role:   deactivated.GetRole(),
+	}); err != nil {
+		r.l.Errorf(ctx, "Failed to write user.deactivated event: %v", err)
+		return model.User{}, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.l.Errorf(ctx, "Failed to commit user deactivation: %v", err)
+		return model.User{}, err
+	}
+
+	r.l.Infof(ctx, "Deactivated user %s", opts.UserID)
+	return deactivated, nil
+}
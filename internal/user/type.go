@@ -1,5 +1,7 @@
 package user
 
+import "time"
+
 // OAuth user operations Input structs
 type CreateInput struct {
 	Email     string
@@ -11,3 +13,8 @@ type UpdateInput struct {
 	UserID string
 	Role   string
 }
+
+type RevokeTokensInput struct {
+	UserID string
+	Before time.Time
+}
@@ -31,3 +31,18 @@ func (u *usecase) Detail(ctx context.Context, id string) (model.User, error) {
 		UserID: id,
 	})
 }
+
+// RevokeTokens persists the user's token revocation watermark
+func (u *usecase) RevokeTokens(ctx context.Context, ip user.RevokeTokensInput) error {
+	return u.repo.SetTokensRevokedBefore(ctx, repository.SetTokensRevokedBeforeOptions{
+		UserID: ip.UserID,
+		Before: ip.Before,
+	})
+}
+
+// Deactivate marks a user inactive
+func (u *usecase) Deactivate(ctx context.Context, id string) (model.User, error) {
+	return u.repo.Deactivate(ctx, repository.DeactivateOptions{
+		UserID: id,
+	})
+}
@@ -0,0 +1,56 @@
+package http
+
+import (
+	"errors"
+	"identity-srv/internal/webhook"
+
+	pkgErrors "github.com/smap-hcmut/shared-libs/go/errors"
+)
+
+// --- HTTP error constants ---
+
+var (
+	errWrongBody          = pkgErrors.NewHTTPError(28001, "Wrong body")
+	errWebhookNotFound    = pkgErrors.NewHTTPError(28002, "Webhook not found")
+	errDeliveryNotFound   = pkgErrors.NewHTTPError(28003, "Webhook delivery not found")
+	errInvalidURL         = pkgErrors.NewHTTPError(28004, "Invalid webhook URL, https is required")
+	errInvalidDescription = pkgErrors.NewHTTPError(28005, "Description must be at most 200 characters")
+	errInvalidEventType   = pkgErrors.NewHTTPError(28006, "Invalid event type")
+	errInvalidStatus      = pkgErrors.NewHTTPError(28007, "Invalid delivery status")
+	errInvalidPage        = pkgErrors.NewHTTPError(28008, "Invalid page or limit")
+	errDeliveryPending    = pkgErrors.NewHTTPError(28009, "Delivery is still pending")
+	errMissingID          = pkgErrors.NewHTTPError(28010, "Webhook ID is required")
+	errInternalSystem     = pkgErrors.NewHTTPError(28011, "Internal system error")
+	errScopeNotFound      = pkgErrors.NewHTTPError(28012, "Scope not found")
+)
+
+// mapError maps UseCase domain errors to HTTP errors
+func (h handler) mapError(err error) error {
+	switch {
+	case errors.Is(err, webhook.ErrWebhookNotFound):
+		return errWebhookNotFound
+	case errors.Is(err, webhook.ErrDeliveryNotFound):
+		return errDeliveryNotFound
+	case errors.Is(err, webhook.ErrInvalidURL):
+		return errInvalidURL
+	case errors.Is(err, webhook.ErrInvalidDescription):
+		return errInvalidDescription
+	case errors.Is(err, webhook.ErrInvalidEventType):
+		return errInvalidEventType
+	case errors.Is(err, webhook.ErrInvalidStatus):
+		return errInvalidStatus
+	case errors.Is(err, webhook.ErrInvalidPage):
+		return errInvalidPage
+	case errors.Is(err, webhook.ErrDeliveryPending):
+		return errDeliveryPending
+	case errors.Is(err, webhook.ErrInternalSystem):
+		return errInternalSystem
+	default:
+		return err
+	}
+}
+
+var NotFound = []error{
+	errWebhookNotFound,
+	errDeliveryNotFound,
+}
@@ -0,0 +1,259 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/smap-hcmut/shared-libs/go/response"
+)
+
+// Create
+// @Summary Create Webhook
+// @Description Register an HTTPS endpoint for identity events. Leave event_types empty to receive every event. The signing secret is returned only once. Requires ADMIN role.
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param body body createReq true "URL, description and event types"
+// @Success 200 {object} response.Resp{data=createResp} "Created webhook (secret shown once)"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/webhooks [POST]
+// @Security CookieAuth
+func (h handler) Create(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	input, sc, err := h.processCreateRequest(c)
+	if err != nil {
+		response.Error(c, err, h.discord)
+		return
+	}
+
+	// 2. Call UseCase
+	output, err := h.uc.Create(ctx, sc, input)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.Create: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	response.OK(c, h.newCreateResp(output))
+}
+
+// List
+// @Summary List Webhooks
+// @Description List all webhooks, including disabled ones (without secrets). Requires ADMIN role.
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.Resp{data=listResp} "Webhooks"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/webhooks [GET]
+// @Security CookieAuth
+func (h handler) List(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Call UseCase
+	webhooks, err := h.uc.List(ctx)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.List: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 2. Response
+	response.OK(c, h.newListResp(webhooks))
+}
+
+// Update
+// @Summary Update Webhook
+// @Description Change the URL, description, event types or active flag. Omitted fields are kept. Requires ADMIN role.
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param id path string true "Webhook ID"
+// @Param body body updateReq true "Fields to change"
+// @Success 200 {object} response.Resp{data=webhookResp} "Updated webhook"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 404 {object} response.Resp "Not Found"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/webhooks/{id} [PATCH]
+// @Security CookieAuth
+func (h handler) Update(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	input, sc, err := h.processUpdateRequest(c)
+	if err != nil {
+		response.Error(c, err, h.discord)
+		return
+	}
+
+	// 2. Call UseCase
+	updated, err := h.uc.Update(ctx, sc, input)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.Update: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	response.OK(c, h.newWebhookResp(updated))
+}
+
+// Delete
+// @Summary Delete Webhook
+// @Description Delete a webhook and its delivery history. Requires ADMIN role.
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param id path string true "Webhook ID"
+// @Success 200 {object} response.Resp "Webhook deleted"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 404 {object} response.Resp "Not Found"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/webhooks/{id} [DELETE]
+// @Security CookieAuth
+func (h handler) Delete(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	id, sc, err := h.processIDRequest(c)
+	if err != nil {
+		response.Error(c, err, h.discord)
+		return
+	}
+
+	// 2. Call UseCase
+	err = h.uc.Delete(ctx, sc, id)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.Delete: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	response.OK(c, nil)
+}
+
+// RotateSecret
+// @Summary Rotate Webhook Secret
+// @Description Replace the signing secret. Deliveries from now on are signed with the new secret, which is returned only once. Requires ADMIN role.
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param id path string true "Webhook ID"
+// @Success 200 {object} response.Resp{data=createResp} "Webhook with its new secret"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 404 {object} response.Resp "Not Found"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/webhooks/{id}/rotate-secret [POST]
+// @Security CookieAuth
+func (h handler) RotateSecret(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	id, sc, err := h.processIDRequest(c)
+	if err != nil {
+		response.Error(c, err, h.discord)
+		return
+	}
+
+	// 2. Call UseCase
+	output, err := h.uc.RotateSecret(ctx, sc, id)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.RotateSecret: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	response.OK(c, h.newCreateResp(output))
+}
+
+// ListDeliveries
+// @Summary List Webhook Deliveries
+// @Description Delivery history of a webhook, newest first. Filter by status (pending, delivered, dead). Requires ADMIN role.
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param id path string true "Webhook ID"
+// @Param status query string false "pending, delivered or dead"
+// @Param page query int false "Page number (default 1)"
+// @Param limit query int false "Page size (default 50, max 200)"
+// @Success 200 {object} response.Resp{data=listDeliveriesResp} "Deliveries"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 404 {object} response.Resp "Not Found"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/webhooks/{id}/deliveries [GET]
+// @Security CookieAuth
+func (h handler) ListDeliveries(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	input, err := h.processListDeliveriesRequest(c)
+	if err != nil {
+		response.Error(c, err, h.discord)
+		return
+	}
+
+	// 2. Call UseCase
+	output, err := h.uc.ListDeliveries(ctx, input)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.ListDeliveries: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	response.OK(c, h.newListDeliveriesResp(output))
+}
+
+// Redeliver
+// @Summary Redeliver Webhook Delivery
+// @Description Queue a delivered or dead delivery again with a fresh retry budget. Requires ADMIN role.
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param id path string true "Webhook ID"
+// @Param deliveryID path string true "Delivery ID"
+// @Success 200 {object} response.Resp{data=deliveryResp} "Requeued delivery"
+// @Failure 400 {object} response.Resp "Bad Request"
+// @Failure 401 {object} response.Resp "Unauthorized"
+// @Failure 403 {object} response.Resp "Forbidden"
+// @Failure 404 {object} response.Resp "Not Found"
+// @Failure 500 {object} response.Resp "Internal Server Error"
+// @Router /authentication/webhooks/{id}/deliveries/{deliveryID}/redeliver [POST]
+// @Security CookieAuth
+func (h handler) Redeliver(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	// 1. Process Request
+	input, sc, err := h.processRedeliverRequest(c)
+	if err != nil {
+		response.Error(c, err, h.discord)
+		return
+	}
+
+	// 2. Call UseCase
+	delivery, err := h.uc.Redeliver(ctx, sc, input)
+	if err != nil {
+		h.l.Errorf(ctx, "uc.Redeliver: %v", err)
+		response.Error(c, h.mapError(err), h.discord)
+		return
+	}
+
+	// 3. Response
+	response.OK(c, h.newDeliveryResp(delivery))
+}
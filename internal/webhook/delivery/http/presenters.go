@@ -0,0 +1,157 @@
+package http
+
+import (
+	"encoding/json"
+	"identity-srv/internal/model"
+	"identity-srv/internal/webhook"
+	"time"
+)
+
+// --- Request DTOs ---
+
+type createReq struct {
+	URL         string   `json:"url" binding:"required"`
+	Description string   `json:"description"`
+	EventTypes  []string `json:"event_types"` // empty subscribes to every event
+}
+
+func (r createReq) toInput() webhook.CreateInput {
+	return webhook.CreateInput{
+		URL:         r.URL,
+		Description: r.Description,
+		EventTypes:  r.EventTypes,
+	}
+}
+
+// updateReq changes only the fields present in the body
+type updateReq struct {
+	URL         *string   `json:"url"`
+	Description *string   `json:"description"`
+	EventTypes  *[]string `json:"event_types"`
+	IsActive    *bool     `json:"is_active"`
+}
+
+func (r updateReq) toInput(id string) webhook.UpdateInput {
+	return webhook.UpdateInput{
+		ID:          id,
+		URL:         r.URL,
+		Description: r.Description,
+		EventTypes:  r.EventTypes,
+		IsActive:    r.IsActive,
+	}
+}
+
+// --- Response DTOs ---
+
+type webhookResp struct {
+	ID           string    `json:"id"`
+	URL          string    `json:"url"`
+	Description  string    `json:"description"`
+	EventTypes   []string  `json:"event_types"`
+	SecretPrefix string    `json:"secret_prefix"`
+	IsActive     bool      `json:"is_active"`
+	CreatedBy    *string   `json:"created_by,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+type createResp struct {
+	Secret string `json:"secret"` // plaintext, shown once
+	webhookResp
+}
+
+type listResp struct {
+	Webhooks []webhookResp `json:"webhooks"`
+}
+
+type deliveryResp struct {
+	ID             string          `json:"id"`
+	WebhookID      string          `json:"webhook_id"`
+	EventID        string          `json:"event_id"`
+	EventType      string          `json:"event_type"`
+	Payload        json.RawMessage `json:"payload"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	NextAttemptAt  *time.Time      `json:"next_attempt_at,omitempty"` // pending only
+	LastStatusCode *int            `json:"last_status_code,omitempty"`
+	LastError      string          `json:"last_error,omitempty"`
+	DeliveredAt    *time.Time      `json:"delivered_at,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+type listDeliveriesResp struct {
+	Deliveries []deliveryResp `json:"deliveries"`
+	Total      int64          `json:"total"`
+	Page       int            `json:"page"`
+	Limit      int            `json:"limit"`
+}
+
+// --- Response Mappers ---
+
+func (h handler) newWebhookResp(o model.Webhook) webhookResp {
+	eventTypes := o.EventTypes
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+	return webhookResp{
+		ID:           o.ID,
+		URL:          o.URL,
+		Description:  o.Description,
+		EventTypes:   eventTypes,
+		SecretPrefix: o.SecretPrefix,
+		IsActive:     o.IsActive,
+		CreatedBy:    o.CreatedBy,
+		CreatedAt:    o.CreatedAt,
+		UpdatedAt:    o.UpdatedAt,
+	}
+}
+
+func (h handler) newCreateResp(o webhook.CreateOutput) createResp {
+	return createResp{
+		Secret:      o.Secret,
+		webhookResp: h.newWebhookResp(o.Webhook),
+	}
+}
+
+func (h handler) newListResp(o []model.Webhook) listResp {
+	webhooks := make([]webhookResp, 0, len(o))
+	for _, wh := range o {
+		webhooks = append(webhooks, h.newWebhookResp(wh))
+	}
+	return listResp{Webhooks: webhooks}
+}
+
+func (h handler) newDeliveryResp(o model.WebhookDelivery) deliveryResp {
+	resp := deliveryResp{
+		ID:             o.ID,
+		WebhookID:      o.WebhookID,
+		EventID:        o.EventID,
+		EventType:      o.EventType,
+		Payload:        json.RawMessage(o.Payload),
+		Status:         o.Status,
+		Attempts:       o.Attempts,
+		LastStatusCode: o.LastStatusCode,
+		LastError:      o.LastError,
+		DeliveredAt:    o.DeliveredAt,
+		CreatedAt:      o.CreatedAt,
+		UpdatedAt:      o.UpdatedAt,
+	}
+	if o.Status == model.WebhookDeliveryPending {
+		resp.NextAttemptAt = &o.NextAttemptAt
+	}
+	return resp
+}
+
+func (h handler) newListDeliveriesResp(o webhook.ListDeliveriesOutput) listDeliveriesResp {
+	deliveries := make([]deliveryResp, 0, len(o.Deliveries))
+	for _, delivery := range o.Deliveries {
+		deliveries = append(deliveries, h.newDeliveryResp(delivery))
+	}
+	return listDeliveriesResp{
+		Deliveries: deliveries,
+		Total:      o.Total,
+		Page:       o.Page,
+		Limit:      o.Limit,
+	}
+}
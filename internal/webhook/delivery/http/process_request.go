@@ -0,0 +1,110 @@
+package http
+
+import (
+	"identity-srv/internal/model"
+	"identity-srv/internal/webhook"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/smap-hcmut/shared-libs/go/auth"
+)
+
+// --- Scope extraction ---
+
+func (h handler) getScope(c *gin.Context) (model.Scope, bool) {
+	payload, ok := auth.GetPayloadFromContext(c.Request.Context())
+	if !ok || payload.UserID == "" {
+		return model.Scope{}, false
+	}
+	return model.Scope{
+		UserID:    payload.UserID,
+		Username:  payload.Username,
+		Role:      payload.Role,
+		JTI:       payload.Id,
+		ExpiresAt: payload.ExpiresAt,
+	}, true
+}
+
+// --- Process request functions ---
+
+func (h handler) processCreateRequest(c *gin.Context) (webhook.CreateInput, model.Scope, error) {
+	sc, ok := h.getScope(c)
+	if !ok {
+		return webhook.CreateInput{}, model.Scope{}, errScopeNotFound
+	}
+
+	var req createReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		return webhook.CreateInput{}, model.Scope{}, errWrongBody
+	}
+
+	return req.toInput(), sc, nil
+}
+
+func (h handler) processUpdateRequest(c *gin.Context) (webhook.UpdateInput, model.Scope, error) {
+	id, sc, err := h.processIDRequest(c)
+	if err != nil {
+		return webhook.UpdateInput{}, model.Scope{}, err
+	}
+
+	var req updateReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		return webhook.UpdateInput{}, model.Scope{}, errWrongBody
+	}
+
+	return req.toInput(id), sc, nil
+}
+
+func (h handler) processIDRequest(c *gin.Context) (string, model.Scope, error) {
+	sc, ok := h.getScope(c)
+	if !ok {
+		return "", model.Scope{}, errScopeNotFound
+	}
+
+	id := c.Param("id")
+	if id == "" {
+		return "", model.Scope{}, errMissingID
+	}
+	return id, sc, nil
+}
+
+func (h handler) processListDeliveriesRequest(c *gin.Context) (webhook.ListDeliveriesInput, error) {
+	input := webhook.ListDeliveriesInput{
+		WebhookID: c.Param("id"),
+		Status:    strings.TrimSpace(c.Query("status")),
+	}
+	if input.WebhookID == "" {
+		return webhook.ListDeliveriesInput{}, errMissingID
+	}
+
+	var err error
+	if input.Page, err = parseIntQuery(c, "page"); err != nil {
+		return webhook.ListDeliveriesInput{}, errInvalidPage
+	}
+	if input.Limit, err = parseIntQuery(c, "limit"); err != nil {
+		return webhook.ListDeliveriesInput{}, errInvalidPage
+	}
+	return input, nil
+}
+
+func (h handler) processRedeliverRequest(c *gin.Context) (webhook.RedeliverInput, model.Scope, error) {
+	id, sc, err := h.processIDRequest(c)
+	if err != nil {
+		return webhook.RedeliverInput{}, model.Scope{}, err
+	}
+
+	return webhook.RedeliverInput{
+		WebhookID:  id,
+		DeliveryID: c.Param("deliveryID"),
+	}, sc, nil
+}
+
+// parseIntQuery reads an optional integer, zero when absent
+func parseIntQuery(c *gin.Context, key string) (int, error) {
+	value := c.Query(key)
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(value)
+}
@@ -0,0 +1,22 @@
+package http
+
+import (
+	internalmw "identity-srv/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/smap-hcmut/shared-libs/go/middleware"
+)
+
+func (h handler) RegisterRoutes(r *gin.RouterGroup, mw *middleware.Middleware, imw *internalmw.Middleware) {
+	// Admin management (require ADMIN role)
+	r.Use(mw.Auth(), mw.AdminOnly(), imw.Admin())
+	r.POST("", h.Create)
+	r.GET("", h.List)
+	r.PATCH("/:id", h.Update)
+	r.DELETE("/:id", h.Delete)
+	r.POST("/:id/rotate-secret", h.RotateSecret)
+
+	// Delivery history and dead-letter replay
+	r.GET("/:id/deliveries", h.ListDeliveries)
+	r.POST("/:id/deliveries/:deliveryID/redeliver", h.Redeliver)
+}
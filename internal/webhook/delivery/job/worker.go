@@ -0,0 +1,59 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"identity-srv/internal/webhook"
+
+	"github.com/smap-hcmut/shared-libs/go/log"
+)
+
+// purgeInterval is how often finished deliveries past the retention are deleted
+const purgeInterval = time.Hour
+
+// Worker sends webhook deliveries in the background
+type Worker struct {
+	l            log.Logger
+	uc           webhook.UseCase
+	pollInterval time.Duration
+}
+
+func New(l log.Logger, uc webhook.UseCase, pollInterval time.Duration) *Worker {
+	return &Worker{
+		l:            l,
+		uc:           uc,
+		pollInterval: pollInterval,
+	}
+}
+
+// Run sends due deliveries every poll interval until ctx is done. Full
+// batches are followed immediately by the next one.
+func (w *Worker) Run(ctx context.Context) {
+	poll := time.NewTicker(w.pollInterval)
+	defer poll.Stop()
+	purge := time.NewTicker(purgeInterval)
+	defer purge.Stop()
+
+	w.l.Infof(ctx, "Webhook sender started: poll_interval=%s", w.pollInterval)
+	for {
+		select {
+		case <-ctx.Done():
+			w.l.Infof(ctx, "Webhook sender stopped")
+			return
+		case <-purge.C:
+			_ = w.uc.Purge(ctx)
+		case <-poll.C:
+			w.drain(ctx)
+		}
+	}
+}
+
+func (w *Worker) drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		attempted, err := w.uc.Deliver(ctx)
+		if err != nil || attempted == 0 {
+			return
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package publisher
+
+import (
+	"context"
+	"encoding/json"
+
+	"identity-srv/internal/webhook"
+	"identity-srv/pkg/publisher"
+)
+
+// Publisher hands relayed outbox events to the webhook usecase, so webhooks
+// see exactly the events other services consume. Wrap it with the broker
+// publisher in a publisher.MultiPublisher.
+type Publisher struct {
+	uc webhook.UseCase
+}
+
+func New(uc webhook.UseCase) *Publisher {
+	return &Publisher{uc: uc}
+}
+
+// Publish queues the message for the subscribed webhooks. The request body of
+// each delivery is the same envelope the broker receives.
+func (p *Publisher) Publish(ctx context.Context, msg publisher.Message) error {
+	body, err := json.Marshal(publisher.NewEnvelope(msg))
+	if err != nil {
+		return err
+	}
+	return p.uc.Dispatch(ctx, webhook.DispatchInput{
+		EventID:   msg.ID,
+		EventType: msg.Type,
+		Payload:   body,
+	})
+}
@@ -0,0 +1,15 @@
+package webhook
+
+import "errors"
+
+var (
+	ErrWebhookNotFound    = errors.New("webhook not found")
+	ErrDeliveryNotFound   = errors.New("webhook delivery not found")
+	ErrInvalidURL         = errors.New("invalid webhook url")
+	ErrInvalidDescription = errors.New("invalid webhook description")
+	ErrInvalidEventType   = errors.New("invalid event type")
+	ErrInvalidStatus      = errors.New("invalid delivery status")
+	ErrInvalidPage        = errors.New("invalid page")
+	ErrDeliveryPending    = errors.New("webhook delivery still pending")
+	ErrInternalSystem     = errors.New("internal system error")
+)
@@ -0,0 +1,29 @@
+package webhook
+
+import (
+	"context"
+
+	"identity-srv/internal/model"
+)
+
+//go:generate mockery --name UseCase
+type UseCase interface {
+	// Admin management
+	Create(ctx context.Context, sc model.Scope, ip CreateInput) (CreateOutput, error)
+	List(ctx context.Context) ([]model.Webhook, error)
+	Update(ctx context.Context, sc model.Scope, ip UpdateInput) (model.Webhook, error)
+	Delete(ctx context.Context, sc model.Scope, id string) error
+	RotateSecret(ctx context.Context, sc model.Scope, id string) (CreateOutput, error)
+
+	// Delivery history and dead-letter replay
+	ListDeliveries(ctx context.Context, ip ListDeliveriesInput) (ListDeliveriesOutput, error)
+	Redeliver(ctx context.Context, sc model.Scope, ip RedeliverInput) (model.WebhookDelivery, error)
+
+	// Dispatch queues the event for every active webhook subscribed to it. It
+	// is idempotent, so the outbox relay may call it again for the same event.
+	Dispatch(ctx context.Context, ip DispatchInput) error
+	// Deliver sends a batch of due deliveries and returns how many were attempted
+	Deliver(ctx context.Context) (int, error)
+	// Purge deletes finished deliveries older than the retention period
+	Purge(ctx context.Context) error
+}
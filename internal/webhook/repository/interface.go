@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"identity-srv/internal/model"
+)
+
+//go:generate mockery --name Repository
+type Repository interface {
+	// Webhooks
+	Create(ctx context.Context, opts CreateOptions) (model.Webhook, error)
+	List(ctx context.Context, opts ListOptions) ([]model.Webhook, error)
+	Detail(ctx context.Context, id string) (model.Webhook, error)
+	Update(ctx context.Context, opts UpdateOptions) (model.Webhook, error)
+	Delete(ctx context.Context, id string) error
+
+	// Deliveries
+	// CreateDeliveries skips deliveries that already exist for the webhook and event
+	CreateDeliveries(ctx context.Context, opts []CreateDeliveryOptions) error
+	// ClaimDeliveries leases up to Limit due pending deliveries, oldest first
+	ClaimDeliveries(ctx context.Context, opts ClaimDeliveriesOptions) ([]model.WebhookDelivery, error)
+	UpdateDelivery(ctx context.Context, opts UpdateDeliveryOptions) (model.WebhookDelivery, error)
+	// ListDeliveries returns a page of a webhook's deliveries, newest first, and the number of matches
+	ListDeliveries(ctx context.Context, opts ListDeliveriesOptions) ([]model.WebhookDelivery, int64, error)
+	DetailDelivery(ctx context.Context, opts DetailDeliveryOptions) (model.WebhookDelivery, error)
+	// DeleteDeliveries removes delivered and dead deliveries created before the given time
+	DeleteDeliveries(ctx context.Context, before time.Time) (int64, error)
+}
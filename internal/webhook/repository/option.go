@@ -0,0 +1,63 @@
+package repository
+
+import "time"
+
+type CreateOptions struct {
+	URL             string
+	Description     string
+	EventTypes      []string
+	SecretPrefix    string
+	SecretEncrypted string
+	CreatedBy       string
+}
+
+type ListOptions struct {
+	ActiveOnly bool
+}
+
+// UpdateOptions changes only the fields that are set
+type UpdateOptions struct {
+	ID              string
+	URL             *string
+	Description     *string
+	EventTypes      *[]string
+	IsActive        *bool
+	SecretPrefix    string // set together with SecretEncrypted
+	SecretEncrypted string
+}
+
+type CreateDeliveryOptions struct {
+	WebhookID string
+	EventID   string
+	EventType string
+	Payload   []byte
+}
+
+type ClaimDeliveriesOptions struct {
+	Limit      int
+	LeaseUntil time.Time
+}
+
+// UpdateDeliveryOptions records the outcome of an attempt, or resets a
+// delivery for redelivery
+type UpdateDeliveryOptions struct {
+	ID             string
+	Status         string
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastStatusCode *int
+	LastError      string
+	DeliveredAt    *time.Time
+}
+
+type ListDeliveriesOptions struct {
+	WebhookID string
+	Status    string // empty for all
+	Offset    int
+	Limit     int
+}
+
+type DetailDeliveryOptions struct {
+	WebhookID string
+	ID        string
+}
@@ -0,0 +1,98 @@
+package postgres
+
+import (
+	"identity-srv/internal/model"
+	"identity-srv/internal/sqlboiler"
+	"identity-srv/internal/webhook/repository"
+
+	"github.com/aarondl/null/v8"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/aarondl/sqlboiler/v4/types"
+	"github.com/smap-hcmut/shared-libs/go/postgres"
+)
+
+func (r *implRepository) buildWebhook(opts repository.CreateOptions) *sqlboiler.Webhook {
+	now := r.clock()
+	webhook := &sqlboiler.Webhook{
+		ID:              postgres.NewUUID(),
+		URL:             opts.URL,
+		Description:     opts.Description,
+		EventTypes:      types.StringArray(opts.EventTypes),
+		SecretPrefix:    opts.SecretPrefix,
+		SecretEncrypted: opts.SecretEncrypted,
+		IsActive:        true,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+	if webhook.EventTypes == nil {
+		webhook.EventTypes = types.StringArray{}
+	}
+	if opts.CreatedBy != "" {
+		webhook.CreatedBy = null.StringFrom(opts.CreatedBy)
+	}
+	return webhook
+}
+
+func (r *implRepository) buildUpdateColumns(opts repository.UpdateOptions) sqlboiler.M {
+	cols := sqlboiler.M{
+		sqlboiler.WebhookColumns.UpdatedAt: r.clock(),
+	}
+	if opts.URL != nil {
+		cols[sqlboiler.WebhookColumns.URL] = *opts.URL
+	}
+	if opts.Description != nil {
+		cols[sqlboiler.WebhookColumns.Description] = *opts.Description
+	}
+	if opts.EventTypes != nil {
+		eventTypes := types.StringArray(*opts.EventTypes)
+		if eventTypes == nil {
+			eventTypes = types.StringArray{}
+		}
+		cols[sqlboiler.WebhookColumns.EventTypes] = eventTypes
+	}
+	if opts.IsActive != nil {
+		cols[sqlboiler.WebhookColumns.IsActive] = *opts.IsActive
+	}
+	if opts.SecretEncrypted != "" {
+		cols[sqlboiler.WebhookColumns.SecretEncrypted] = opts.SecretEncrypted
+		cols[sqlboiler.WebhookColumns.SecretPrefix] = opts.SecretPrefix
+	}
+	return cols
+}
+
+func (r *implRepository) buildDelivery(opts repository.CreateDeliveryOptions) *sqlboiler.WebhookDelivery {
+	now := r.clock()
+	return &sqlboiler.WebhookDelivery{
+		ID:            postgres.NewUUID(),
+		WebhookID:     opts.WebhookID,
+		EventID:       opts.EventID,
+		EventType:     opts.EventType,
+		Payload:       opts.Payload,
+		Status:        model.WebhookDeliveryPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+}
+
+func (r *implRepository) buildUpdateDeliveryColumns(opts repository.UpdateDeliveryOptions) sqlboiler.M {
+	return sqlboiler.M{
+		sqlboiler.WebhookDeliveryColumns.Status:         opts.Status,
+		sqlboiler.WebhookDeliveryColumns.Attempts:       opts.Attempts,
+		sqlboiler.WebhookDeliveryColumns.NextAttemptAt:  opts.NextAttemptAt,
+		sqlboiler.WebhookDeliveryColumns.LastStatusCode: null.IntFromPtr(opts.LastStatusCode),
+		sqlboiler.WebhookDeliveryColumns.LastError:      opts.LastError,
+		sqlboiler.WebhookDeliveryColumns.DeliveredAt:    null.TimeFromPtr(opts.DeliveredAt),
+		sqlboiler.WebhookDeliveryColumns.UpdatedAt:      r.clock(),
+	}
+}
+
+func (r *implRepository) buildListDeliveriesQuery(opts repository.ListDeliveriesOptions) []qm.QueryMod {
+	mods := []qm.QueryMod{
+		sqlboiler.WebhookDeliveryWhere.WebhookID.EQ(opts.WebhookID),
+	}
+	if opts.Status != "" {
+		mods = append(mods, sqlboiler.WebhookDeliveryWhere.Status.EQ(opts.Status))
+	}
+	return mods
+}
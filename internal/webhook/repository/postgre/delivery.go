@@ -0,0 +1,173 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"identity-srv/internal/model"
+	"identity-srv/internal/sqlboiler"
+	"identity-srv/internal/webhook/repository"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// CreateDeliveries inserts the deliveries of one event. A delivery that
+// already exists for the webhook and event is left as it is.
+func (r *implRepository) CreateDeliveries(ctx context.Context, opts []repository.CreateDeliveryOptions) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.l.Errorf(ctx, "webhook.repository.postgres.CreateDeliveries.BeginTx: %v", err)
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	conflict := []string{sqlboiler.WebhookDeliveryColumns.WebhookID, sqlboiler.WebhookDeliveryColumns.EventID}
+	for _, opt := range opts {
+		if err := r.buildDelivery(opt).Upsert(ctx, tx, false, conflict, boil.None(), boil.Infer()); err != nil {
+			r.l.Errorf(ctx, "webhook.repository.postgres.CreateDeliveries.Upsert: %v", err)
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.l.Errorf(ctx, "webhook.repository.postgres.CreateDeliveries.Commit: %v", err)
+		return err
+	}
+	return nil
+}
+
+// ClaimDeliveries leases due deliveries by moving their next_attempt_at to
+// LeaseUntil. SKIP LOCKED lets several replicas deliver side by side.
+func (r *implRepository) ClaimDeliveries(ctx context.Context, opts repository.ClaimDeliveriesOptions) ([]model.WebhookDelivery, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.l.Errorf(ctx, "webhook.repository.postgres.ClaimDeliveries.BeginTx: %v", err)
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	deliveries, err := sqlboiler.WebhookDeliveries(
+		sqlboiler.WebhookDeliveryWhere.Status.EQ(model.WebhookDeliveryPending),
+		sqlboiler.WebhookDeliveryWhere.NextAttemptAt.LTE(r.clock()),
+		qm.OrderBy(sqlboiler.WebhookDeliveryColumns.NextAttemptAt),
+		qm.Limit(opts.Limit),
+		qm.For("UPDATE SKIP LOCKED"),
+	).All(ctx, tx)
+	if err != nil {
+		r.l.Errorf(ctx, "webhook.repository.postgres.ClaimDeliveries.All: %v", err)
+		return nil, err
+	}
+	if len(deliveries) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		ids = append(ids, delivery.ID)
+	}
+	if _, err := sqlboiler.WebhookDeliveries(
+		sqlboiler.WebhookDeliveryWhere.ID.IN(ids),
+	).UpdateAll(ctx, tx, sqlboiler.M{
+		sqlboiler.WebhookDeliveryColumns.NextAttemptAt: opts.LeaseUntil,
+	}); err != nil {
+		r.l.Errorf(ctx, "webhook.repository.postgres.ClaimDeliveries.UpdateAll: %v", err)
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.l.Errorf(ctx, "webhook.repository.postgres.ClaimDeliveries.Commit: %v", err)
+		return nil, err
+	}
+
+	result := make([]model.WebhookDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		result = append(result, *model.NewWebhookDeliveryFromDB(delivery))
+	}
+	return result, nil
+}
+
+// UpdateDelivery stores the state of a delivery
+func (r *implRepository) UpdateDelivery(ctx context.Context, opts repository.UpdateDeliveryOptions) (model.WebhookDelivery, error) {
+	delivery, err := sqlboiler.WebhookDeliveries(
+		sqlboiler.WebhookDeliveryWhere.ID.EQ(opts.ID),
+	).One(ctx, r.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.WebhookDelivery{}, repository.ErrNotFound
+		}
+		r.l.Errorf(ctx, "webhook.repository.postgres.UpdateDelivery.One: %v", err)
+		return model.WebhookDelivery{}, err
+	}
+
+	if _, err := sqlboiler.WebhookDeliveries(
+		sqlboiler.WebhookDeliveryWhere.ID.EQ(opts.ID),
+	).UpdateAll(ctx, r.db, r.buildUpdateDeliveryColumns(opts)); err != nil {
+		r.l.Errorf(ctx, "webhook.repository.postgres.UpdateDelivery.UpdateAll: %v", err)
+		return model.WebhookDelivery{}, err
+	}
+	if err := delivery.Reload(ctx, r.db); err != nil {
+		r.l.Errorf(ctx, "webhook.repository.postgres.UpdateDelivery.Reload: %v", err)
+		return model.WebhookDelivery{}, err
+	}
+	return *model.NewWebhookDeliveryFromDB(delivery), nil
+}
+
+// ListDeliveries returns a page of a webhook's deliveries, newest first
+func (r *implRepository) ListDeliveries(ctx context.Context, opts repository.ListDeliveriesOptions) ([]model.WebhookDelivery, int64, error) {
+	mods := r.buildListDeliveriesQuery(opts)
+
+	total, err := sqlboiler.WebhookDeliveries(mods...).Count(ctx, r.db)
+	if err != nil {
+		r.l.Errorf(ctx, "webhook.repository.postgres.ListDeliveries.Count: %v", err)
+		return nil, 0, err
+	}
+
+	mods = append(mods,
+		qm.OrderBy(sqlboiler.WebhookDeliveryColumns.CreatedAt+" DESC"),
+		qm.Offset(opts.Offset),
+		qm.Limit(opts.Limit),
+	)
+	deliveries, err := sqlboiler.WebhookDeliveries(mods...).All(ctx, r.db)
+	if err != nil {
+		r.l.Errorf(ctx, "webhook.repository.postgres.ListDeliveries.All: %v", err)
+		return nil, 0, err
+	}
+
+	result := make([]model.WebhookDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		result = append(result, *model.NewWebhookDeliveryFromDB(delivery))
+	}
+	return result, total, nil
+}
+
+// DetailDelivery finds a delivery of a webhook
+func (r *implRepository) DetailDelivery(ctx context.Context, opts repository.DetailDeliveryOptions) (model.WebhookDelivery, error) {
+	delivery, err := sqlboiler.WebhookDeliveries(
+		sqlboiler.WebhookDeliveryWhere.ID.EQ(opts.ID),
+		sqlboiler.WebhookDeliveryWhere.WebhookID.EQ(opts.WebhookID),
+	).One(ctx, r.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.WebhookDelivery{}, repository.ErrNotFound
+		}
+		r.l.Errorf(ctx, "webhook.repository.postgres.DetailDelivery: %v", err)
+		return model.WebhookDelivery{}, err
+	}
+	return *model.NewWebhookDeliveryFromDB(delivery), nil
+}
+
+// DeleteDeliveries removes delivered and dead deliveries created before the given time
+func (r *implRepository) DeleteDeliveries(ctx context.Context, before time.Time) (int64, error) {
+	rows, err := sqlboiler.WebhookDeliveries(
+		sqlboiler.WebhookDeliveryWhere.Status.NEQ(model.WebhookDeliveryPending),
+		sqlboiler.WebhookDeliveryWhere.CreatedAt.LT(before),
+	).DeleteAll(ctx, r.db)
+	if err != nil {
+		r.l.Errorf(ctx, "webhook.repository.postgres.DeleteDeliveries: %v", err)
+		return 0, err
+	}
+	return rows, nil
+}
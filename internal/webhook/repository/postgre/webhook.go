@@ -0,0 +1,89 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"identity-srv/internal/model"
+	"identity-srv/internal/sqlboiler"
+	"identity-srv/internal/webhook/repository"
+
+	"github.com/aarondl/sqlboiler/v4/boil"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+// Create inserts a new webhook
+func (r *implRepository) Create(ctx context.Context, opts repository.CreateOptions) (model.Webhook, error) {
+	webhook := r.buildWebhook(opts)
+	if err := webhook.Insert(ctx, r.db, boil.Infer()); err != nil {
+		r.l.Errorf(ctx, "webhook.repository.postgres.Create: %v", err)
+		return model.Webhook{}, err
+	}
+	return *model.NewWebhookFromDB(webhook), nil
+}
+
+// List returns webhooks in creation order
+func (r *implRepository) List(ctx context.Context, opts repository.ListOptions) ([]model.Webhook, error) {
+	mods := []qm.QueryMod{qm.OrderBy(sqlboiler.WebhookColumns.CreatedAt)}
+	if opts.ActiveOnly {
+		mods = append(mods, sqlboiler.WebhookWhere.IsActive.EQ(true))
+	}
+
+	webhooks, err := sqlboiler.Webhooks(mods...).All(ctx, r.db)
+	if err != nil {
+		r.l.Errorf(ctx, "webhook.repository.postgres.List: %v", err)
+		return nil, err
+	}
+
+	result := make([]model.Webhook, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		result = append(result, *model.NewWebhookFromDB(webhook))
+	}
+	return result, nil
+}
+
+// Detail finds a webhook by ID
+func (r *implRepository) Detail(ctx context.Context, id string) (model.Webhook, error) {
+	webhook, err := sqlboiler.Webhooks(
+		sqlboiler.WebhookWhere.ID.EQ(id),
+	).One(ctx, r.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.Webhook{}, repository.ErrNotFound
+		}
+		r.l.Errorf(ctx, "webhook.repository.postgres.Detail: %v", err)
+		return model.Webhook{}, err
+	}
+	return *model.NewWebhookFromDB(webhook), nil
+}
+
+// Update changes the set fields of a webhook
+func (r *implRepository) Update(ctx context.Context, opts repository.UpdateOptions) (model.Webhook, error) {
+	rows, err := sqlboiler.Webhooks(
+		sqlboiler.WebhookWhere.ID.EQ(opts.ID),
+	).UpdateAll(ctx, r.db, r.buildUpdateColumns(opts))
+	if err != nil {
+		r.l.Errorf(ctx, "webhook.repository.postgres.Update: %v", err)
+		return model.Webhook{}, err
+	}
+	if rows == 0 {
+		return model.Webhook{}, repository.ErrNotFound
+	}
+	return r.Detail(ctx, opts.ID)
+}
+
+// Delete removes a webhook and, by cascade, its delivery history
+func (r *implRepository) Delete(ctx context.Context, id string) error {
+	rows, err := sqlboiler.Webhooks(
+		sqlboiler.WebhookWhere.ID.EQ(id),
+	).DeleteAll(ctx, r.db)
+	if err != nil {
+		r.l.Errorf(ctx, "webhook.repository.postgres.Delete: %v", err)
+		return err
+	}
+	if rows == 0 {
+		return repository.ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package webhook
+
+import (
+	"identity-srv/internal/model"
+	"time"
+)
+
+// Request headers of a delivery. The signature header is
+// "t=<unix seconds>,v1=<hex HMAC-SHA256 of "<t>.<body>" keyed by the secret>".
+const (
+	HeaderSignature  = "X-Smap-Signature"
+	HeaderEventID    = "X-Smap-Event-Id"
+	HeaderEventType  = "X-Smap-Event-Type"
+	HeaderDeliveryID = "X-Smap-Delivery-Id"
+)
+
+// Pagination of the delivery history
+const (
+	DefaultLimit = 50
+	MaxLimit     = 200
+)
+
+// ClaimLease is how long a claimed delivery is hidden from other workers. It
+// must exceed the request timeout.
+const ClaimLease = 2 * time.Minute
+
+// CreateInput contains the data for registering a webhook
+type CreateInput struct {
+	URL         string
+	Description string
+	EventTypes  []string // empty subscribes to every event
+}
+
+// CreateOutput contains the created or rotated webhook and its plaintext secret
+type CreateOutput struct {
+	Secret  string // plaintext, shown once
+	Webhook model.Webhook
+}
+
+// UpdateInput changes only the fields that are set
+type UpdateInput struct {
+	ID          string
+	URL         *string
+	Description *string
+	EventTypes  *[]string
+	IsActive    *bool
+}
+
+// ListDeliveriesInput filters the delivery history of a webhook
+type ListDeliveriesInput struct {
+	WebhookID string
+	Status    string // empty for all
+	Page      int
+	Limit     int
+}
+
+// ListDeliveriesOutput is a page of deliveries, newest first
+type ListDeliveriesOutput struct {
+	Deliveries []model.WebhookDelivery
+	Total      int64
+	Page       int
+	Limit      int
+}
+
+// RedeliverInput names a finished delivery to send again
+type RedeliverInput struct {
+	WebhookID  string
+	DeliveryID string
+}
+
+// DispatchInput is a published domain event; Payload is the envelope posted
+// as the request body
+type DispatchInput struct {
+	EventID   string
+	EventType string
+	Payload   []byte
+}
+
+// Options tunes delivery
+type Options struct {
+	MaxAttempts  int           // attempts before a delivery is dead-lettered
+	RetryBackoff time.Duration // delay before the first retry, doubled after each failure
+	MaxBackoff   time.Duration
+	Timeout      time.Duration // per request
+	BatchSize    int
+	Retention    time.Duration // how long finished deliveries are kept
+	// AllowPrivateTargets lets webhooks reach loopback, private and link-local
+	// addresses; otherwise they are refused at registration and when dialling
+	AllowPrivateTargets bool
+}
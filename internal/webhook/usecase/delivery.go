@@ -0,0 +1,261 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"identity-srv/internal/model"
+	"identity-srv/internal/webhook"
+	"identity-srv/internal/webhook/repository"
+)
+
+// Dispatch queues the event for every active webhook subscribed to it
+func (u *usecase) Dispatch(ctx context.Context, ip webhook.DispatchInput) error {
+	webhooks, err := u.repo.List(ctx, repository.ListOptions{ActiveOnly: true})
+	if err != nil {
+		u.l.Errorf(ctx, "webhook.usecase.Dispatch.List: %v", err)
+		return fmt.Errorf("%w: %v", webhook.ErrInternalSystem, err)
+	}
+
+	opts := make([]repository.CreateDeliveryOptions, 0, len(webhooks))
+	for _, wh := range webhooks {
+		if !wh.Subscribes(ip.EventType) {
+			continue
+		}
+		opts = append(opts, repository.CreateDeliveryOptions{
+			WebhookID: wh.ID,
+			EventID:   ip.EventID,
+			EventType: ip.EventType,
+			Payload:   ip.Payload,
+		})
+	}
+	if len(opts) == 0 {
+		return nil
+	}
+
+	if err := u.repo.CreateDeliveries(ctx, opts); err != nil {
+		u.l.Errorf(ctx, "webhook.usecase.Dispatch.CreateDeliveries: %v", err)
+		return fmt.Errorf("%w: %v", webhook.ErrInternalSystem, err)
+	}
+	return nil
+}
+
+// Deliver sends a batch of due deliveries. A 2xx response marks a delivery
+// delivered; anything else is retried with backoff until MaxAttempts, after
+// which the delivery is dead-lettered.
+func (u *usecase) Deliver(ctx context.Context) (int, error) {
+	deliveries, err := u.repo.ClaimDeliveries(ctx, repository.ClaimDeliveriesOptions{
+		Limit:      u.batchSize,
+		LeaseUntil: u.clock().Add(webhook.ClaimLease),
+	})
+	if err != nil {
+		u.l.Errorf(ctx, "webhook.usecase.Deliver.ClaimDeliveries: %v", err)
+		return 0, fmt.Errorf("%w: %v", webhook.ErrInternalSystem, err)
+	}
+
+	// Webhooks are loaded once per batch
+	webhooks := make(map[string]*model.Webhook)
+	for _, delivery := range deliveries {
+		wh, ok := webhooks[delivery.WebhookID]
+		if !ok {
+			found, err := u.repo.Detail(ctx, delivery.WebhookID)
+			if err != nil && !errors.Is(err, repository.ErrNotFound) {
+				u.l.Errorf(ctx, "webhook.usecase.Deliver.Detail: %v", err)
+				continue // retried once the lease expires
+			}
+			if err == nil {
+				wh = &found
+			}
+			webhooks[delivery.WebhookID] = wh
+		}
+
+		if wh == nil || !wh.IsActive {
+			u.kill(ctx, delivery, nil, "webhook disabled")
+			continue
+		}
+
+		statusCode, err := u.send(ctx, *wh, delivery)
+		if err != nil {
+			u.fail(ctx, delivery, statusCode, err.Error())
+			continue
+		}
+		u.succeed(ctx, delivery, statusCode)
+	}
+
+	return len(deliveries), nil
+}
+
+// send posts the delivery's payload to the webhook and returns the response status code
+func (u *usecase) send(ctx context.Context, wh model.Webhook, delivery model.WebhookDelivery) (*int, error) {
+	secret, err := u.encrypt.Decrypt(wh.SecretEncrypted)
+	if err != nil {
+		u.l.Errorf(ctx, "webhook.usecase.send.Decrypt: %v", err)
+		return nil, errors.New("cannot decrypt signing secret")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, wh.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "identity-srv-webhooks")
+	req.Header.Set(webhook.HeaderSignature, sign(secret, u.clock(), delivery.Payload))
+	req.Header.Set(webhook.HeaderEventID, delivery.EventID)
+	req.Header.Set(webhook.HeaderEventType, delivery.EventType)
+	req.Header.Set(webhook.HeaderDeliveryID, delivery.ID)
+
+	resp, err := u.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	// Drain a bounded amount so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBody))
+
+	statusCode := resp.StatusCode
+	if statusCode < 200 || statusCode >= 300 {
+		return &statusCode, fmt.Errorf("unexpected status %d", statusCode)
+	}
+	return &statusCode, nil
+}
+
+func (u *usecase) succeed(ctx context.Context, delivery model.WebhookDelivery, statusCode *int) {
+	now := u.clock()
+	if _, err := u.repo.UpdateDelivery(ctx, repository.UpdateDeliveryOptions{
+		ID:             delivery.ID,
+		Status:         model.WebhookDeliveryDelivered,
+		Attempts:       delivery.Attempts + 1,
+		NextAttemptAt:  now,
+		LastStatusCode: statusCode,
+		DeliveredAt:    &now,
+	}); err != nil {
+		u.l.Errorf(ctx, "webhook.usecase.succeed.UpdateDelivery: %v", err)
+	}
+}
+
+func (u *usecase) fail(ctx context.Context, delivery model.WebhookDelivery, statusCode *int, reason string) {
+	attempts := delivery.Attempts + 1
+	if attempts >= u.maxAttempts {
+		delivery.Attempts = attempts
+		u.kill(ctx, delivery, statusCode, reason)
+		return
+	}
+
+	if _, err := u.repo.UpdateDelivery(ctx, repository.UpdateDeliveryOptions{
+		ID:             delivery.ID,
+		Status:         model.WebhookDeliveryPending,
+		Attempts:       attempts,
+		NextAttemptAt:  u.clock().Add(u.backoff(attempts)),
+		LastStatusCode: statusCode,
+		LastError:      truncate(reason, maxErrorLength),
+	}); err != nil {
+		u.l.Errorf(ctx, "webhook.usecase.fail.UpdateDelivery: %v", err)
+	}
+}
+
+// kill dead-letters a delivery; it stays in the history until redelivered or purged
+func (u *usecase) kill(ctx context.Context, delivery model.WebhookDelivery, statusCode *int, reason string) {
+	u.l.Warnf(ctx, "Webhook delivery dead: ID=%s Webhook=%s Event=%s Reason=%s", delivery.ID, delivery.WebhookID, delivery.EventID, reason)
+	if _, err := u.repo.UpdateDelivery(ctx, repository.UpdateDeliveryOptions{
+		ID:             delivery.ID,
+		Status:         model.WebhookDeliveryDead,
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  u.clock(),
+		LastStatusCode: statusCode,
+		LastError:      truncate(reason, maxErrorLength),
+	}); err != nil {
+		u.l.Errorf(ctx, "webhook.usecase.kill.UpdateDelivery: %v", err)
+	}
+}
+
+// ListDeliveries returns a page of a webhook's delivery history, newest first
+func (u *usecase) ListDeliveries(ctx context.Context, ip webhook.ListDeliveriesInput) (webhook.ListDeliveriesOutput, error) {
+	if err := validateStatus(ip.Status); err != nil {
+		return webhook.ListDeliveriesOutput{}, err
+	}
+	if ip.Page < 0 || ip.Limit < 0 {
+		return webhook.ListDeliveriesOutput{}, webhook.ErrInvalidPage
+	}
+	page := max(ip.Page, 1)
+	limit := ip.Limit
+	if limit == 0 {
+		limit = webhook.DefaultLimit
+	}
+	limit = min(limit, webhook.MaxLimit)
+
+	if _, err := u.repo.Detail(ctx, ip.WebhookID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return webhook.ListDeliveriesOutput{}, webhook.ErrWebhookNotFound
+		}
+		u.l.Errorf(ctx, "webhook.usecase.ListDeliveries.Detail: %v", err)
+		return webhook.ListDeliveriesOutput{}, fmt.Errorf("%w: %v", webhook.ErrInternalSystem, err)
+	}
+
+	deliveries, total, err := u.repo.ListDeliveries(ctx, repository.ListDeliveriesOptions{
+		WebhookID: ip.WebhookID,
+		Status:    ip.Status,
+		Offset:    (page - 1) * limit,
+		Limit:     limit,
+	})
+	if err != nil {
+		u.l.Errorf(ctx, "webhook.usecase.ListDeliveries.ListDeliveries: %v", err)
+		return webhook.ListDeliveriesOutput{}, fmt.Errorf("%w: %v", webhook.ErrInternalSystem, err)
+	}
+
+	return webhook.ListDeliveriesOutput{
+		Deliveries: deliveries,
+		Total:      total,
+		Page:       page,
+		Limit:      limit,
+	}, nil
+}
+
+// Redeliver queues a delivered or dead delivery again with a fresh attempt budget
+func (u *usecase) Redeliver(ctx context.Context, sc model.Scope, ip webhook.RedeliverInput) (model.WebhookDelivery, error) {
+	delivery, err := u.repo.DetailDelivery(ctx, repository.DetailDeliveryOptions{
+		WebhookID: ip.WebhookID,
+		ID:        ip.DeliveryID,
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return model.WebhookDelivery{}, webhook.ErrDeliveryNotFound
+		}
+		u.l.Errorf(ctx, "webhook.usecase.Redeliver.DetailDelivery: %v", err)
+		return model.WebhookDelivery{}, fmt.Errorf("%w: %v", webhook.ErrInternalSystem, err)
+	}
+	if delivery.Status == model.WebhookDeliveryPending {
+		return model.WebhookDelivery{}, webhook.ErrDeliveryPending
+	}
+
+	updated, err := u.repo.UpdateDelivery(ctx, repository.UpdateDeliveryOptions{
+		ID:             delivery.ID,
+		Status:         model.WebhookDeliveryPending,
+		NextAttemptAt:  u.clock(),
+		LastStatusCode: delivery.LastStatusCode,
+		LastError:      delivery.LastError,
+	})
+	if err != nil {
+		u.l.Errorf(ctx, "webhook.usecase.Redeliver.UpdateDelivery: %v", err)
+		return model.WebhookDelivery{}, fmt.Errorf("%w: %v", webhook.ErrInternalSystem, err)
+	}
+
+	u.l.Infof(ctx, "Webhook delivery requeued: ID=%s Webhook=%s By=%s", delivery.ID, delivery.WebhookID, sc.UserID)
+	return updated, nil
+}
+
+// Purge deletes finished deliveries older than the retention period
+func (u *usecase) Purge(ctx context.Context) error {
+	deleted, err := u.repo.DeleteDeliveries(ctx, u.clock().Add(-u.retention))
+	if err != nil {
+		u.l.Errorf(ctx, "webhook.usecase.Purge.DeleteDeliveries: %v", err)
+		return fmt.Errorf("%w: %v", webhook.ErrInternalSystem, err)
+	}
+	if deleted > 0 {
+		u.l.Infof(ctx, "Webhook deliveries purged: %d", deleted)
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"identity-srv/internal/model"
+	"identity-srv/internal/webhook"
+)
+
+const (
+	maxURLLength         = 2048
+	maxDescriptionLength = 200
+	displayPrefixLength  = 6 // characters of the secret kept in secret_prefix
+	secretBytes          = 32
+	maxErrorLength       = 500 // of last_error
+	maxResponseBody      = 64 << 10
+)
+
+// normalizeURL requires an absolute https URL. An IP literal must be public
+// unless allowPrivate is set; plain http is allowed only for loopback hosts
+// then, so receivers can be tested locally. Names are checked when dialling.
+func normalizeURL(raw string, allowPrivate bool) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" || len(raw) > maxURLLength {
+		return "", webhook.ErrInvalidURL
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" || u.User != nil || u.Fragment != "" {
+		return "", webhook.ErrInvalidURL
+	}
+
+	switch u.Scheme {
+	case "https":
+	case "http":
+		if !allowPrivate || !isLoopback(u.Hostname()) {
+			return "", webhook.ErrInvalidURL
+		}
+	default:
+		return "", webhook.ErrInvalidURL
+	}
+
+	if !allowPrivate {
+		if u.Hostname() == "localhost" {
+			return "", webhook.ErrInvalidURL
+		}
+		if ip := net.ParseIP(u.Hostname()); ip != nil && !isPublicIP(ip) {
+			return "", webhook.ErrInvalidURL
+		}
+	}
+	return u.String(), nil
+}
+
+func isLoopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func normalizeDescription(description string) (string, error) {
+	description = strings.TrimSpace(description)
+	if len(description) > maxDescriptionLength {
+		return "", webhook.ErrInvalidDescription
+	}
+	return description, nil
+}
+
+// normalizeEventTypes validates and de-duplicates event types, preserving order
+func normalizeEventTypes(eventTypes []string) ([]string, error) {
+	normalized := make([]string, 0, len(eventTypes))
+	seen := make(map[string]struct{}, len(eventTypes))
+	for _, eventType := range eventTypes {
+		switch eventType {
+		case model.EventUserCreated,
+			model.EventUserRoleChanged,
+			model.EventUserDeactivated,
+			model.EventSessionRevoked:
+		default:
+			return nil, fmt.Errorf("%w: %q", webhook.ErrInvalidEventType, eventType)
+		}
+		if _, ok := seen[eventType]; ok {
+			continue
+		}
+		seen[eventType] = struct{}{}
+		normalized = append(normalized, eventType)
+	}
+	return normalized, nil
+}
+
+func validateStatus(status string) error {
+	switch status {
+	case "", model.WebhookDeliveryPending, model.WebhookDeliveryDelivered, model.WebhookDeliveryDead:
+		return nil
+	default:
+		return webhook.ErrInvalidStatus
+	}
+}
+
+// generateSecret returns "smap_whsec_" followed by 256 bits of base64url randomness
+func generateSecret() (string, error) {
+	secret := make([]byte, secretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("rand.Read: %w", err)
+	}
+	return model.WebhookSecretPrefix + base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+// secretPrefix returns the part of the secret that is safe to display
+func secretPrefix(secret string) string {
+	return secret[:len(model.WebhookSecretPrefix)+displayPrefixLength]
+}
+
+// sign returns the signature header value for a body sent at the given time
+func sign(secret string, at time.Time, body []byte) string {
+	timestamp := strconv.FormatInt(at.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "t=" + timestamp + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// backoff returns the delay before the next attempt: retryBackoff doubled for
+// every earlier failure, capped at maxBackoff
+func (u *usecase) backoff(attempts int) time.Duration {
+	delay := u.retryBackoff
+	for i := 1; i < attempts && delay < u.maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > u.maxBackoff {
+		return u.maxBackoff
+	}
+	return delay
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
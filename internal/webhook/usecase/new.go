@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"net/http"
+	"time"
+
+	"identity-srv/internal/webhook"
+	"identity-srv/internal/webhook/repository"
+
+	"github.com/smap-hcmut/shared-libs/go/encrypter"
+	"github.com/smap-hcmut/shared-libs/go/log"
+)
+
+type usecase struct {
+	l            log.Logger
+	repo         repository.Repository
+	encrypt      encrypter.Encrypter
+	client       *http.Client
+	clock        func() time.Time
+	maxAttempts  int
+	retryBackoff time.Duration
+	maxBackoff   time.Duration
+	batchSize    int
+	retention    time.Duration
+	allowPrivate bool
+}
+
+// New creates the webhook usecase. Signing secrets are stored encrypted with encrypt.
+func New(l log.Logger, repo repository.Repository, encrypt encrypter.Encrypter, opts webhook.Options) webhook.UseCase {
+	return &usecase{
+		l:       l,
+		repo:    repo,
+		encrypt: encrypt,
+		client:  newClient(opts.Timeout, opts.AllowPrivateTargets),
+		clock:        time.Now,
+		maxAttempts:  opts.MaxAttempts,
+		retryBackoff: opts.RetryBackoff,
+		maxBackoff:   opts.MaxBackoff,
+		batchSize:    opts.BatchSize,
+		retention:    opts.Retention,
+		allowPrivate: opts.AllowPrivateTargets,
+	}
+}
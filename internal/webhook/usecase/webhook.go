@@ -0,0 +1,161 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"identity-srv/internal/model"
+	"identity-srv/internal/webhook"
+	"identity-srv/internal/webhook/repository"
+)
+
+// Create registers a webhook.
+// The plaintext signing secret is only returned here; Postgres keeps it encrypted.
+func (u *usecase) Create(ctx context.Context, sc model.Scope, ip webhook.CreateInput) (webhook.CreateOutput, error) {
+	url, err := normalizeURL(ip.URL, u.allowPrivate)
+	if err != nil {
+		return webhook.CreateOutput{}, err
+	}
+	description, err := normalizeDescription(ip.Description)
+	if err != nil {
+		return webhook.CreateOutput{}, err
+	}
+	eventTypes, err := normalizeEventTypes(ip.EventTypes)
+	if err != nil {
+		return webhook.CreateOutput{}, err
+	}
+
+	secret, encrypted, err := u.newSecret()
+	if err != nil {
+		u.l.Errorf(ctx, "webhook.usecase.Create.newSecret: %v", err)
+		return webhook.CreateOutput{}, fmt.Errorf("%w: %v", webhook.ErrInternalSystem, err)
+	}
+
+	created, err := u.repo.Create(ctx, repository.CreateOptions{
+		URL:             url,
+		Description:     description,
+		EventTypes:      eventTypes,
+		SecretPrefix:    secretPrefix(secret),
+		SecretEncrypted: encrypted,
+		CreatedBy:       sc.UserID,
+	})
+	if err != nil {
+		u.l.Errorf(ctx, "webhook.usecase.Create.Create: %v", err)
+		return webhook.CreateOutput{}, fmt.Errorf("%w: %v", webhook.ErrInternalSystem, err)
+	}
+
+	u.l.Infof(ctx, "Webhook created: ID=%s URL=%s By=%s", created.ID, url, sc.UserID)
+	return webhook.CreateOutput{
+		Secret:  secret,
+		Webhook: created,
+	}, nil
+}
+
+// List returns all webhooks, including disabled ones
+func (u *usecase) List(ctx context.Context) ([]model.Webhook, error) {
+	webhooks, err := u.repo.List(ctx, repository.ListOptions{})
+	if err != nil {
+		u.l.Errorf(ctx, "webhook.usecase.List.List: %v", err)
+		return nil, fmt.Errorf("%w: %v", webhook.ErrInternalSystem, err)
+	}
+	return webhooks, nil
+}
+
+// Update changes the URL, description, event filter or active flag of a webhook.
+// Deliveries already queued are still sent to the new URL.
+func (u *usecase) Update(ctx context.Context, sc model.Scope, ip webhook.UpdateInput) (model.Webhook, error) {
+	opts := repository.UpdateOptions{
+		ID:       ip.ID,
+		IsActive: ip.IsActive,
+	}
+	if ip.URL != nil {
+		url, err := normalizeURL(*ip.URL, u.allowPrivate)
+		if err != nil {
+			return model.Webhook{}, err
+		}
+		opts.URL = &url
+	}
+	if ip.Description != nil {
+		description, err := normalizeDescription(*ip.Description)
+		if err != nil {
+			return model.Webhook{}, err
+		}
+		opts.Description = &description
+	}
+	if ip.EventTypes != nil {
+		eventTypes, err := normalizeEventTypes(*ip.EventTypes)
+		if err != nil {
+			return model.Webhook{}, err
+		}
+		opts.EventTypes = &eventTypes
+	}
+
+	updated, err := u.repo.Update(ctx, opts)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return model.Webhook{}, webhook.ErrWebhookNotFound
+		}
+		u.l.Errorf(ctx, "webhook.usecase.Update.Update: %v", err)
+		return model.Webhook{}, fmt.Errorf("%w: %v", webhook.ErrInternalSystem, err)
+	}
+
+	u.l.Infof(ctx, "Webhook updated: ID=%s By=%s", ip.ID, sc.UserID)
+	return updated, nil
+}
+
+// Delete removes a webhook together with its delivery history
+func (u *usecase) Delete(ctx context.Context, sc model.Scope, id string) error {
+	if err := u.repo.Delete(ctx, id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return webhook.ErrWebhookNotFound
+		}
+		u.l.Errorf(ctx, "webhook.usecase.Delete.Delete: %v", err)
+		return fmt.Errorf("%w: %v", webhook.ErrInternalSystem, err)
+	}
+
+	u.l.Infof(ctx, "Webhook deleted: ID=%s By=%s", id, sc.UserID)
+	return nil
+}
+
+// RotateSecret replaces the signing secret. Deliveries sent from now on,
+// including retries, are signed with the new secret.
+func (u *usecase) RotateSecret(ctx context.Context, sc model.Scope, id string) (webhook.CreateOutput, error) {
+	secret, encrypted, err := u.newSecret()
+	if err != nil {
+		u.l.Errorf(ctx, "webhook.usecase.RotateSecret.newSecret: %v", err)
+		return webhook.CreateOutput{}, fmt.Errorf("%w: %v", webhook.ErrInternalSystem, err)
+	}
+
+	updated, err := u.repo.Update(ctx, repository.UpdateOptions{
+		ID:              id,
+		SecretPrefix:    secretPrefix(secret),
+		SecretEncrypted: encrypted,
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return webhook.CreateOutput{}, webhook.ErrWebhookNotFound
+		}
+		u.l.Errorf(ctx, "webhook.usecase.RotateSecret.Update: %v", err)
+		return webhook.CreateOutput{}, fmt.Errorf("%w: %v", webhook.ErrInternalSystem, err)
+	}
+
+	u.l.Infof(ctx, "Webhook secret rotated: ID=%s By=%s", id, sc.UserID)
+	return webhook.CreateOutput{
+		Secret:  secret,
+		Webhook: updated,
+	}, nil
+}
+
+// newSecret returns a new signing secret and its encrypted form
+func (u *usecase) newSecret() (string, string, error) {
+	secret, err := generateSecret()
+	if err != nil {
+		return "", "", err
+	}
+	encrypted, err := u.encrypt.Encrypt(secret)
+	if err != nil {
+		return "", "", fmt.Errorf("encrypt: %w", err)
+	}
+	return secret, encrypted, nil
+}
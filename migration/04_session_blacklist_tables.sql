@@ -0,0 +1,44 @@
+-- Session and token blacklist storage for the Postgres backend
+-- Description: Used when session.backend / blacklist.backend is "postgres".
+--              Redis and in-memory backends do not need these tables.
+-- Date: 2026-10-18
+
+SET search_path TO identity;
+
+-- ============================================================================
+-- SESSIONS TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS identity.sessions (
+    jti VARCHAR(64) PRIMARY KEY, -- JWT ID of the access token
+    user_id UUID NOT NULL REFERENCES identity.users(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+-- Index for "logout all" lookups
+CREATE INDEX IF NOT EXISTS idx_sessions_user_id ON identity.sessions(user_id);
+
+-- Index for expired session cleanup
+CREATE INDEX IF NOT EXISTS idx_sessions_expires_at ON identity.sessions(expires_at);
+
+-- ============================================================================
+-- TOKEN BLACKLIST TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS identity.token_blacklist (
+    jti VARCHAR(64) PRIMARY KEY, -- JWT ID of the revoked token
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Index for expired entry cleanup
+CREATE INDEX IF NOT EXISTS idx_token_blacklist_expires_at ON identity.token_blacklist(expires_at);
+
+-- ============================================================================
+-- COMMENTS
+-- ============================================================================
+COMMENT ON TABLE identity.sessions IS 'Login sessions (Postgres session backend)';
+COMMENT ON COLUMN identity.sessions.jti IS 'JWT ID of the access token bound to this session';
+COMMENT ON COLUMN identity.sessions.expires_at IS 'Session expiry; expired rows are ignored and purged lazily';
+
+COMMENT ON TABLE identity.token_blacklist IS 'Revoked token JTIs (Postgres blacklist backend)';
+COMMENT ON COLUMN identity.token_blacklist.expires_at IS 'Original token expiry; the entry is useless after this time';
@@ -0,0 +1,13 @@
+-- Per-user token revocation watermark
+-- Description: Tokens whose iat is earlier than tokens_revoked_before are
+--              rejected by ValidateToken. Set by "revoke all user tokens".
+--              Redis keeps a copy ({blacklist.key_prefix}revoked_before:{user_id})
+--              for the hot validation path.
+-- Date: 2026-10-18
+
+SET search_path TO identity;
+
+ALTER TABLE identity.users
+    ADD COLUMN IF NOT EXISTS tokens_revoked_before TIMESTAMPTZ NULL;
+
+COMMENT ON COLUMN identity.users.tokens_revoked_before IS 'Tokens of this user issued before this time are rejected';
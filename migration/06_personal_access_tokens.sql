@@ -0,0 +1,35 @@
+-- Personal access tokens for CLI and script users
+-- Description: Long-lived, user-scoped API tokens. Only a SHA-256 hash of the
+--              token is stored; the plaintext is shown once at creation.
+-- Date: 2026-10-18
+
+SET search_path TO identity;
+
+-- ============================================================================
+-- PERSONAL ACCESS TOKENS TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS identity.personal_access_tokens (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES identity.users(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    token_prefix VARCHAR(16) NOT NULL, -- first characters of the token, for display only
+    secret_hash VARCHAR(64) NOT NULL UNIQUE, -- hex SHA-256 of the full token
+    scopes TEXT[] NOT NULL DEFAULT '{}',
+    expires_at TIMESTAMPTZ NULL, -- NULL means no expiry
+    last_used_at TIMESTAMPTZ NULL,
+    revoked_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Index for listing a user's tokens
+CREATE INDEX IF NOT EXISTS idx_personal_access_tokens_user_id ON identity.personal_access_tokens(user_id);
+
+-- ============================================================================
+-- COMMENTS
+-- ============================================================================
+COMMENT ON TABLE identity.personal_access_tokens IS 'Personal access tokens for CLI and script access';
+COMMENT ON COLUMN identity.personal_access_tokens.token_prefix IS 'First characters of the token, shown in listings to identify it';
+COMMENT ON COLUMN identity.personal_access_tokens.secret_hash IS 'Hex SHA-256 of the full token; the plaintext is never stored';
+COMMENT ON COLUMN identity.personal_access_tokens.scopes IS 'Scopes granted to the token, enforced by downstream services';
+COMMENT ON COLUMN identity.personal_access_tokens.expires_at IS 'Expiry time; NULL means the token does not expire';
+COMMENT ON COLUMN identity.personal_access_tokens.revoked_at IS 'Set when the owner revokes the token';
@@ -0,0 +1,36 @@
+-- Service accounts for the OAuth2 client_credentials grant
+-- Description: Registered service clients (client_id + hashed secret) with the
+--              scopes and audiences they may request. Replaces the single
+--              shared internal key with a per-service identity.
+-- Date: 2026-10-18
+
+SET search_path TO identity;
+
+-- ============================================================================
+-- SERVICE ACCOUNTS TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS identity.service_accounts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    client_id VARCHAR(64) NOT NULL UNIQUE,
+    name VARCHAR(100) NOT NULL,
+    secret_prefix VARCHAR(16) NOT NULL, -- first characters of the secret, for display only
+    secret_hash VARCHAR(64) NOT NULL, -- hex SHA-256 of the full secret
+    scopes TEXT[] NOT NULL DEFAULT '{}',
+    audiences TEXT[] NOT NULL DEFAULT '{}',
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by UUID NULL REFERENCES identity.users(id) ON DELETE SET NULL,
+    last_used_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- ============================================================================
+-- COMMENTS
+-- ============================================================================
+COMMENT ON TABLE identity.service_accounts IS 'Service clients allowed to use the OAuth2 client_credentials grant';
+COMMENT ON COLUMN identity.service_accounts.client_id IS 'Public client identifier, also the subject of issued service tokens';
+COMMENT ON COLUMN identity.service_accounts.secret_prefix IS 'First characters of the client secret, shown in listings to identify it';
+COMMENT ON COLUMN identity.service_accounts.secret_hash IS 'Hex SHA-256 of the client secret; the plaintext is never stored';
+COMMENT ON COLUMN identity.service_accounts.scopes IS 'Scopes the client may request';
+COMMENT ON COLUMN identity.service_accounts.audiences IS 'Audiences (target services) the client may request tokens for';
+COMMENT ON COLUMN identity.service_accounts.is_active IS 'Disabled clients cannot obtain new tokens';
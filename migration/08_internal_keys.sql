@@ -0,0 +1,30 @@
+-- Named internal keys with validity windows
+-- Description: Per-consumer keys for X-Internal-Key, loaded when
+--              internal.database_keys is enabled. Only a SHA-256 hash of each
+--              key is stored. Insert a key with:
+--              INSERT INTO identity.internal_keys (name, key_hash, not_after)
+--              VALUES ('project-srv-2026q4', encode(sha256('<key>'::bytea), 'hex'), '2027-01-31');
+-- Date: 2026-10-18
+
+SET search_path TO identity;
+
+-- ============================================================================
+-- INTERNAL KEYS TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS identity.internal_keys (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(64) NOT NULL UNIQUE,
+    key_hash VARCHAR(64) NOT NULL UNIQUE, -- hex SHA-256 of the key
+    not_before TIMESTAMPTZ NULL, -- NULL means valid immediately
+    not_after TIMESTAMPTZ NULL, -- NULL means no expiry
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- ============================================================================
+-- COMMENTS
+-- ============================================================================
+COMMENT ON TABLE identity.internal_keys IS 'Named keys accepted in the X-Internal-Key header';
+COMMENT ON COLUMN identity.internal_keys.name IS 'Key name, recorded in request logs and metrics';
+COMMENT ON COLUMN identity.internal_keys.key_hash IS 'Hex SHA-256 of the key; the plaintext is never stored';
+COMMENT ON COLUMN identity.internal_keys.not_before IS 'Start of the validity window; NULL means no lower bound';
+COMMENT ON COLUMN identity.internal_keys.not_after IS 'End of the validity window; NULL means no upper bound';
@@ -0,0 +1,11 @@
+-- Impersonation marker on sessions
+-- Description: Sessions created by an admin impersonating a user record the
+--              admin's ID. Deleting the admin ends those sessions.
+-- Date: 2026-10-18
+
+SET search_path TO identity;
+
+ALTER TABLE identity.sessions
+    ADD COLUMN IF NOT EXISTS impersonator_id UUID NULL REFERENCES identity.users(id) ON DELETE CASCADE;
+
+COMMENT ON COLUMN identity.sessions.impersonator_id IS 'Admin acting as user_id; NULL for normal logins';
@@ -0,0 +1,47 @@
+-- TOTP multi-factor authentication
+-- Description: One TOTP factor per user (secret encrypted with the service
+--              encrypter) and single-use recovery codes. Failed attempts are
+--              counted per user so a lockout applies across devices.
+-- Date: 2026-10-18
+
+SET search_path TO identity;
+
+-- ============================================================================
+-- USER TOTP TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS identity.user_totp (
+    user_id UUID PRIMARY KEY REFERENCES identity.users(id) ON DELETE CASCADE,
+    secret_encrypted TEXT NOT NULL,
+    confirmed_at TIMESTAMPTZ NULL, -- NULL until the first code is verified
+    last_used_step BIGINT NOT NULL DEFAULT 0,
+    failed_attempts INTEGER NOT NULL DEFAULT 0,
+    locked_until TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- ============================================================================
+-- MFA RECOVERY CODES TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS identity.mfa_recovery_codes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES identity.users(id) ON DELETE CASCADE,
+    code_hash VARCHAR(64) NOT NULL, -- hex SHA-256 of the code
+    used_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_mfa_recovery_codes_user_id ON identity.mfa_recovery_codes(user_id);
+
+-- ============================================================================
+-- COMMENTS
+-- ============================================================================
+COMMENT ON TABLE identity.user_totp IS 'TOTP second factor of a user (RFC 6238)';
+COMMENT ON COLUMN identity.user_totp.secret_encrypted IS 'Base32 TOTP secret, encrypted with the service encrypter';
+COMMENT ON COLUMN identity.user_totp.confirmed_at IS 'Set when enrolment is confirmed with a valid code; unconfirmed factors are not enforced';
+COMMENT ON COLUMN identity.user_totp.last_used_step IS 'Time step of the last accepted code, so a code cannot be replayed';
+COMMENT ON COLUMN identity.user_totp.failed_attempts IS 'Verification attempts since the last success or lockout';
+COMMENT ON COLUMN identity.user_totp.locked_until IS 'Verification is refused until this time after too many failed attempts';
+COMMENT ON TABLE identity.mfa_recovery_codes IS 'Single-use recovery codes, replaced as a set when regenerated';
+COMMENT ON COLUMN identity.mfa_recovery_codes.code_hash IS 'Hex SHA-256 of the recovery code; the plaintext is shown once';
+COMMENT ON COLUMN identity.mfa_recovery_codes.used_at IS 'Set when the code is redeemed';
@@ -0,0 +1,41 @@
+-- WebAuthn / passkey credentials
+-- Description: Public-key credentials registered by users, used as a second
+--              factor after the OAuth callback or for passwordless login.
+--              Credential IDs and public keys are stored base64url-encoded.
+-- Date: 2026-10-18
+
+SET search_path TO identity;
+
+-- ============================================================================
+-- WEBAUTHN CREDENTIALS TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS identity.webauthn_credentials (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES identity.users(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    credential_id TEXT NOT NULL UNIQUE,
+    public_key TEXT NOT NULL, -- COSE key
+    attestation_type VARCHAR(32) NOT NULL DEFAULT '',
+    transports TEXT[] NOT NULL DEFAULT '{}',
+    aaguid VARCHAR(36) NOT NULL DEFAULT '',
+    attachment VARCHAR(32) NOT NULL DEFAULT '',
+    flags INTEGER NOT NULL DEFAULT 0,
+    sign_count BIGINT NOT NULL DEFAULT 0,
+    last_used_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_webauthn_credentials_user_id ON identity.webauthn_credentials(user_id);
+
+-- ============================================================================
+-- COMMENTS
+-- ============================================================================
+COMMENT ON TABLE identity.webauthn_credentials IS 'WebAuthn public-key credentials (passkeys and security keys)';
+COMMENT ON COLUMN identity.webauthn_credentials.name IS 'Label chosen by the user';
+COMMENT ON COLUMN identity.webauthn_credentials.credential_id IS 'Base64url credential ID assigned by the authenticator';
+COMMENT ON COLUMN identity.webauthn_credentials.public_key IS 'Base64url COSE public key';
+COMMENT ON COLUMN identity.webauthn_credentials.aaguid IS 'Authenticator model identifier; all zeros when not attested';
+COMMENT ON COLUMN identity.webauthn_credentials.flags IS 'Authenticator data flags of the last ceremony (backup eligibility and state)';
+COMMENT ON COLUMN identity.webauthn_credentials.sign_count IS 'Signature counter of the last assertion, used to detect cloned authenticators';
+COMMENT ON COLUMN identity.webauthn_credentials.last_used_at IS 'Time of the last successful assertion';
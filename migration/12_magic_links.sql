@@ -0,0 +1,32 @@
+-- Email magic-link login
+-- Description: Single-use login links sent by email, for users without an
+--              account at the OAuth provider. The link carries a signed token;
+--              this table makes it single-use and limits how often links are sent.
+-- Date: 2026-10-18
+
+SET search_path TO identity;
+
+-- ============================================================================
+-- MAGIC LINKS TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS identity.magic_links (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(), -- jti of the signed token
+    email VARCHAR(255) NOT NULL,
+    ip_address VARCHAR(45) NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_magic_links_email_created_at ON identity.magic_links(email, created_at);
+
+-- ============================================================================
+-- COMMENTS
+-- ============================================================================
+COMMENT ON TABLE identity.magic_links IS 'Login links sent by email; a link can be redeemed once before it expires';
+COMMENT ON COLUMN identity.magic_links.id IS 'JWT ID of the signed token in the link';
+COMMENT ON COLUMN identity.magic_links.email IS 'Normalized address the link was sent to';
+COMMENT ON COLUMN identity.magic_links.ip_address IS 'Client IP of the request that sent the link';
+COMMENT ON COLUMN identity.magic_links.expires_at IS 'The link cannot be redeemed after this time';
+COMMENT ON COLUMN identity.magic_links.used_at IS 'Set when the link is redeemed';
@@ -0,0 +1,37 @@
+-- User invitations
+-- Description: Admins invite an address with a role before its first login.
+--              An invited address may log in even when its domain is not in
+--              the allowlist; the first login accepts the invitation.
+-- Date: 2026-10-18
+
+SET search_path TO identity;
+
+-- ============================================================================
+-- INVITATIONS TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS identity.invitations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    email VARCHAR(255) NOT NULL,
+    role VARCHAR(50) NOT NULL,
+    invited_by UUID NULL REFERENCES identity.users(id) ON DELETE SET NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    accepted_at TIMESTAMPTZ NULL,
+    accepted_by UUID NULL REFERENCES identity.users(id) ON DELETE SET NULL,
+    revoked_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_invitations_email ON identity.invitations(email);
+
+-- ============================================================================
+-- COMMENTS
+-- ============================================================================
+COMMENT ON TABLE identity.invitations IS 'Addresses invited with a role ahead of their first login';
+COMMENT ON COLUMN identity.invitations.email IS 'Normalized invited address';
+COMMENT ON COLUMN identity.invitations.role IS 'Role given to the user, unless access_control.user_roles maps the address';
+COMMENT ON COLUMN identity.invitations.invited_by IS 'Admin who sent the invitation';
+COMMENT ON COLUMN identity.invitations.expires_at IS 'A pending invitation cannot be accepted after this time';
+COMMENT ON COLUMN identity.invitations.accepted_at IS 'Set on the first login of the invited address';
+COMMENT ON COLUMN identity.invitations.accepted_by IS 'User created or updated by that login';
+COMMENT ON COLUMN identity.invitations.revoked_at IS 'Set when an admin withdraws the invitation; it then grants nothing';
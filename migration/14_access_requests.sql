@@ -0,0 +1,43 @@
+-- Access requests
+-- Description: A login refused because of the domain allowlist files an access
+--              request. Admins approve it with a role, or deny it; an approved
+--              address may log in whatever its domain.
+-- Date: 2026-10-18
+
+SET search_path TO identity;
+
+-- ============================================================================
+-- ACCESS REQUESTS TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS identity.access_requests (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    email VARCHAR(255) NOT NULL UNIQUE,
+    name VARCHAR(255) NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'denied')),
+    role VARCHAR(50) NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    ip_address VARCHAR(45) NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    attempts INT NOT NULL DEFAULT 1,
+    last_attempt_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    decided_by UUID NULL REFERENCES identity.users(id) ON DELETE SET NULL,
+    decided_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_access_requests_status ON identity.access_requests(status, created_at);
+
+-- ============================================================================
+-- COMMENTS
+-- ============================================================================
+COMMENT ON TABLE identity.access_requests IS 'Logins refused by the domain allowlist, waiting for an admin decision';
+COMMENT ON COLUMN identity.access_requests.email IS 'Normalized address, verified by the OAuth provider';
+COMMENT ON COLUMN identity.access_requests.name IS 'Name reported by the OAuth provider';
+COMMENT ON COLUMN identity.access_requests.status IS 'pending, approved or denied';
+COMMENT ON COLUMN identity.access_requests.role IS 'Role chosen by the admin on approval';
+COMMENT ON COLUMN identity.access_requests.reason IS 'Note left by the admin with the decision';
+COMMENT ON COLUMN identity.access_requests.ip_address IS 'Client IP of the last refused login';
+COMMENT ON COLUMN identity.access_requests.attempts IS 'Refused logins since the request was filed';
+COMMENT ON COLUMN identity.access_requests.last_attempt_at IS 'Time of the last refused login';
+COMMENT ON COLUMN identity.access_requests.decided_by IS 'Admin who approved or denied the request';
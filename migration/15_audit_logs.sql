@@ -0,0 +1,63 @@
+-- Audit logs
+-- Description: Append-only record of authentication and admin events (logins,
+--              logouts, token revocations, role changes, impersonation and
+--              config-driven denials). Replaces the table dropped by 03 with one
+--              written synchronously by this service.
+-- Date: 2026-10-18
+
+SET search_path TO identity;
+
+-- ============================================================================
+-- AUDIT LOGS TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS identity.audit_logs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_type VARCHAR(50) NOT NULL,
+    outcome VARCHAR(20) NOT NULL DEFAULT 'success' CHECK (outcome IN ('success', 'failure')),
+    reason VARCHAR(100) NOT NULL DEFAULT '',
+    actor_id UUID NULL,
+    actor_email VARCHAR(255) NOT NULL DEFAULT '',
+    target_id UUID NULL,
+    target_email VARCHAR(255) NOT NULL DEFAULT '',
+    ip_address VARCHAR(45) NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    trace_id VARCHAR(64) NOT NULL DEFAULT '',
+    metadata JSONB NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_created_at ON identity.audit_logs(created_at);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_actor_id ON identity.audit_logs(actor_id, created_at) WHERE actor_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_audit_logs_target_id ON identity.audit_logs(target_id, created_at) WHERE target_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_audit_logs_event_type ON identity.audit_logs(event_type, created_at);
+
+-- ============================================================================
+-- APPEND-ONLY GUARD
+-- ============================================================================
+-- Entries are never edited. DELETE stays allowed so the retention job can
+-- purge old rows.
+CREATE OR REPLACE FUNCTION identity.audit_logs_reject_update() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_logs is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_audit_logs_reject_update ON identity.audit_logs;
+CREATE TRIGGER trg_audit_logs_reject_update
+    BEFORE UPDATE ON identity.audit_logs
+    FOR EACH ROW EXECUTE FUNCTION identity.audit_logs_reject_update();
+
+-- ============================================================================
+-- COMMENTS
+-- ============================================================================
+COMMENT ON TABLE identity.audit_logs IS 'Append-only log of authentication and admin events';
+COMMENT ON COLUMN identity.audit_logs.event_type IS 'login, logout, logout_all, token_revoke, role_change, impersonation or access_denied';
+COMMENT ON COLUMN identity.audit_logs.outcome IS 'success or failure';
+COMMENT ON COLUMN identity.audit_logs.reason IS 'Machine-readable cause of a failure or denial';
+COMMENT ON COLUMN identity.audit_logs.actor_id IS 'User who performed the action; no foreign key so entries outlive the user';
+COMMENT ON COLUMN identity.audit_logs.actor_email IS 'Actor address at the time of the event';
+COMMENT ON COLUMN identity.audit_logs.target_id IS 'User the action applied to, when different from the actor';
+COMMENT ON COLUMN identity.audit_logs.target_email IS 'Target address at the time of the event';
+COMMENT ON COLUMN identity.audit_logs.ip_address IS 'Client IP of the request';
+COMMENT ON COLUMN identity.audit_logs.trace_id IS 'Trace ID of the request, to correlate with service logs';
+COMMENT ON COLUMN identity.audit_logs.metadata IS 'Event-specific details such as the login method or the old and new role';
@@ -0,0 +1,39 @@
+-- Outbox events
+-- Description: Transactional outbox for identity domain events (user.created,
+--              user.role_changed, user.deactivated, session.revoked). Rows are
+--              written in the same transaction as the user change and published
+--              by the relay worker at least once.
+-- Date: 2026-10-18
+
+SET search_path TO identity;
+
+-- ============================================================================
+-- OUTBOX EVENTS TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS identity.outbox_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_type VARCHAR(100) NOT NULL,
+    aggregate_id VARCHAR(255) NOT NULL,
+    payload JSONB NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_error TEXT NOT NULL DEFAULT '',
+    published_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_events_pending ON identity.outbox_events(next_attempt_at) WHERE published_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_outbox_events_aggregate ON identity.outbox_events(aggregate_id, created_at) WHERE published_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_outbox_events_published_at ON identity.outbox_events(published_at) WHERE published_at IS NOT NULL;
+
+-- ============================================================================
+-- COMMENTS
+-- ============================================================================
+COMMENT ON TABLE identity.outbox_events IS 'Domain events waiting to be published, written with the change they describe';
+COMMENT ON COLUMN identity.outbox_events.event_type IS 'user.created, user.role_changed, user.deactivated or session.revoked';
+COMMENT ON COLUMN identity.outbox_events.aggregate_id IS 'User the event is about; the message key, so events of a user stay in order';
+COMMENT ON COLUMN identity.outbox_events.payload IS 'Event data, published as the data field of the message';
+COMMENT ON COLUMN identity.outbox_events.attempts IS 'Failed publish attempts';
+COMMENT ON COLUMN identity.outbox_events.next_attempt_at IS 'The relay skips the event until this time: retry backoff, or the lease of the relay publishing it';
+COMMENT ON COLUMN identity.outbox_events.last_error IS 'Error of the last failed publish attempt';
+COMMENT ON COLUMN identity.outbox_events.published_at IS 'Set once the publisher acknowledged the event; purged after outbox.retention';
@@ -0,0 +1,64 @@
+-- Outbound webhooks
+-- Description: Admin-managed webhook subscriptions for identity domain events,
+--              and the delivery history of every event sent to them. Deliveries
+--              are created by the outbox relay and retried with backoff until
+--              they succeed or are dead-lettered.
+-- Date: 2026-10-18
+
+SET search_path TO identity;
+
+-- ============================================================================
+-- WEBHOOKS TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS identity.webhooks (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    url TEXT NOT NULL,
+    description VARCHAR(200) NOT NULL DEFAULT '',
+    event_types TEXT[] NOT NULL DEFAULT '{}',
+    secret_prefix VARCHAR(16) NOT NULL, -- first characters of the secret, for display only
+    secret_encrypted TEXT NOT NULL,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by UUID NULL REFERENCES identity.users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- ============================================================================
+-- WEBHOOK DELIVERIES TABLE
+-- ============================================================================
+CREATE TABLE IF NOT EXISTS identity.webhook_deliveries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    webhook_id UUID NOT NULL REFERENCES identity.webhooks(id) ON DELETE CASCADE,
+    event_id UUID NOT NULL, -- outbox event id; no foreign key, published events are purged
+    event_type VARCHAR(100) NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'delivered', 'dead')),
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_status_code INT NULL,
+    last_error TEXT NOT NULL DEFAULT '',
+    delivered_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (webhook_id, event_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_pending ON identity.webhook_deliveries(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_webhook_id ON identity.webhook_deliveries(webhook_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_created_at ON identity.webhook_deliveries(created_at) WHERE status <> 'pending';
+
+-- ============================================================================
+-- COMMENTS
+-- ============================================================================
+COMMENT ON TABLE identity.webhooks IS 'Webhook subscriptions receiving identity domain events';
+COMMENT ON COLUMN identity.webhooks.url IS 'Endpoint receiving a signed POST per event';
+COMMENT ON COLUMN identity.webhooks.event_types IS 'Event types delivered to the webhook; empty for all';
+COMMENT ON COLUMN identity.webhooks.secret_prefix IS 'First characters of the signing secret, shown in listings to identify it';
+COMMENT ON COLUMN identity.webhooks.secret_encrypted IS 'HMAC signing secret, encrypted with encrypter.key';
+COMMENT ON COLUMN identity.webhooks.is_active IS 'Inactive webhooks receive no new deliveries';
+COMMENT ON TABLE identity.webhook_deliveries IS 'One event sent to one webhook, with its retry state';
+COMMENT ON COLUMN identity.webhook_deliveries.payload IS 'Event envelope posted as the request body';
+COMMENT ON COLUMN identity.webhook_deliveries.status IS 'pending (waiting or retrying), delivered, or dead after the last attempt failed';
+COMMENT ON COLUMN identity.webhook_deliveries.next_attempt_at IS 'The worker skips the delivery until this time: retry backoff, or the lease of the worker sending it';
+COMMENT ON COLUMN identity.webhook_deliveries.last_status_code IS 'HTTP status of the last attempt; NULL when no response was received';
+COMMENT ON COLUMN identity.webhook_deliveries.last_error IS 'Error of the last failed attempt';
@@ -0,0 +1,32 @@
+package alert
+
+import (
+	"context"
+	"time"
+)
+
+// Sink delivers security alerts to the people who watch them
+type Sink interface {
+	Send(ctx context.Context, a Alert) error
+}
+
+// Alert severities
+const (
+	SeverityWarning  = "warning"
+	SeverityCritical = "critical"
+)
+
+// Alert is a short security notification. Fields are shown in order.
+type Alert struct {
+	Kind       string // stable identifier of the rule that fired, e.g. "role_escalation"
+	Severity   string
+	Title      string
+	Fields     []Field
+	OccurredAt time.Time
+}
+
+// Field is a labelled value of an alert
+type Field struct {
+	Name  string
+	Value string
+}
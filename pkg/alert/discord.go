@@ -0,0 +1,29 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/smap-hcmut/shared-libs/go/discord"
+)
+
+// DiscordSink posts alerts to the admins' Discord channel
+type DiscordSink struct {
+	d discord.IDiscord
+}
+
+func NewDiscordSink(d discord.IDiscord) *DiscordSink {
+	return &DiscordSink{d: d}
+}
+
+func (s *DiscordSink) Send(ctx context.Context, a Alert) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "**[%s] %s**\n", strings.ToUpper(a.Severity), a.Title)
+	for _, field := range a.Fields {
+		fmt.Fprintf(&b, "%s: %s\n", field.Name, field.Value)
+	}
+	fmt.Fprintf(&b, "Rule: %s, at %s", a.Kind, a.OccurredAt.UTC().Format(time.RFC3339))
+	return s.d.ReportBug(ctx, b.String())
+}
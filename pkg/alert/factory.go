@@ -0,0 +1,24 @@
+package alert
+
+import (
+	"fmt"
+
+	"github.com/smap-hcmut/shared-libs/go/discord"
+	"github.com/smap-hcmut/shared-libs/go/log"
+)
+
+// New creates a sink for the driver. d is needed by the discord driver; the
+// others ignore it.
+func New(driver string, l log.Logger, d discord.IDiscord) (Sink, error) {
+	switch driver {
+	case "log":
+		return NewLogSink(l), nil
+	case "discord":
+		if d == nil {
+			return nil, fmt.Errorf("discord is not configured for the discord alert sink")
+		}
+		return NewDiscordSink(d), nil
+	default:
+		return nil, fmt.Errorf("unsupported alert sink: %s (supported: log, discord)", driver)
+	}
+}
@@ -0,0 +1,27 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/smap-hcmut/shared-libs/go/log"
+)
+
+// LogSink writes alerts to the service log. Meant for local runs.
+type LogSink struct {
+	l log.Logger
+}
+
+func NewLogSink(l log.Logger) *LogSink {
+	return &LogSink{l: l}
+}
+
+func (s *LogSink) Send(ctx context.Context, a Alert) error {
+	fields := make([]string, 0, len(a.Fields))
+	for _, field := range a.Fields {
+		fields = append(fields, fmt.Sprintf("%s=%q", field.Name, field.Value))
+	}
+	s.l.Warnf(ctx, "Security alert (not sent): Kind=%s Severity=%s Title=%q %s", a.Kind, a.Severity, a.Title, strings.Join(fields, " "))
+	return nil
+}
@@ -0,0 +1,27 @@
+package mailer
+
+import (
+	"fmt"
+
+	"github.com/smap-hcmut/shared-libs/go/log"
+)
+
+// New creates a mailer based on configuration
+func New(cfg Config, l log.Logger) (Mailer, error) {
+	switch cfg.Driver {
+	case "smtp":
+		if cfg.SMTP.Host == "" || cfg.SMTP.Port == 0 {
+			return nil, fmt.Errorf("smtp host and port are required for the smtp mailer")
+		}
+		return NewSMTPMailer(cfg), nil
+	case "file":
+		if cfg.Dir == "" {
+			return nil, fmt.Errorf("dir is required for the file mailer")
+		}
+		return NewFileMailer(cfg), nil
+	case "log":
+		return NewLogMailer(l), nil
+	default:
+		return nil, fmt.Errorf("unsupported mailer driver: %s (supported: smtp, file, log)", cfg.Driver)
+	}
+}
@@ -0,0 +1,43 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// FileMailer writes each message as an .eml file, for local development and tests
+type FileMailer struct {
+	cfg   Config
+	clock func() time.Time
+}
+
+func NewFileMailer(cfg Config) *FileMailer {
+	return &FileMailer{
+		cfg:   cfg,
+		clock: time.Now,
+	}
+}
+
+func (m *FileMailer) Send(ctx context.Context, msg Message) error {
+	now := m.clock()
+	data, err := buildMessage(m.cfg.From, msg, now)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(m.cfg.Dir, 0o700); err != nil {
+		return fmt.Errorf("create mail dir: %w", err)
+	}
+	suffix, err := randomBoundary()
+	if err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%s-%s.eml", now.UTC().Format("20060102T150405.000"), suffix[:8])
+	if err := os.WriteFile(filepath.Join(m.cfg.Dir, name), data, 0o600); err != nil {
+		return fmt.Errorf("write mail file: %w", err)
+	}
+	return nil
+}
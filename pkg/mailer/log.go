@@ -0,0 +1,22 @@
+package mailer
+
+import (
+	"context"
+
+	"github.com/smap-hcmut/shared-libs/go/log"
+)
+
+// LogMailer writes messages to the service log instead of sending them.
+// Message bodies may contain login links, so it is meant for development only.
+type LogMailer struct {
+	l log.Logger
+}
+
+func NewLogMailer(l log.Logger) *LogMailer {
+	return &LogMailer{l: l}
+}
+
+func (m *LogMailer) Send(ctx context.Context, msg Message) error {
+	m.l.Infof(ctx, "Mail (not sent): To=%s Subject=%q\n%s", msg.To, msg.Subject, msg.Text)
+	return nil
+}
@@ -0,0 +1,22 @@
+package notifier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smap-hcmut/shared-libs/go/discord"
+)
+
+// DiscordNotifier posts notifications to the admins' Discord channel
+type DiscordNotifier struct {
+	d discord.IDiscord
+}
+
+func NewDiscordNotifier(d discord.IDiscord) *DiscordNotifier {
+	return &DiscordNotifier{d: d}
+}
+
+func (n *DiscordNotifier) Notify(ctx context.Context, notification Notification) error {
+	message := fmt.Sprintf("**%s**\nTo: %s\n%s", notification.Subject, notification.To, notification.Text)
+	return n.d.ReportBug(ctx, message)
+}
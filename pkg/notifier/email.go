@@ -0,0 +1,24 @@
+package notifier
+
+import (
+	"context"
+
+	"identity-srv/pkg/mailer"
+)
+
+// EmailNotifier sends notifications to the recipient by email
+type EmailNotifier struct {
+	m mailer.Mailer
+}
+
+func NewEmailNotifier(m mailer.Mailer) *EmailNotifier {
+	return &EmailNotifier{m: m}
+}
+
+func (n *EmailNotifier) Notify(ctx context.Context, notification Notification) error {
+	return n.m.Send(ctx, mailer.Message{
+		To:      notification.To,
+		Subject: notification.Subject,
+		Text:    notification.Text,
+	})
+}
@@ -0,0 +1,31 @@
+package notifier
+
+import (
+	"fmt"
+
+	"identity-srv/pkg/mailer"
+
+	"github.com/smap-hcmut/shared-libs/go/discord"
+	"github.com/smap-hcmut/shared-libs/go/log"
+)
+
+// New creates a notifier for the driver. d is needed by the discord driver and
+// m by the email driver; the others ignore them.
+func New(driver string, l log.Logger, d discord.IDiscord, m mailer.Mailer) (Notifier, error) {
+	switch driver {
+	case "log":
+		return NewLogNotifier(l), nil
+	case "discord":
+		if d == nil {
+			return nil, fmt.Errorf("discord is not configured for the discord notifier")
+		}
+		return NewDiscordNotifier(d), nil
+	case "email":
+		if m == nil {
+			return nil, fmt.Errorf("a mailer is required for the email notifier")
+		}
+		return NewEmailNotifier(m), nil
+	default:
+		return nil, fmt.Errorf("unsupported notifier driver: %s (supported: log, discord, email)", driver)
+	}
+}
@@ -0,0 +1,21 @@
+package notifier
+
+import (
+	"context"
+
+	"github.com/smap-hcmut/shared-libs/go/log"
+)
+
+// LogNotifier writes notifications to the service log, for admins to relay by hand
+type LogNotifier struct {
+	l log.Logger
+}
+
+func NewLogNotifier(l log.Logger) *LogNotifier {
+	return &LogNotifier{l: l}
+}
+
+func (n *LogNotifier) Notify(ctx context.Context, notification Notification) error {
+	n.l.Infof(ctx, "Notification (not sent): To=%s Subject=%q\n%s", notification.To, notification.Subject, notification.Text)
+	return nil
+}
@@ -0,0 +1,17 @@
+package notifier
+
+import "context"
+
+// Notifier tells a person about something that concerns them, e.g. an invitation
+type Notifier interface {
+	// Notify delivers a notification. Channels without a per-recipient address
+	// (e.g. a Discord webhook) post it where admins can relay it.
+	Notify(ctx context.Context, n Notification) error
+}
+
+// Notification is a short plain-text message for one recipient
+type Notification struct {
+	To      string // email address of the recipient
+	Subject string
+	Text    string
+}
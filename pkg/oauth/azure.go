@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/smap-hcmut/shared-libs/go/tracing"
@@ -66,12 +67,21 @@ func (p *AzureProvider) GetUserInfo(ctx context.Context, token *oauth2.Token) (*
 	}
 
 	return &UserInfo{
-		Email:   azureUser.Mail,
-		Name:    azureUser.DisplayName,
-		Picture: "", // Azure doesn't provide picture in basic profile
+		Email:    azureUser.Mail,
+		Name:     azureUser.DisplayName,
+		Picture:  "", // Azure doesn't provide picture in basic profile
+		AuthTime: idTokenAuthTime(token),
 	}, nil
 }
 
 func (p *AzureProvider) GetProviderName() string {
 	return "azure"
 }
+
+func (p *AzureProvider) GetEndSessionURL(postLogoutRedirectURI string) string {
+	endSessionURL := "https://login.microsoftonline.com/common/oauth2/v2.0/logout"
+	if postLogoutRedirectURI == "" {
+		return endSessionURL
+	}
+	return endSessionURL + "?" + url.Values{"post_logout_redirect_uri": {postLogoutRedirectURI}}.Encode()
+}
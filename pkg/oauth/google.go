@@ -67,12 +67,18 @@ func (p *GoogleProvider) GetUserInfo(ctx context.Context, token *oauth2.Token) (
 	}
 
 	return &UserInfo{
-		Email:   googleUser.Email,
-		Name:    googleUser.Name,
-		Picture: googleUser.Picture,
+		Email:    googleUser.Email,
+		Name:     googleUser.Name,
+		Picture:  googleUser.Picture,
+		AuthTime: idTokenAuthTime(token),
 	}, nil
 }
 
 func (p *GoogleProvider) GetProviderName() string {
 	return "google"
 }
+
+// GetEndSessionURL returns "" because Google does not support RP-initiated logout
+func (p *GoogleProvider) GetEndSessionURL(postLogoutRedirectURI string) string {
+	return ""
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/smap-hcmut/shared-libs/go/tracing"
@@ -71,12 +72,23 @@ func (p *OktaProvider) GetUserInfo(ctx context.Context, token *oauth2.Token) (*U
 	}
 
 	return &UserInfo{
-		Email:   oktaUser.Email,
-		Name:    oktaUser.Name,
-		Picture: oktaUser.Picture,
+		Email:    oktaUser.Email,
+		Name:     oktaUser.Name,
+		Picture:  oktaUser.Picture,
+		AuthTime: idTokenAuthTime(token),
 	}, nil
 }
 
 func (p *OktaProvider) GetProviderName() string {
 	return "okta"
 }
+
+// GetEndSessionURL uses the Okta sign-out redirect, since the OIDC logout
+// endpoint requires an id_token_hint that the service does not keep
+func (p *OktaProvider) GetEndSessionURL(postLogoutRedirectURI string) string {
+	endSessionURL := fmt.Sprintf("https://%s/login/signout", p.oktaDomain)
+	if postLogoutRedirectURI == "" {
+		return endSessionURL
+	}
+	return endSessionURL + "?" + url.Values{"fromURI": {postLogoutRedirectURI}}.Encode()
+}
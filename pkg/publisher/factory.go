@@ -0,0 +1,32 @@
+package publisher
+
+import (
+	"fmt"
+
+	"github.com/smap-hcmut/shared-libs/go/log"
+	"github.com/smap-hcmut/shared-libs/go/redis"
+)
+
+// New creates a publisher based on configuration. rdb is needed by the redis
+// driver; the others ignore it.
+func New(cfg Config, l log.Logger, rdb redis.IRedis) (Publisher, error) {
+	switch cfg.Driver {
+	case "kafka":
+		if cfg.Kafka.RESTURL == "" || cfg.Kafka.Topic == "" {
+			return nil, fmt.Errorf("rest url and topic are required for the kafka publisher")
+		}
+		return NewKafkaPublisher(cfg.Kafka), nil
+	case "redis":
+		if rdb == nil {
+			return nil, fmt.Errorf("redis is not configured for the redis publisher")
+		}
+		if cfg.Redis.Stream == "" {
+			return nil, fmt.Errorf("stream is required for the redis publisher")
+		}
+		return NewRedisPublisher(cfg.Redis, rdb), nil
+	case "log":
+		return NewLogPublisher(l), nil
+	default:
+		return nil, fmt.Errorf("unsupported publisher driver: %s (supported: kafka, redis, log)", cfg.Driver)
+	}
+}
@@ -0,0 +1,92 @@
+package publisher
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const kafkaContentType = "application/vnd.kafka.json.v2+json"
+
+// KafkaPublisher produces messages through a Kafka REST Proxy (v2 API), so the
+// service needs no Kafka client or broker access. The message key is the user
+// ID, keeping the events of a user on one partition; the value is the JSON
+// envelope.
+type KafkaPublisher struct {
+	endpoint string
+	client   *http.Client
+}
+
+func NewKafkaPublisher(cfg KafkaConfig) *KafkaPublisher {
+	return &KafkaPublisher{
+		endpoint: strings.TrimRight(cfg.RESTURL, "/") + "/topics/" + url.PathEscape(cfg.Topic),
+		client:   &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+type kafkaRecord struct {
+	Key   string   `json:"key"`
+	Value Envelope `json:"value"`
+}
+
+type kafkaProduceReq struct {
+	Records []kafkaRecord `json:"records"`
+}
+
+type kafkaProduceResp struct {
+	Offsets []struct {
+		Partition int    `json:"partition"`
+		Offset    int64  `json:"offset"`
+		ErrorCode *int   `json:"error_code"`
+		Error     string `json:"error"`
+	} `json:"offsets"`
+}
+
+func (p *KafkaPublisher) Publish(ctx context.Context, msg Message) error {
+	body, err := json.Marshal(kafkaProduceReq{
+		Records: []kafkaRecord{{Key: msg.Key, Value: NewEnvelope(msg)}},
+	})
+	if err != nil {
+		return fmt.Errorf("json.Marshal: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", kafkaContentType)
+	req.Header.Set("Accept", "application/vnd.kafka.v2+json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("kafka rest proxy returned %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+
+	// The proxy answers 200 even when a record was rejected
+	var produced kafkaProduceResp
+	if err := json.Unmarshal(respBody, &produced); err != nil {
+		return fmt.Errorf("json.Unmarshal: %w", err)
+	}
+	if len(produced.Offsets) != 1 {
+		return fmt.Errorf("kafka rest proxy returned %d offsets for 1 record", len(produced.Offsets))
+	}
+	if offset := produced.Offsets[0]; offset.ErrorCode != nil {
+		return fmt.Errorf("kafka rest proxy rejected the record (%d): %s", *offset.ErrorCode, offset.Error)
+	}
+	return nil
+}
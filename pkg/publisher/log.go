@@ -0,0 +1,22 @@
+package publisher
+
+import (
+	"context"
+
+	"github.com/smap-hcmut/shared-libs/go/log"
+)
+
+// LogPublisher writes messages to the service log instead of publishing them.
+// Meant for local runs.
+type LogPublisher struct {
+	l log.Logger
+}
+
+func NewLogPublisher(l log.Logger) *LogPublisher {
+	return &LogPublisher{l: l}
+}
+
+func (p *LogPublisher) Publish(ctx context.Context, msg Message) error {
+	p.l.Infof(ctx, "Event (not published): ID=%s Type=%s Key=%s\n%s", msg.ID, msg.Type, msg.Key, msg.Payload)
+	return nil
+}
@@ -0,0 +1,27 @@
+package publisher
+
+import (
+	"context"
+	"errors"
+)
+
+// MultiPublisher publishes every message to all of its publishers. It fails
+// if any of them fails, so the caller retries the message on all of them;
+// consumers already deduplicate by ID.
+type MultiPublisher struct {
+	publishers []Publisher
+}
+
+func NewMultiPublisher(publishers ...Publisher) *MultiPublisher {
+	return &MultiPublisher{publishers: publishers}
+}
+
+func (p *MultiPublisher) Publish(ctx context.Context, msg Message) error {
+	var errs []error
+	for _, publisher := range p.publishers {
+		if err := publisher.Publish(ctx, msg); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,63 @@
+package publisher
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Publisher delivers domain events to other services
+type Publisher interface {
+	// Publish returns once the broker has acknowledged the message. Delivery is
+	// at least once: a message may be published again after a failure, so
+	// consumers deduplicate by ID.
+	Publish(ctx context.Context, msg Message) error
+}
+
+// Message is a domain event. Key groups the messages that must stay in order
+// (the user ID).
+type Message struct {
+	ID         string
+	Type       string
+	Key        string
+	Payload    []byte // JSON
+	OccurredAt time.Time
+}
+
+// Config holds publisher configuration
+type Config struct {
+	Driver string // "kafka", "redis", "log"
+	Kafka  KafkaConfig
+	Redis  RedisConfig
+}
+
+// KafkaConfig holds the Kafka REST Proxy settings
+type KafkaConfig struct {
+	RESTURL string // e.g. "http://kafka-rest:8082"
+	Topic   string
+}
+
+// RedisConfig holds the Redis Streams settings
+type RedisConfig struct {
+	Stream string
+	MaxLen int64 // approximate cap on the stream length, 0 keeps everything
+}
+
+// Envelope is the JSON body of a published message
+type Envelope struct {
+	ID         string          `json:"id"`
+	Type       string          `json:"type"`
+	Key        string          `json:"key"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	Data       json.RawMessage `json:"data"`
+}
+
+func NewEnvelope(msg Message) Envelope {
+	return Envelope{
+		ID:         msg.ID,
+		Type:       msg.Type,
+		Key:        msg.Key,
+		OccurredAt: msg.OccurredAt.UTC(),
+		Data:       json.RawMessage(msg.Payload),
+	}
+}
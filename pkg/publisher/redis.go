@@ -0,0 +1,40 @@
+package publisher
+
+import (
+	"context"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/smap-hcmut/shared-libs/go/redis"
+)
+
+// RedisPublisher appends messages to a Redis stream. Each entry has the
+// fields id, type, key, occurred_at (RFC 3339) and data (the JSON payload).
+type RedisPublisher struct {
+	rdb    redis.IRedis
+	stream string
+	maxLen int64
+}
+
+func NewRedisPublisher(cfg RedisConfig, rdb redis.IRedis) *RedisPublisher {
+	return &RedisPublisher{
+		rdb:    rdb,
+		stream: cfg.Stream,
+		maxLen: cfg.MaxLen,
+	}
+}
+
+func (p *RedisPublisher) Publish(ctx context.Context, msg Message) error {
+	return p.rdb.GetClient().XAdd(ctx, &goredis.XAddArgs{
+		Stream: p.stream,
+		MaxLen: p.maxLen,
+		Approx: p.maxLen > 0,
+		Values: map[string]interface{}{
+			"id":          msg.ID,
+			"type":        msg.Type,
+			"key":         msg.Key,
+			"occurred_at": msg.OccurredAt.UTC().Format(time.RFC3339Nano),
+			"data":        string(msg.Payload),
+		},
+	}).Err()
+}